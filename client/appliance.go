@@ -0,0 +1,44 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+
+	"github.com/dell/gopowerstore"
+)
+
+// GetLatestApplianceSpaceMetrics returns the most recent space metrics sample of an appliance
+// It returns nil if the appliance has not reported any space metrics yet
+func (c *Client) GetLatestApplianceSpaceMetrics(ctx context.Context, id string) (*gopowerstore.SpaceMetricsByApplianceResponse, error) {
+	metrics, err := c.PStoreClient.SpaceMetricsByAppliance(ctx, id, gopowerstore.FiveMins)
+	if err != nil || len(metrics) == 0 {
+		return nil, err
+	}
+	return &metrics[len(metrics)-1], nil
+}
+
+// GetLatestClusterSpaceMetrics returns the most recent space metrics sample of a cluster
+// It returns nil if the cluster has not reported any space metrics yet
+func (c *Client) GetLatestClusterSpaceMetrics(ctx context.Context, id string) (*gopowerstore.SpaceMetricsByClusterResponse, error) {
+	metrics, err := c.PStoreClient.SpaceMetricsByCluster(ctx, id, gopowerstore.FiveMins)
+	if err != nil || len(metrics) == 0 {
+		return nil, err
+	}
+	return &metrics[len(metrics)-1], nil
+}
@@ -0,0 +1,65 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+
+	"github.com/dell/gopowerstore"
+	"github.com/dell/gopowerstore/api"
+
+	"terraform-provider-powerstore/clientgen"
+)
+
+const (
+	auditEventURL = "audit_event"
+)
+
+// auditEventFields lists the audit event fields returned by GetAuditEvents
+var auditEventFields = []string{
+	"id", "type", "timestamp", "username", "is_successful", "client_address", "server_address",
+	"appliance_id", "job_id", "resource_type", "resource_action", "message_code", "message_arguments", "message_l10n",
+}
+
+// GetAuditEvents returns the audit events matching the filters, most recent first
+func (c *Client) GetAuditEvents(ctx context.Context, filters map[string]string) ([]clientgen.AuditEventInstance, error) {
+	var result []clientgen.AuditEventInstance
+	err := c.readPaginatedData(func(offset int) (api.RespMeta, error) {
+		var page []clientgen.AuditEventInstance
+		qp := c.PStoreClient.APIClient().QueryParams().Select(auditEventFields...)
+		for k, v := range filters {
+			qp.RawArg(k, v)
+		}
+		qp.Order("timestamp.desc")
+		qp.Offset(offset).Limit(paginationDefaultPageSize)
+		meta, err := c.PStoreClient.APIClient().Query(
+			ctx,
+			gopowerstore.RequestConfig{
+				Method:      "GET",
+				Endpoint:    auditEventURL,
+				QueryParams: qp,
+			},
+			&page)
+		err = gopowerstore.WrapErr(err)
+		if err == nil {
+			result = append(result, page...)
+		}
+		return meta, err
+	})
+	return result, err
+}
@@ -39,6 +39,7 @@ import (
 type Client struct {
 	PStoreClient *pstore.ClientIMPL
 	GenClient    *clientgen.APIClient
+	maintenance  *maintenanceWindows
 }
 
 var (
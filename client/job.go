@@ -0,0 +1,109 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	"terraform-provider-powerstore/clientgen"
+)
+
+const (
+	// jobSelect lists the job fields needed to follow a job and report the errors of its steps
+	jobSelect = "id,description_l10n,state,response_body,leafs(id,description_l10n,state,response_body)"
+	// jobClockSkew widens the search window of failed jobs, the clock of the array may lag behind the local one
+	jobClockSkew = time.Minute
+)
+
+// GetJob returns a job with the state and response body of its steps
+func (c *Client) GetJob(ctx context.Context, id string) (*clientgen.JobInstance, error) {
+	queries := make(url.Values)
+	queries.Set("select", jobSelect)
+	job, _, err := c.GenClient.JobApi.GetJobById(ctx, id).Queries(queries).Execute()
+	return job, err
+}
+
+// JobErrorMessages returns the error messages stored in the response body of a job
+func JobErrorMessages(job clientgen.JobInstance) []string {
+	var messages []string
+	if job.ResponseBody == nil {
+		return messages
+	}
+	for _, message := range job.ResponseBody.Messages {
+		if message.MessageL10n != nil {
+			messages = append(messages, *message.MessageL10n)
+		}
+	}
+	return messages
+}
+
+// FailedJobDetails returns the id and the step level errors of the most recent failed job of a resource started after since.
+// The resource is looked up by id, or by name if the id is not known yet. The details are formatted to be appended to
+// the detail of a diagnostic, an empty string is returned if no failed job is found or if neither id nor name is known,
+// since the jobs of concurrent operations on other resources of the same type cannot be told apart.
+func (c *Client) FailedJobDetails(ctx context.Context, since time.Time, resourceType, resourceID, resourceName string) string {
+	if resourceID == "" && resourceName == "" {
+		return ""
+	}
+	queries := make(url.Values)
+	queries.Set("select", jobSelect)
+	queries.Set("resource_type", "eq."+resourceType)
+	if resourceID != "" {
+		queries.Set("resource_id", "eq."+resourceID)
+	} else {
+		queries.Set("resource_name", "eq."+resourceName)
+	}
+	queries.Set("state", "in.(FAILED,UNRECOVERABLE_FAILED)")
+	queries.Set("start_time", "gte."+since.Add(-jobClockSkew).UTC().Format(time.RFC3339))
+	queries.Set("order", "start_time.desc")
+	queries.Set("limit", "1")
+	jobs, _, err := c.GenClient.JobApi.GetAllJobs(ctx).Queries(queries).Execute()
+	if err != nil || len(jobs) == 0 {
+		return ""
+	}
+	return JobFailureDetails(jobs[0])
+}
+
+// JobFailureDetails formats the id and the step level errors of a failed job to be appended to the detail of a diagnostic
+func JobFailureDetails(job clientgen.JobInstance) string {
+	var jobID string
+	if job.Id != nil {
+		jobID = *job.Id
+	}
+	var details strings.Builder
+	details.WriteString("\n\nPowerStore job " + jobID + " failed")
+	if job.DescriptionL10n != nil {
+		details.WriteString(": " + *job.DescriptionL10n)
+	}
+	for i, step := range append([]clientgen.JobInstance{job}, job.Leafs...) {
+		messages := JobErrorMessages(step)
+		// a job without steps is its own leaf
+		if len(messages) == 0 || (i > 0 && step.Id != nil && *step.Id == jobID) {
+			continue
+		}
+		details.WriteString("\n- ")
+		if step.DescriptionL10n != nil {
+			details.WriteString(*step.DescriptionL10n + ": ")
+		}
+		details.WriteString(strings.Join(messages, " "))
+	}
+	return details.String()
+}
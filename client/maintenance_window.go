@@ -0,0 +1,123 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sync"
+
+	"terraform-provider-powerstore/clientgen"
+)
+
+// maintenanceWindows tracks the maintenance windows opened automatically around disruptive operations
+// Windows are opened by the first running operation and closed when the last one finishes,
+// so that parallel resource operations share the same windows
+type maintenanceWindows struct {
+	mu       sync.Mutex
+	duration int32
+	running  int
+	opened   []string
+}
+
+// SetAutoMaintenanceWindow enables opening maintenance windows on all appliances around disruptive operations
+// The duration in seconds only bounds the window in case the provider exits before closing it
+func (c *Client) SetAutoMaintenanceWindow(duration int32) {
+	c.maintenance = &maintenanceWindows{
+		duration: duration,
+	}
+}
+
+// WithMaintenanceWindow runs a disruptive operation inside maintenance windows on all appliances
+// if automatic maintenance windows are enabled, otherwise it just runs the operation
+// Only the windows that were closed before the operation are closed again afterwards
+func (c *Client) WithMaintenanceWindow(ctx context.Context, operation func() error) error {
+	m := c.maintenance
+	if m == nil {
+		return operation()
+	}
+
+	if err := c.openMaintenanceWindows(ctx, m); err != nil {
+		return err
+	}
+	opErr := operation()
+	closeErr := c.closeMaintenanceWindows(ctx, m)
+	if opErr != nil {
+		return opErr
+	}
+	return closeErr
+}
+
+// openMaintenanceWindows enables the closed maintenance windows if no other operation holds them open
+func (c *Client) openMaintenanceWindows(ctx context.Context, m *maintenanceWindows) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.running == 0 {
+		queries := make(url.Values)
+		queries.Set("select", "id,appliance_id,is_enabled")
+		windows, _, err := c.GenClient.MaintenanceWindowApi.GetAllMaintenanceWindows(ctx).Queries(queries).Execute()
+		if err != nil {
+			return fmt.Errorf("could not read maintenance windows: %w", err)
+		}
+		for _, window := range windows {
+			if window.Id == nil || (window.IsEnabled != nil && *window.IsEnabled) {
+				continue
+			}
+			_, err := c.GenClient.MaintenanceWindowApi.PatchMaintenanceWindowById(ctx, *window.Id).Body(clientgen.MaintenanceWindowModify{
+				IsEnabled: clientgen.PtrBool(true),
+				EndOffset: &m.duration,
+			}).Execute()
+			if err != nil {
+				m.closeOpened(ctx, c)
+				return fmt.Errorf("could not open maintenance window %s: %w", *window.Id, err)
+			}
+			m.opened = append(m.opened, *window.Id)
+		}
+	}
+	m.running++
+	return nil
+}
+
+// closeMaintenanceWindows disables the opened maintenance windows once the last operation is done
+func (c *Client) closeMaintenanceWindows(ctx context.Context, m *maintenanceWindows) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.running--
+	if m.running > 0 {
+		return nil
+	}
+	return m.closeOpened(ctx, c)
+}
+
+// closeOpened disables all the maintenance windows opened by the provider, the caller must hold the lock
+func (m *maintenanceWindows) closeOpened(ctx context.Context, c *Client) error {
+	var err error
+	for _, id := range m.opened {
+		_, patchErr := c.GenClient.MaintenanceWindowApi.PatchMaintenanceWindowById(ctx, id).Body(clientgen.MaintenanceWindowModify{
+			IsEnabled: clientgen.PtrBool(false),
+		}).Execute()
+		if patchErr != nil && err == nil {
+			err = fmt.Errorf("could not close maintenance window %s: %w", id, patchErr)
+		}
+	}
+	m.opened = nil
+	return err
+}
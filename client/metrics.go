@@ -0,0 +1,109 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/dell/gopowerstore"
+)
+
+const (
+	metricsURL = "metrics"
+)
+
+// PerformanceMetrics is a sample returned by a performance_metrics_by_* metrics request
+// Twenty_Sec samples carry the raw counters while rolled up intervals carry the avg_ and max_ counters
+type PerformanceMetrics struct {
+	Timestamp         *time.Time `json:"timestamp,omitempty"`
+	RepeatCount       *int64     `json:"repeat_count,omitempty"`
+	ReadIops          *float64   `json:"read_iops,omitempty"`
+	WriteIops         *float64   `json:"write_iops,omitempty"`
+	TotalIops         *float64   `json:"total_iops,omitempty"`
+	ReadBandwidth     *float64   `json:"read_bandwidth,omitempty"`
+	WriteBandwidth    *float64   `json:"write_bandwidth,omitempty"`
+	TotalBandwidth    *float64   `json:"total_bandwidth,omitempty"`
+	AvgLatency        *float64   `json:"avg_latency,omitempty"`
+	AvgReadLatency    *float64   `json:"avg_read_latency,omitempty"`
+	AvgWriteLatency   *float64   `json:"avg_write_latency,omitempty"`
+	AvgIoSize         *float64   `json:"avg_io_size,omitempty"`
+	AvgSize           *float64   `json:"avg_size,omitempty"`
+	AvgReadIops       *float64   `json:"avg_read_iops,omitempty"`
+	AvgWriteIops      *float64   `json:"avg_write_iops,omitempty"`
+	AvgTotalIops      *float64   `json:"avg_total_iops,omitempty"`
+	AvgReadBandwidth  *float64   `json:"avg_read_bandwidth,omitempty"`
+	AvgWriteBandwidth *float64   `json:"avg_write_bandwidth,omitempty"`
+	AvgTotalBandwidth *float64   `json:"avg_total_bandwidth,omitempty"`
+	MaxTotalIops      *float64   `json:"max_total_iops,omitempty"`
+	MaxIops           *float64   `json:"max_iops,omitempty"`
+	MaxTotalBandwidth *float64   `json:"max_total_bandwidth,omitempty"`
+	MaxAvgLatency     *float64   `json:"max_avg_latency,omitempty"`
+}
+
+// SpaceMetrics is a sample returned by a space_metrics_by_* metrics request
+// Five_Mins samples carry the raw counters while rolled up intervals carry the last_ counters
+type SpaceMetrics struct {
+	Timestamp              *time.Time `json:"timestamp,omitempty"`
+	LogicalProvisioned     *int64     `json:"logical_provisioned,omitempty"`
+	LogicalUsed            *int64     `json:"logical_used,omitempty"`
+	PhysicalTotal          *int64     `json:"physical_total,omitempty"`
+	PhysicalUsed           *int64     `json:"physical_used,omitempty"`
+	UniquePhysicalUsed     *int64     `json:"unique_physical_used,omitempty"`
+	DataReduction          *float64   `json:"data_reduction,omitempty"`
+	EfficiencyRatio        *float64   `json:"efficiency_ratio,omitempty"`
+	SnapshotSavings        *float64   `json:"snapshot_savings,omitempty"`
+	ThinSavings            *float64   `json:"thin_savings,omitempty"`
+	LastLogicalProvisioned *int64     `json:"last_logical_provisioned,omitempty"`
+	LastLogicalUsed        *int64     `json:"last_logical_used,omitempty"`
+	LastPhysicalTotal      *int64     `json:"last_physical_total,omitempty"`
+	LastPhysicalUsed       *int64     `json:"last_physical_used,omitempty"`
+	LastUniquePhysicalUsed *int64     `json:"last_unique_physical_used,omitempty"`
+}
+
+// GetPerformanceMetrics returns the performance metrics of an entity at the given interval
+func (c *Client) GetPerformanceMetrics(ctx context.Context, entity, entityID, interval string) ([]PerformanceMetrics, error) {
+	var result []PerformanceMetrics
+	err := c.generateMetrics(ctx, entity, entityID, interval, &result)
+	return result, err
+}
+
+// GetSpaceMetrics returns the space metrics of an entity at the given interval
+func (c *Client) GetSpaceMetrics(ctx context.Context, entity, entityID, interval string) ([]SpaceMetrics, error) {
+	var result []SpaceMetrics
+	err := c.generateMetrics(ctx, entity, entityID, interval, &result)
+	return result, err
+}
+
+// generateMetrics decodes the samples of a metrics request into result
+func (c *Client) generateMetrics(ctx context.Context, entity, entityID, interval string, result interface{}) error {
+	_, err := c.PStoreClient.APIClient().Query(
+		ctx,
+		gopowerstore.RequestConfig{
+			Method:   "POST",
+			Endpoint: metricsURL,
+			Action:   "generate",
+			Body: &gopowerstore.MetricsRequest{
+				Entity:   entity,
+				EntityID: entityID,
+				Interval: interval,
+			},
+		},
+		result)
+	return gopowerstore.WrapErr(err)
+}
@@ -0,0 +1,158 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"terraform-provider-powerstore/clientgen"
+
+	"github.com/dell/gopowerstore"
+)
+
+const (
+	softwarePackageURL = "software_package"
+)
+
+// StartSoftwarePackageJob starts an action of a software package, such as puhc or install, as a background job
+// and returns the id of the job. Both actions can take hours and install cannot return synchronously when the
+// primary appliance reboots, so they are always run asynchronously.
+func (c *Client) StartSoftwarePackageJob(ctx context.Context, id, action string) (string, error) {
+	var resp gopowerstore.CreateResponse
+	_, err := c.PStoreClient.APIClient().Query(
+		ctx,
+		gopowerstore.RequestConfig{
+			Method:      "POST",
+			Endpoint:    softwarePackageURL,
+			ID:          id,
+			Action:      action,
+			QueryParams: c.PStoreClient.APIClient().QueryParams().Async(true),
+			Body:        &struct{}{},
+		},
+		&resp)
+	return resp.ID, gopowerstore.WrapErr(err)
+}
+
+// UploadSoftwarePackage streams a software package file to the array and returns the id of the uploaded package along
+// with the SHA-256 checksum of the uploaded content.
+// Packages are several GB large, so the file is never loaded in memory and the upload is not bound by the timeout
+// of the API client. Any error while reading the file fails the upload instead of sending a truncated package.
+func (c *Client) UploadSoftwarePackage(ctx context.Context, filePath string) (string, string, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return "", "", err
+	}
+	defer file.Close()
+
+	body, bodyWriter := io.Pipe()
+	form := multipart.NewWriter(bodyWriter)
+	checksum := sha256.New()
+	copied := make(chan error, 1)
+	go func() {
+		part, err := form.CreateFormFile("upload_file", filepath.Base(filePath))
+		if err == nil {
+			_, err = io.Copy(part, io.TeeReader(file, checksum))
+		}
+		if err == nil {
+			err = form.Close()
+		}
+		bodyWriter.CloseWithError(err)
+		copied <- err
+	}()
+
+	cfg := c.GenClient.GetConfig()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, cfg.Servers[0].URL+"/"+softwarePackageURL, body)
+	if err != nil {
+		body.CloseWithError(err)
+		<-copied
+		return "", "", err
+	}
+	req.Header.Set("Content-Type", form.FormDataContentType())
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", cfg.UserAgent)
+	for name, value := range cfg.DefaultHeader {
+		req.Header.Set(name, value)
+	}
+
+	httpClient := *cfg.HTTPClient
+	httpClient.Timeout = 0
+	resp, err := httpClient.Do(req)
+	// unblock the copy if the array answered before reading the whole package
+	body.Close()
+	copyErr := <-copied
+	if copyErr != nil && !errors.Is(copyErr, io.ErrClosedPipe) {
+		return "", "", fmt.Errorf("could not read software package file %s: %w", filePath, copyErr)
+	}
+	if err != nil {
+		return "", "", err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", "", err
+	}
+	if resp.StatusCode >= 300 {
+		var errResp clientgen.ErrorResponse
+		var messages []string
+		if json.Unmarshal(respBody, &errResp) == nil {
+			for _, message := range errResp.Messages {
+				if message.MessageL10n != nil {
+					messages = append(messages, *message.MessageL10n)
+				}
+			}
+		}
+		return "", "", fmt.Errorf("%s %s", resp.Status, strings.Join(messages, " "))
+	}
+	if copyErr != nil {
+		return "", "", fmt.Errorf("upload of software package file %s was interrupted: %w", filePath, copyErr)
+	}
+	var created clientgen.CreateResponse
+	if err := json.Unmarshal(respBody, &created); err != nil {
+		return "", "", err
+	}
+	if created.Id == nil {
+		return "", "", fmt.Errorf("no id returned for the uploaded software package")
+	}
+	return *created.Id, hex.EncodeToString(checksum.Sum(nil)), nil
+}
+
+// SoftwarePackageChecksum returns the SHA-256 checksum of a software package file
+func SoftwarePackageChecksum(filePath string) (string, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	checksum := sha256.New()
+	if _, err := io.Copy(checksum, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(checksum.Sum(nil)), nil
+}
@@ -48,9 +48,97 @@ type APIClient struct {
 
 	// API Services
 
+	AlertApi *AlertApiService
+
+	ApplianceApi *ApplianceApiService
+
+	AuditEventApi *AuditEventApiService
+
+	BondApi *BondApiService
+
+	ClusterApi *ClusterApiService
+
+	DatastoreApi *DatastoreApiService
+
+	DnsApi *DnsApiService
+
+	EmailNotifyDestinationApi *EmailNotifyDestinationApiService
+
+	EthBePortApi *EthBePortApiService
+
+	EthPortApi *EthPortApiService
+
+	EventApi *EventApiService
+
+	FcPortApi *FcPortApiService
+
+	FileDhsmConfigApi *FileDhsmConfigApiService
+
+	FileEventsPoolApi *FileEventsPoolApiService
+
+	FileEventsPublisherApi *FileEventsPublisherApiService
+
+	FileFtpApi *FileFtpApiService
+
+	FileNdmpApi *FileNdmpApiService
+
+	FileVirusCheckerApi *FileVirusCheckerApiService
+
+	HardwareApi *HardwareApiService
+
+	IpPortApi *IpPortApiService
+
+	JobApi *JobApiService
+
+	KmipConfigApi *KmipConfigApiService
+
+	LdapAccountApi *LdapAccountApiService
+
+	LdapDomainApi *LdapDomainApiService
+
+	LocalUserApi *LocalUserApiService
+
+	LoginBannerApi *LoginBannerApiService
+
 	LoginSessionApi *LoginSessionApiService
 
+	MaintenanceWindowApi *MaintenanceWindowApiService
+
+	NasServerApi *NasServerApiService
+
+	NetworkApi *NetworkApiService
+
+	NodeApi *NodeApiService
+
+	NtpApi *NtpApiService
+
+	RemoteSyslogServerApi *RemoteSyslogServerApiService
+
+	RoleApi *RoleApiService
+
+	SasPortApi *SasPortApiService
+
+	SecurityConfigApi *SecurityConfigApiService
+
+	SmtpConfigApi *SmtpConfigApiService
+
+	SnapshotRuleApi *SnapshotRuleApiService
+
+	SnmpServerApi *SnmpServerApiService
+
+	SoftwareInstalledApi *SoftwareInstalledApiService
+
+	SoftwarePackageApi *SoftwarePackageApiService
+
+	VethPortApi *VethPortApiService
+
+	VolumeApi *VolumeApiService
+
 	VolumeGroupApi *VolumeGroupApiService
+
+	VsphereHostApi *VsphereHostApiService
+
+	X509CertificateApi *X509CertificateApiService
 }
 
 type service struct {
@@ -69,8 +157,52 @@ func NewAPIClient(cfg *Configuration) *APIClient {
 	c.common.client = c
 
 	// API Services
+	c.AlertApi = (*AlertApiService)(&c.common)
+	c.ApplianceApi = (*ApplianceApiService)(&c.common)
+	c.AuditEventApi = (*AuditEventApiService)(&c.common)
+	c.BondApi = (*BondApiService)(&c.common)
+	c.ClusterApi = (*ClusterApiService)(&c.common)
+	c.DatastoreApi = (*DatastoreApiService)(&c.common)
+	c.DnsApi = (*DnsApiService)(&c.common)
+	c.EmailNotifyDestinationApi = (*EmailNotifyDestinationApiService)(&c.common)
+	c.EthBePortApi = (*EthBePortApiService)(&c.common)
+	c.EthPortApi = (*EthPortApiService)(&c.common)
+	c.EventApi = (*EventApiService)(&c.common)
+	c.FcPortApi = (*FcPortApiService)(&c.common)
+	c.FileDhsmConfigApi = (*FileDhsmConfigApiService)(&c.common)
+	c.FileEventsPoolApi = (*FileEventsPoolApiService)(&c.common)
+	c.FileEventsPublisherApi = (*FileEventsPublisherApiService)(&c.common)
+	c.FileFtpApi = (*FileFtpApiService)(&c.common)
+	c.FileNdmpApi = (*FileNdmpApiService)(&c.common)
+	c.FileVirusCheckerApi = (*FileVirusCheckerApiService)(&c.common)
+	c.HardwareApi = (*HardwareApiService)(&c.common)
+	c.IpPortApi = (*IpPortApiService)(&c.common)
+	c.JobApi = (*JobApiService)(&c.common)
+	c.KmipConfigApi = (*KmipConfigApiService)(&c.common)
+	c.LdapAccountApi = (*LdapAccountApiService)(&c.common)
+	c.LdapDomainApi = (*LdapDomainApiService)(&c.common)
+	c.LocalUserApi = (*LocalUserApiService)(&c.common)
+	c.LoginBannerApi = (*LoginBannerApiService)(&c.common)
 	c.LoginSessionApi = (*LoginSessionApiService)(&c.common)
+	c.MaintenanceWindowApi = (*MaintenanceWindowApiService)(&c.common)
+	c.NasServerApi = (*NasServerApiService)(&c.common)
+	c.NetworkApi = (*NetworkApiService)(&c.common)
+	c.NodeApi = (*NodeApiService)(&c.common)
+	c.NtpApi = (*NtpApiService)(&c.common)
+	c.RemoteSyslogServerApi = (*RemoteSyslogServerApiService)(&c.common)
+	c.RoleApi = (*RoleApiService)(&c.common)
+	c.SasPortApi = (*SasPortApiService)(&c.common)
+	c.SecurityConfigApi = (*SecurityConfigApiService)(&c.common)
+	c.SmtpConfigApi = (*SmtpConfigApiService)(&c.common)
+	c.SnapshotRuleApi = (*SnapshotRuleApiService)(&c.common)
+	c.SnmpServerApi = (*SnmpServerApiService)(&c.common)
+	c.SoftwareInstalledApi = (*SoftwareInstalledApiService)(&c.common)
+	c.SoftwarePackageApi = (*SoftwarePackageApiService)(&c.common)
+	c.VethPortApi = (*VethPortApiService)(&c.common)
+	c.VolumeApi = (*VolumeApiService)(&c.common)
 	c.VolumeGroupApi = (*VolumeGroupApiService)(&c.common)
+	c.VsphereHostApi = (*VsphereHostApiService)(&c.common)
+	c.X509CertificateApi = (*X509CertificateApiService)(&c.common)
 
 	return c
 }
@@ -18,7 +18,7 @@ import json
 
 from requiredApis import RequiredAPIs
 from commonUtils import ProcessOpenapiSpec
-from powerStoreUtils import AddPowerStoreOpIds, AddPowerStoreFlexibleQuery
+from powerStoreUtils import AddPowerStoreOpIds, AddPowerStoreFlexibleQuery, RemoveDuplicateDeprecatedProperties, AddJobResponseMessages
 
 parser = argparse.ArgumentParser(description='Process PowerStore OpenAPI spec.')
 parser.add_argument('--input', help='Input PowerStore OpenAPI spec file path.', required=True)
@@ -32,6 +32,8 @@ filtered_json = ProcessOpenapiSpec(args.input, RequiredAPIs)
 # powerstore specific processing
 filtered_json = AddPowerStoreOpIds(filtered_json)
 filtered_json = AddPowerStoreFlexibleQuery(filtered_json)
+filtered_json = RemoveDuplicateDeprecatedProperties(filtered_json)
+filtered_json = AddJobResponseMessages(filtered_json)
 
 # write to file
 with open(args.output, 'w') as outfile:
@@ -72,3 +72,46 @@ def AddPowerStoreFlexibleQuery(json_obj: dict) -> dict:
         if "get" in json_obj['paths'][key]:
             json_obj['paths'][key]['get']['x-flexible-query'] = "true"
     return json_obj
+
+def RemoveDuplicateDeprecatedProperties(json_obj: dict) -> dict:
+    """
+    Removes deprecated camelCase properties which duplicate a snake_case property of the same model.
+    Some PowerStore models (eg. validate_create_issue) expose both appliance_id and applianceId,
+    which would generate the same Go field name.
+
+    Args:
+        json_obj (dict): The JSON OpenAPI spec of PowerStore.
+
+    Returns:
+        dict: The modified JSON OpenAPI spec.
+    """
+    for model in json_obj.get('definitions', {}).values():
+        props = model.get('properties', {})
+        normalized = {key.replace('_', '').lower(): key for key in props if '_' in key}
+        for key in list(props):
+            if '_' in key or 'x-deprecated' not in props[key]:
+                continue
+            if key.lower() in normalized:
+                del props[key]
+    return json_obj
+
+def AddJobResponseMessages(json_obj: dict) -> dict:
+    """
+    Adds the error messages to base_response, the response body of a job.
+    A failed job stores its error_response as response body, but base_response only declares
+    the response_type discriminator, so the messages would be dropped when decoding a job.
+
+    Args:
+        json_obj (dict): The JSON OpenAPI spec of PowerStore.
+
+    Returns:
+        dict: The modified JSON OpenAPI spec.
+    """
+    definitions = json_obj.get('definitions', {})
+    if 'base_response' in definitions and 'error_message' in definitions:
+        definitions['base_response'].setdefault('properties', {})['messages'] = {
+            'type': 'array',
+            'description': 'Error messages of a failed job.',
+            'items': {'$ref': '#/definitions/error_message'},
+        }
+    return json_obj
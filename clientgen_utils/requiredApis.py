@@ -18,5 +18,95 @@ RequiredAPIs = [
     "/volume_group/{id}",
     "/volume_group/{id}/add_members",
     "/volume_group/{id}/remove_members",
-    "/login_session"
-]
\ No newline at end of file
+    "/login_session",
+    "/appliance",
+    "/appliance/{id}",
+    "/cluster",
+    "/cluster/{id}",
+    "/node",
+    "/node/{id}",
+    "/hardware",
+    "/hardware/{id}",
+    "/eth_port",
+    "/eth_port/{id}",
+    "/fc_port",
+    "/fc_port/{id}",
+    "/eth_be_port",
+    "/eth_be_port/{id}",
+    "/sas_port",
+    "/sas_port/{id}",
+    "/veth_port",
+    "/veth_port/{id}",
+    "/bond",
+    "/bond/{id}",
+    "/network",
+    "/network/{id}",
+    "/ip_port",
+    "/ip_port/{id}",
+    "/maintenance_window",
+    "/maintenance_window/{id}",
+    "/software_installed",
+    "/software_installed/{id}",
+    "/software_package",
+    "/software_package/{id}",
+    "/software_package/{id}/puhc",
+    "/software_package/{id}/install",
+    "/local_user",
+    "/local_user/{id}",
+    "/role",
+    "/role/{id}",
+    "/ldap_domain",
+    "/ldap_domain/{id}",
+    "/ldap_account",
+    "/ldap_account/{id}",
+    "/x509_certificate",
+    "/x509_certificate/{id}",
+    "/ntp",
+    "/ntp/{id}",
+    "/dns",
+    "/dns/{id}",
+    "/smtp_config",
+    "/smtp_config/{id}",
+    "/email_notify_destination",
+    "/email_notify_destination/{id}",
+    "/snmp_server",
+    "/snmp_server/{id}",
+    "/remote_syslog_server",
+    "/remote_syslog_server/{id}",
+    "/alert",
+    "/alert/{id}",
+    "/event",
+    "/event/{id}",
+    "/file_virus_checker",
+    "/file_virus_checker/{id}",
+    "/file_virus_checker/{id}/upload_config",
+    "/file_virus_checker/{id}/download_config",
+    "/file_ftp",
+    "/file_ftp/{id}",
+    "/file_ndmp",
+    "/file_ndmp/{id}",
+    "/file_events_pool",
+    "/file_events_pool/{id}",
+    "/file_events_publisher",
+    "/file_events_publisher/{id}",
+    "/nas_server/{id}",
+    "/file_dhsm_config",
+    "/file_dhsm_config/{id}",
+    "/snapshot_rule",
+    "/snapshot_rule/{id}",
+    "/volume/{id}",
+    "/kmip_config",
+    "/kmip_config/{id}",
+    "/kmip_config/{id}/verify",
+    "/security_config",
+    "/security_config/{id}",
+    "/login_banner",
+    "/login_banner/{id}",
+    "/audit_event",
+    "/job",
+    "/job/{id}",
+    "/datastore",
+    "/datastore/{id}",
+    "/vsphere_host",
+    "/vsphere_host/{id}"
+]
@@ -0,0 +1,53 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# commands to run this tf file : terraform init && terraform apply --auto-approve
+
+# fetching all alerts on the array
+data "powerstore_alert" "all_alerts" {
+}
+
+# fetching alert using id
+data "powerstore_alert" "alert_by_id" {
+  id = "0b0a2ac4-0b7c-4c5e-9c0a-5b5d8e5e6f6a"
+}
+
+# fetching alerts generated by a resource using its name
+data "powerstore_alert" "alert_by_resource_name" {
+  resource_name = "Appliance-WX-H6121"
+}
+
+# Fetching alerts using filter expression
+# This filter expression will fetch all the active critical alerts
+data "powerstore_alert" "active_critical_alerts" {
+  filter_expression = "state=eq.ACTIVE&severity=eq.Critical"
+}
+
+# Refuse to continue when the array has active critical alerts
+resource "terraform_data" "array_healthy" {
+  lifecycle {
+    precondition {
+      condition     = length(data.powerstore_alert.active_critical_alerts.alerts) == 0
+      error_message = "The PowerStore array has active critical alerts."
+    }
+  }
+}
+
+# Output all alert Details
+output "alert_all_details" {
+  value = data.powerstore_alert.all_alerts.alerts
+}
@@ -0,0 +1,54 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# commands to run this tf file : terraform init && terraform apply --auto-approve
+
+# fetching all Appliances on the array
+data "powerstore_appliance" "all_appliances" {
+}
+
+# fetching Appliance using id
+data "powerstore_appliance" "appliance_by_id" {
+  id = "A1"
+}
+
+# fetching Appliance using name
+data "powerstore_appliance" "appliance_by_name" {
+  name = "Appliance-WND8977"
+}
+
+# Fetching Appliances using filter expression
+# This filter expression will fetch all the Unified Appliances
+data "powerstore_appliance" "appliance_by_filters" {
+  filter_expression = "mode=eq.Unified"
+}
+
+# Output all Appliance Details
+output "appliances_all_details" {
+  value = data.powerstore_appliance.all_appliances.appliances
+}
+
+# Output Appliance model, service tag and used capacity with Appliance name as key
+output "appliance_model_and_capacity" {
+  value = {
+    for appliance in data.powerstore_appliance.all_appliances.appliances : appliance.name => {
+      model         = appliance.model
+      service_tag   = appliance.service_tag
+      physical_used = appliance.capacity.physical_used
+    }
+  }
+}
@@ -0,0 +1,47 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# commands to run this tf file : terraform init && terraform apply --auto-approve
+
+# fetching all audit events on the array, most recent first
+data "powerstore_audit_event" "all_audit_events" {
+}
+
+# fetching the configuration changes made during a pipeline run
+data "powerstore_audit_event" "pipeline_changes" {
+  start_time    = "2025-01-15T10:00:00Z"
+  end_time      = "2025-01-15T11:00:00Z"
+  resource_type = "volume"
+  is_successful = true
+}
+
+# fetching the failed operations of a user
+data "powerstore_audit_event" "failed_by_user" {
+  username      = "admin"
+  is_successful = false
+}
+
+# Fetching audit events using filter expression
+# This filter expression will fetch the configuration changes
+data "powerstore_audit_event" "audit_event_by_filters" {
+  filter_expression = "type=eq.Config"
+}
+
+# Output the users other than the Terraform service account which made changes during the pipeline run
+output "unexpected_users" {
+  value = distinct([for event in data.powerstore_audit_event.pipeline_changes.audit_events : event.username if event.username != "terraform"])
+}
@@ -0,0 +1,51 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# commands to run this tf file : terraform init && terraform apply --auto-approve
+
+# fetching the Cluster on the array
+data "powerstore_cluster" "all_clusters" {
+}
+
+# fetching Cluster using id
+data "powerstore_cluster" "cluster_by_id" {
+  id = "0"
+}
+
+# fetching Cluster using name
+data "powerstore_cluster" "cluster_by_name" {
+  name = "WN-D8977"
+}
+
+# Fetching Clusters using filter expression
+# This filter expression will fetch the Cluster with encryption enabled
+data "powerstore_cluster" "cluster_by_filters" {
+  filter_expression = "is_encryption_enabled=eq.true"
+}
+
+# Output all Cluster Details
+output "clusters_all_details" {
+  value = data.powerstore_cluster.all_clusters.clusters
+}
+
+# Output the management address and capacity of the Cluster
+output "cluster_management_address_and_capacity" {
+  value = {
+    management_address = data.powerstore_cluster.all_clusters.clusters[0].management_address
+    capacity           = data.powerstore_cluster.all_clusters.clusters[0].capacity
+  }
+}
@@ -0,0 +1,52 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# commands to run this tf file : terraform init && terraform apply --auto-approve
+
+# fetching all datastores on the array
+data "powerstore_datastore" "all_datastores" {
+}
+
+# fetching datastore using id
+data "powerstore_datastore" "datastore_by_id" {
+  id = "a3b7c9d1-0e2f-4a5b-8c6d-7e8f9a0b1c2d"
+}
+
+# fetching datastore using name
+data "powerstore_datastore" "datastore_by_name" {
+  name = "vvol-datastore"
+}
+
+# Fetching datastores using filter expression
+# This filter expression will fetch all the NFS datastores
+data "powerstore_datastore" "datastore_by_filters" {
+  filter_expression = "type=eq.NFS"
+}
+
+# Output all datastore Details
+output "datastore_all_details" {
+  value = data.powerstore_datastore.all_datastores.datastores
+}
+
+# Output the IDs of all volumes and file systems backing a datastore.
+# These can be checked before destroying the corresponding storage resources.
+output "storage_in_use_by_datastores" {
+  value = {
+    volume_ids      = flatten(data.powerstore_datastore.all_datastores.datastores[*].volume_ids)
+    file_system_ids = compact(data.powerstore_datastore.all_datastores.datastores[*].file_system_id)
+  }
+}
@@ -0,0 +1,43 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# commands to run this tf file : terraform init && terraform apply --auto-approve
+
+# fetching all back-end Ethernet ports on the array
+data "powerstore_eth_be_port" "all_eth_be_ports" {
+}
+
+# fetching back-end Ethernet port using id
+data "powerstore_eth_be_port" "eth_be_port_by_id" {
+  id = "0b9a8c7d6e5f4a3b2c1d0e9f8a7b6c5d"
+}
+
+# fetching back-end Ethernet port using name
+data "powerstore_eth_be_port" "eth_be_port_by_name" {
+  name = "BaseEnclosure-NodeA-EmbeddedModule-BEPort0"
+}
+
+# Fetching back-end Ethernet ports using filter expression
+# This filter expression will fetch all the back-end Ethernet ports whose link is down
+data "powerstore_eth_be_port" "eth_be_port_by_filters" {
+  filter_expression = "is_link_up=eq.false"
+}
+
+# Output all back-end Ethernet port Details
+output "eth_be_port_all_details" {
+  value = data.powerstore_eth_be_port.all_eth_be_ports.eth_be_ports
+}
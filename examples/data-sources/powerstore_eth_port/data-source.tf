@@ -0,0 +1,43 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# commands to run this tf file : terraform init && terraform apply --auto-approve
+
+# fetching all Ethernet ports on the array
+data "powerstore_eth_port" "all_eth_ports" {
+}
+
+# fetching Ethernet port using id
+data "powerstore_eth_port" "eth_port_by_id" {
+  id = "a1b2c3d4e5f64a7b8c9d0e1f2a3b4c5d"
+}
+
+# fetching Ethernet port using name
+data "powerstore_eth_port" "eth_port_by_name" {
+  name = "BaseEnclosure-NodeA-EmbeddedModule-MezzCard-FEPort0"
+}
+
+# Fetching Ethernet ports using filter expression
+# This filter expression will fetch all the Ethernet ports whose link is down
+data "powerstore_eth_port" "eth_port_by_filters" {
+  filter_expression = "is_link_up=eq.false"
+}
+
+# Output all Ethernet port Details
+output "eth_port_all_details" {
+  value = data.powerstore_eth_port.all_eth_ports.eth_ports
+}
@@ -0,0 +1,43 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# commands to run this tf file : terraform init && terraform apply --auto-approve
+
+# fetching all events on the array
+data "powerstore_event" "all_events" {
+}
+
+# fetching event using id
+data "powerstore_event" "event_by_id" {
+  id = "0b0a2ac4-0b7c-4c5e-9c0a-5b5d8e5e6f6a"
+}
+
+# fetching events generated by a resource using its name
+data "powerstore_event" "event_by_resource_name" {
+  resource_name = "Appliance-WX-H6121"
+}
+
+# Fetching events using filter expression
+# This filter expression will fetch all the major events generated after the given time
+data "powerstore_event" "event_by_filters" {
+  filter_expression = "severity=eq.Major&generated_timestamp=gt.2025-01-01T00:00:00Z"
+}
+
+# Output all event Details
+output "event_all_details" {
+  value = data.powerstore_event.all_events.events
+}
@@ -0,0 +1,43 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# commands to run this tf file : terraform init && terraform apply --auto-approve
+
+# fetching all FC ports on the array
+data "powerstore_fc_port" "all_fc_ports" {
+}
+
+# fetching FC port using id
+data "powerstore_fc_port" "fc_port_by_id" {
+  id = "6e4f0b2d1a9c4c7e8f3d2b1a0c9e8d7f"
+}
+
+# fetching FC port using name
+data "powerstore_fc_port" "fc_port_by_name" {
+  name = "BaseEnclosure-NodeA-IoModule0-FEPort0"
+}
+
+# Fetching FC ports using filter expression
+# This filter expression will fetch all the FC ports whose link is up
+data "powerstore_fc_port" "fc_port_by_filters" {
+  filter_expression = "is_link_up=eq.true"
+}
+
+# Output all FC port Details
+output "fc_port_all_details" {
+  value = data.powerstore_fc_port.all_fc_ports.fc_ports
+}
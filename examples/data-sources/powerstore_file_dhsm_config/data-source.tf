@@ -0,0 +1,43 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# commands to run this tf file : terraform init && terraform apply --auto-approve
+
+# fetching all DHSM services on the array
+data "powerstore_file_dhsm_config" "all_dhsm_configs" {
+}
+
+# fetching DHSM service using id
+data "powerstore_file_dhsm_config" "dhsm_config_by_id" {
+  id = "65a4e2a8-8a2b-9e26-4bd6-3a3d1c5e3d0e"
+}
+
+# fetching DHSM service using the id of its NAS server
+data "powerstore_file_dhsm_config" "dhsm_config_by_nas_server" {
+  nas_server_id = "654b2ba6-cdd8-9ce6-2ac5-a6d3d04b7f2c"
+}
+
+# Fetching DHSM services using filter expression
+# This filter expression will fetch the DHSM services of the given user
+data "powerstore_file_dhsm_config" "dhsm_config_by_filters" {
+  filter_expression = "user_name=eq.dhsm_archive"
+}
+
+# Output all DHSM service Details
+output "file_dhsm_config_all_details" {
+  value = data.powerstore_file_dhsm_config.all_dhsm_configs.file_dhsm_configs
+}
@@ -0,0 +1,69 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# commands to run this tf file : terraform init && terraform apply --auto-approve
+
+# fetching all Hardware components on the array
+data "powerstore_hardware" "all_hardware" {
+}
+
+# fetching Hardware component using id
+data "powerstore_hardware" "hardware_by_id" {
+  id = "2b6c5ec4e4e14b6b9a34c9c3d1d14c5a"
+}
+
+# fetching Hardware component using name
+data "powerstore_hardware" "hardware_by_name" {
+  name = "BaseEnclosure-Drive-0"
+}
+
+# fetching all Drives on the array
+# type can be one of Drive, SFP, DIMM, Power_Supply, Base_Enclosure, Expansion_Enclosure, etc.
+data "powerstore_hardware" "drives" {
+  type = "Drive"
+}
+
+# Fetching Hardware components using filter expression
+# This filter expression will fetch all the Hardware components which are not healthy
+data "powerstore_hardware" "hardware_by_filters" {
+  filter_expression = "lifecycle_state=neq.Healthy"
+}
+
+# Output all Hardware Details
+output "hardware_all_details" {
+  value = data.powerstore_hardware.all_hardware.hardware
+}
+
+# Output encryption and FIPS status of every Drive with Drive name as key
+output "drive_encryption_and_fips_status" {
+  value = {
+    for drive in data.powerstore_hardware.drives.hardware : drive.name => {
+      encryption_status = drive.extra_details.encryption_status
+      fips_status       = drive.extra_details.fips_status
+    }
+  }
+}
+
+# Fail the run if any Drive is not encrypted
+check "drives_encrypted" {
+  assert {
+    condition = alltrue([
+      for drive in data.powerstore_hardware.drives.hardware : drive.extra_details.encryption_status != "Disabled"
+    ])
+    error_message = "All Drives on the array should be encrypted."
+  }
+}
@@ -0,0 +1,43 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# commands to run this tf file : terraform init && terraform apply --auto-approve
+
+# fetching all IP ports on the array
+data "powerstore_ip_port" "all_ip_ports" {
+}
+
+# fetching IP port using id
+data "powerstore_ip_port" "ip_port_by_id" {
+  id = "IP_PORT1"
+}
+
+# fetching IP ports configured on an Ethernet port
+data "powerstore_ip_port" "ip_port_by_eth_port_id" {
+  eth_port_id = "a1b2c3d4e5f64a7b8c9d0e1f2a3b4c5d"
+}
+
+# Fetching IP ports using filter expression
+# This filter expression will fetch all the IP ports which can be used for iSCSI
+data "powerstore_ip_port" "ip_port_by_filters" {
+  filter_expression = "available_usages=cs.{ISCSI}"
+}
+
+# Output all IP port Details
+output "ip_port_all_details" {
+  value = data.powerstore_ip_port.all_ip_ports.ip_ports
+}
@@ -0,0 +1,43 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# commands to run this tf file : terraform init && terraform apply --auto-approve
+
+# fetching all jobs on the array
+data "powerstore_job" "all_jobs" {
+}
+
+# fetching job using id, eg. the job id reported in the error of a failed resource operation
+data "powerstore_job" "job_by_id" {
+  id = "8c1f0a7e-3b4d-4e2a-9d6f-1a2b3c4d5e6f"
+}
+
+# fetching jobs which operated on a resource using its id
+data "powerstore_job" "job_by_resource_id" {
+  resource_id = "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"
+}
+
+# Fetching jobs using filter expression
+# This filter expression will fetch all the failed jobs started after the given time
+data "powerstore_job" "job_by_filters" {
+  filter_expression = "state=eq.FAILED&start_time=gt.2025-01-01T00:00:00Z"
+}
+
+# Output the error messages of the failed jobs
+output "failed_job_errors" {
+  value = { for job in data.powerstore_job.job_by_filters.jobs : job.id => concat(job.error_messages, flatten(job.child_jobs[*].error_messages)) }
+}
@@ -0,0 +1,32 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# commands to run this tf file : terraform init && terraform apply --auto-approve
+
+# fetching the KMIP configuration with the status of the last verification
+data "powerstore_kmip_server" "kmip" {
+}
+
+# verifying the connectivity to the KMIP servers before fetching their status
+data "powerstore_kmip_server" "kmip_verified" {
+  verify = true
+}
+
+# Output the KMIP servers which are not reachable
+output "kmip_servers_down" {
+  value = [for server in data.powerstore_kmip_server.kmip_verified.servers : server.address if server.status != "Up"]
+}
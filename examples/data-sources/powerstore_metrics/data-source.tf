@@ -0,0 +1,47 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# commands to run this tf file : terraform init && terraform apply --auto-approve
+
+# fetching the performance metrics of an appliance at the default interval of five minutes
+data "powerstore_metrics" "appliance" {
+  entity_type = "appliance"
+  entity_id   = "A1"
+}
+
+# fetching the performance metrics of a volume at twenty seconds interval
+data "powerstore_metrics" "volume" {
+  entity_type = "volume"
+  entity_id   = "0b0a2ac4-0b7c-4c5e-9c0a-5b5d8e5e6f6a"
+  interval    = "Twenty_Sec"
+}
+
+# gating a change on the current load of the appliance
+# the precondition fails the plan if the latest sample is above 50000 IOPS or 5 ms of latency
+resource "terraform_data" "quiet_appliance" {
+  lifecycle {
+    precondition {
+      condition     = length(data.powerstore_metrics.appliance.metrics) == 0 || (data.powerstore_metrics.appliance.metrics[length(data.powerstore_metrics.appliance.metrics) - 1].total_iops < 50000 && data.powerstore_metrics.appliance.metrics[length(data.powerstore_metrics.appliance.metrics) - 1].avg_latency < 5000)
+      error_message = "Appliance A1 is under heavy load, retry the change later."
+    }
+  }
+}
+
+# Output the performance metrics of the appliance
+output "appliance_metrics" {
+  value = data.powerstore_metrics.appliance.metrics
+}
@@ -0,0 +1,43 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# commands to run this tf file : terraform init && terraform apply --auto-approve
+
+# fetching all networks on the array
+data "powerstore_network" "all_networks" {
+}
+
+# fetching network using id
+data "powerstore_network" "network_by_id" {
+  id = "NW1"
+}
+
+# fetching network using name
+data "powerstore_network" "network_by_name" {
+  name = "Default Storage Network"
+}
+
+# Fetching networks using filter expression
+# This filter expression will fetch all the storage networks
+data "powerstore_network" "network_by_filters" {
+  filter_expression = "type=eq.Storage"
+}
+
+# Output all network Details
+output "network_all_details" {
+  value = data.powerstore_network.all_networks.networks
+}
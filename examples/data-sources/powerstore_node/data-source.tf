@@ -0,0 +1,48 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# commands to run this tf file : terraform init && terraform apply --auto-approve
+
+# fetching all Nodes on the array
+data "powerstore_node" "all_nodes" {
+}
+
+# fetching Node using id
+data "powerstore_node" "node_by_id" {
+  id = "N1"
+}
+
+# fetching all Nodes of an Appliance
+data "powerstore_node" "nodes_by_appliance" {
+  appliance_id = "A1"
+}
+
+# Fetching Nodes using filter expression
+# This filter expression will fetch the Nodes in slot 0
+data "powerstore_node" "node_by_filters" {
+  filter_expression = "slot=eq.0"
+}
+
+# Output all Node Details
+output "nodes_all_details" {
+  value = data.powerstore_node.all_nodes.nodes
+}
+
+# Output only Node IDs
+output "nodes_IDs_only" {
+  value = data.powerstore_node.all_nodes.nodes.*.id
+}
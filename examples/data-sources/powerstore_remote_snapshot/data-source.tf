@@ -0,0 +1,33 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# commands to run this tf file : terraform init && terraform apply --auto-approve
+
+# fetching the remote snapshots of a volume
+data "powerstore_remote_snapshot" "volume_remote_snapshots" {
+  volume_id = "4ba4d7e1-8d21-4e5a-a5c4-1a4c9c0a3c29"
+}
+
+# fetching the remote snapshots of a volume group
+data "powerstore_remote_snapshot" "volume_group_remote_snapshots" {
+  volume_group_id = "075aeb23-c782-4cce-9372-5a2e31dc5138"
+}
+
+# Output all remote snapshot Details
+output "remote_snapshot_all_details" {
+  value = data.powerstore_remote_snapshot.volume_remote_snapshots.remote_snapshots
+}
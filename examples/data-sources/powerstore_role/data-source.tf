@@ -0,0 +1,43 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# commands to run this tf file : terraform init && terraform apply --auto-approve
+
+# fetching all roles on the array
+data "powerstore_role" "all_roles" {
+}
+
+# fetching role using id
+data "powerstore_role" "role_by_id" {
+  id = "3"
+}
+
+# fetching role using name
+data "powerstore_role" "role_by_name" {
+  name = "Operator"
+}
+
+# Fetching roles using filter expression
+# This filter expression will fetch all the built-in roles
+data "powerstore_role" "role_by_filters" {
+  filter_expression = "is_built_in=eq.true"
+}
+
+# Output all role Details
+output "role_all_details" {
+  value = data.powerstore_role.all_roles.roles
+}
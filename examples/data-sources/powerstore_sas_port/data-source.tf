@@ -0,0 +1,43 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# commands to run this tf file : terraform init && terraform apply --auto-approve
+
+# fetching all SAS ports on the array
+data "powerstore_sas_port" "all_sas_ports" {
+}
+
+# fetching SAS port using id
+data "powerstore_sas_port" "sas_port_by_id" {
+  id = "3c2b1a0f9e8d4c7b6a5f4e3d2c1b0a9f"
+}
+
+# fetching SAS port using name
+data "powerstore_sas_port" "sas_port_by_name" {
+  name = "BaseEnclosure-NodeA-EmbeddedModule-SasPort0"
+}
+
+# Fetching SAS ports using filter expression
+# This filter expression will fetch all the SAS ports which are in use
+data "powerstore_sas_port" "sas_port_by_filters" {
+  filter_expression = "is_in_use=eq.true"
+}
+
+# Output all SAS port Details
+output "sas_port_all_details" {
+  value = data.powerstore_sas_port.all_sas_ports.sas_ports
+}
@@ -0,0 +1,51 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# commands to run this tf file : terraform init && terraform apply --auto-approve
+
+# fetching the installed software of the cluster and of every appliance
+data "powerstore_software_installed" "all_software" {
+}
+
+# fetching installed software using id
+data "powerstore_software_installed" "software_by_id" {
+  id = "3b3a4ec6-9c47-4c1b-9f39-ad0c0e7b1a42"
+}
+
+# fetching installed software using release version
+data "powerstore_software_installed" "software_by_release_version" {
+  release_version = "4.1.0.0"
+}
+
+# Fetching installed software using filter expression
+# This filter expression will fetch the common software version supported on all appliances in the cluster
+data "powerstore_software_installed" "cluster_software" {
+  filter_expression = "is_cluster=eq.true"
+}
+
+# Modules can branch on features using the cluster software version
+locals {
+  cluster_version = data.powerstore_software_installed.cluster_software.software_installed[0].release_version
+}
+
+# Output the software version installed on each appliance
+output "appliance_software_versions" {
+  value = {
+    for software in data.powerstore_software_installed.all_software.software_installed :
+    software.appliance_name => software.release_version if !software.is_cluster
+  }
+}
@@ -0,0 +1,47 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# commands to run this tf file : terraform init && terraform apply --auto-approve
+
+# fetching the space metrics of the cluster
+data "powerstore_space_metrics" "cluster" {
+  entity_type = "cluster"
+  entity_id   = "0"
+}
+
+# fetching the space metrics of a volume group
+data "powerstore_space_metrics" "volume_group" {
+  entity_type = "volume_group"
+  entity_id   = "0b0a2ac4-0b7c-4c5e-9c0a-5b5d8e5e6f6a"
+}
+
+# fetching the space metrics of a file system
+data "powerstore_space_metrics" "file_system" {
+  entity_type = "file_system"
+  entity_id   = "6568c0b8-bf5d-8bb8-4c8e-1e1a2e6e7b3c"
+}
+
+# Output the capacity of the cluster and its growth over the last week
+output "cluster_capacity" {
+  value = {
+    physical_total          = data.powerstore_space_metrics.cluster.physical_total
+    physical_used           = data.powerstore_space_metrics.cluster.physical_used
+    data_reduction          = data.powerstore_space_metrics.cluster.data_reduction
+    efficiency_ratio        = data.powerstore_space_metrics.cluster.efficiency_ratio
+    physical_growth_in_week = data.powerstore_space_metrics.cluster.physical_used_growth_last_week
+  }
+}
@@ -0,0 +1,43 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# commands to run this tf file : terraform init && terraform apply --auto-approve
+
+# fetching all virtual Ethernet ports on the array
+data "powerstore_veth_port" "all_veth_ports" {
+}
+
+# fetching virtual Ethernet port using id
+data "powerstore_veth_port" "veth_port_by_id" {
+  id = "9f8e7d6c5b4a4f3e2d1c0b9a8f7e6d5c"
+}
+
+# fetching virtual Ethernet port using name
+data "powerstore_veth_port" "veth_port_by_name" {
+  name = "BaseEnclosure-NodeA-VEthPort0"
+}
+
+# Fetching virtual Ethernet ports using filter expression
+# This filter expression will fetch all the virtual Ethernet ports whose link is up
+data "powerstore_veth_port" "veth_port_by_filters" {
+  filter_expression = "is_link_up=eq.true"
+}
+
+# Output all virtual Ethernet port Details
+output "veth_port_all_details" {
+  value = data.powerstore_veth_port.all_veth_ports.veth_ports
+}
@@ -0,0 +1,43 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# commands to run this tf file : terraform init && terraform apply --auto-approve
+
+# fetching all vSphere hosts on the array
+data "powerstore_vsphere_host" "all_vsphere_hosts" {
+}
+
+# fetching vSphere host using id
+data "powerstore_vsphere_host" "vsphere_host_by_id" {
+  id = "f1e2d3c4-b5a6-4978-8a9b-0c1d2e3f4a5b"
+}
+
+# fetching vSphere host using name
+data "powerstore_vsphere_host" "vsphere_host_by_name" {
+  name = "esxi-01.example.com"
+}
+
+# Fetching vSphere hosts using filter expression
+# This filter expression will fetch all the ESXi 8.0 hosts
+data "powerstore_vsphere_host" "vsphere_host_by_filters" {
+  filter_expression = "version=like.8.0*"
+}
+
+# Output all vSphere host Details
+output "vsphere_host_all_details" {
+  value = data.powerstore_vsphere_host.all_vsphere_hosts.vsphere_hosts
+}
@@ -31,6 +31,11 @@ provider "powerstore" {
   insecure = true
   timeout  = var.timeout
 
+  ## Optional, opens a maintenance window on all appliances around disruptive
+  ## operations such as port, bond and network changes, so that call-home alerts are suppressed
+  # auto_maintenance_window     = true
+  # maintenance_window_duration = 3600
+
   ## Provider can also be set using environment variables
   ## If environment variables are set it will override this configuration
   ## Example environment variables
@@ -39,4 +44,5 @@ provider "powerstore" {
   # POWERSTORE_ENDPOINT="https://yourhost.host.com/api/rest"
   # POWERSTORE_INSECURE="false"
   # POWERSTORE_TIMEOUT="120"
+  # POWERSTORE_AUTO_MAINTENANCE_WINDOW="true"
 }
\ No newline at end of file
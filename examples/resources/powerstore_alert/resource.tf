@@ -0,0 +1,38 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Commands to run this tf file : terraform init && terraform plan && terraform apply
+# Create, Update, Delete and Import is supported for this resource
+# Alerts are raised and cleared by the array, Delete only removes the alert from the state
+
+# fetch the active alerts to acknowledge
+data "powerstore_alert" "active_minor_alerts" {
+  filter_expression = "state=eq.ACTIVE&severity=eq.Minor&is_acknowledged=eq.false"
+}
+
+resource "powerstore_alert" "test" {
+  for_each = { for alert in data.powerstore_alert.active_minor_alerts.alerts : alert.id => alert }
+
+  # Required, cannot be updated
+  id = each.key
+
+  # Optional, set to false to unacknowledge the alert, defaults to true
+  is_acknowledged = true
+
+  # Optional, acknowledging fails if the severity of the alert became higher
+  acknowledged_severity = "Minor"
+}
@@ -0,0 +1,41 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Commands to run this tf file : terraform init && terraform plan && terraform apply
+# Create, Update, Delete and Import is supported for this resource
+
+# To create a bond, we shall:
+# 1. get the ids of the unused front-end Ethernet ports of a node to be aggregated
+data "powerstore_eth_port" "bond_ports" {
+  filter_expression = "name=in.(BaseEnclosure-NodeA-IoModule0-FEPort2,BaseEnclosure-NodeA-IoModule0-FEPort3)"
+}
+
+# 2. create a bond from those ports
+resource "powerstore_bond" "lacp_bond" {
+  // Required
+  # 2 to 4 ports can be included in a bond
+  # ports can be added later, but removing a port recreates the bond
+  port_ids = data.powerstore_eth_port.bond_ports.eth_ports[*].id
+
+  // Optional
+  description = "Bond for file interfaces"
+}
+
+# The ip_port_ids of the bond can be used to place storage networks and file interfaces on it
+output "bond_ip_port_ids" {
+  value = powerstore_bond.lacp_bond.ip_port_ids
+}
@@ -0,0 +1,28 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Commands to run this tf file : terraform init && terraform plan && terraform apply
+# Create, Update, Delete and Import is supported for this resource
+# The cluster has exactly one DNS setting, creating the resource adopts it and destroying the resource restores the baseline servers
+
+resource "powerstore_dns" "test" {
+  # Required, 1 to 3 servers in IPv4 or IPv6 format
+  addresses = ["10.230.24.30", "10.230.24.31"]
+
+  # Optional, servers restored on destroy, defaults to the servers configured on the array before the resource was created
+  baseline_addresses = ["10.230.24.30"]
+}
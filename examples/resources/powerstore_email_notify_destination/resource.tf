@@ -0,0 +1,30 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Commands to run this tf file : terraform init && terraform plan && terraform apply
+# Create, Update, Delete and Import is supported for this resource
+
+resource "powerstore_email_notify_destination" "test" {
+  # Required
+  email_address = "storage.admins@example.com"
+
+  # Optional, severities of the alerts sent to the email address
+  notify_critical = true
+  notify_major    = true
+  notify_minor    = false
+  notify_info     = false
+}
@@ -0,0 +1,30 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Commands to run this tf file : terraform init && terraform plan && terraform apply
+# Create, Update, Delete and Import is supported for this resource
+# Creating this resource adopts an existing FC port and deleting it only removes the port from the state.
+# The SCSI mode and protocols of the port are read-only.
+
+resource "powerstore_fc_port" "test" {
+  # Exactly one of id and name is required
+  name = "BaseEnclosure-NodeA-IoModule0-FEPort0"
+
+  # Optional, valid values depend on the port hardware; see supported_speeds
+  # Valid values are Auto, 4_Gbps, 8_Gbps, 16_Gbps and 32_Gbps
+  requested_speed = "16_Gbps"
+}
@@ -0,0 +1,37 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Commands to run this tf file : terraform init && terraform plan && terraform apply
+# Create, Update, Delete and Import is supported for this resource
+
+resource "powerstore_file_events_pool" "test" {
+  # Required
+  name                          = "audit_pool"
+  file_events_publisher_servers = ["10.10.10.10", "cepa.example.com"]
+
+  # Required, at most one entry per events category (Pre_Events, Post_Events, Post_Error_Events)
+  file_events_settings = [
+    {
+      events_category = "Post_Events"
+      events          = ["create_file", "delete_file", "rename_file", "set_acl_file"]
+    },
+    {
+      events_category = "Pre_Events"
+      events          = ["open_file_write"]
+    }
+  ]
+}
@@ -0,0 +1,42 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Commands to run this tf file : terraform init && terraform plan && terraform apply
+# Create, Update, Delete and Import is supported for this resource
+
+resource "powerstore_file_events_publisher" "test" {
+  # Required
+  name                 = "audit_publisher"
+  file_events_pool_ids = ["654b2ba6-cdd8-9ce6-2ac5-a6d3d04b7f2c"]
+
+  # Optional
+  is_enabled                           = true
+  heartbeat                            = 10
+  connection_timeout                   = 1000
+  post_event_policy                    = "Accumulate"
+  deny_access_when_all_servers_offline = false
+  http_port                            = 12228
+
+  # Optional, the NAS servers publishing their SMB and NFS file events through this publisher
+  nas_server_ids = ["654b2ba6-cdd8-9ce6-2ac5-a6d3d04b7f3d"]
+
+  # Optional, Windows user used to connect through Microsoft RPC
+  # password is write-only, change password_version to apply a new value
+  username         = "DOMAIN\\cepa_user"
+  password         = var.file_events_password
+  password_version = 1
+}
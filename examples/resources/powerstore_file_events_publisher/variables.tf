@@ -0,0 +1,41 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+variable "username" {
+  type        = string
+  description = "Stores the username of PowerStore host."
+}
+
+variable "password" {
+  type        = string
+  description = "Stores the password of PowerStore host."
+}
+
+variable "timeout" {
+  type        = string
+  description = "Stores the timeout of PowerStore host."
+}
+
+variable "endpoint" {
+  type        = string
+  description = "Stores the endpoint of PowerStore host. eg: https://10.1.1.1/api/rest"
+}
+variable "file_events_password" {
+  type        = string
+  sensitive   = true
+  description = "Stores the password of the Windows user connecting to the file events servers."
+}
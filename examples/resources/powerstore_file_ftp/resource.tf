@@ -0,0 +1,46 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Commands to run this tf file : terraform init && terraform plan && terraform apply
+# Create, Update, Delete and Import is supported for this resource
+
+resource "powerstore_file_ftp" "test" {
+  # Required, only one FTP server can be configured per NAS server
+  nas_server_id = "654b2ba6-cdd8-9ce6-2ac5-a6d3d04b7f2c"
+
+  # Optional
+  is_ftp_enabled                      = false
+  is_sftp_enabled                     = true
+  is_anonymous_authentication_enabled = false
+  is_smb_authentication_enabled       = true
+  is_unix_authentication_enabled      = true
+
+  # Optional, limit the users to their home directory
+  is_homedir_limit_enabled = true
+
+  # Optional, messages displayed to the clients
+  welcome_message    = "Authorized users only"
+  message_of_the_day = "Data is pushed nightly"
+
+  # Optional, allowed or denied hosts, users and groups
+  hosts             = ["10.230.24.0/24"]
+  is_allowed_hosts  = true
+  users             = ["legacy_app"]
+  is_allowed_users  = true
+  groups            = ["contractors"]
+  is_allowed_groups = false
+}
@@ -0,0 +1,29 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Commands to run this tf file : terraform init && terraform plan && terraform apply
+# Create, Update, Delete and Import is supported for this resource
+
+resource "powerstore_file_ndmp" "test" {
+  # Required, only one NDMP service can be configured per NAS server
+  nas_server_id = "654b2ba6-cdd8-9ce6-2ac5-a6d3d04b7f2c"
+  user_name     = "ndmp_backup"
+
+  # Required, write-only, change password_version to apply a new value
+  password         = var.ndmp_password
+  password_version = 1
+}
@@ -0,0 +1,39 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Commands to run this tf file : terraform init && terraform plan && terraform apply
+# Create, Update, Delete and Import is supported for this resource
+
+resource "powerstore_file_virus_checker" "test" {
+  # Required, only one virus checker can be configured per NAS server
+  nas_server_id = "654b2ba6-cdd8-9ce6-2ac5-a6d3d04b7f2c"
+
+  # Optional, a configuration file must be uploaded before the virus checker can be enabled
+  is_enabled = true
+  # Optional, Allow_Access, Stop_SMB_Access or Disable_Virus_Checker
+  offline_policy = "Stop_SMB_Access"
+
+  # Optional, content of the viruschecker.conf file uploaded on the NAS server
+  # Changes of the uploaded file on the array are detected by comparing its SHA-256 hash
+  config_file_path = "${path.module}/viruschecker.conf"
+
+  # Alternatively the configuration can be given inline
+  # config = <<-EOT
+  #   masks=*.exe:*.com:*.doc:*.docx:*.xls:*.xlsx
+  #   addr=10.230.24.70
+  # EOT
+}
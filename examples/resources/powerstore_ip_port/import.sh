@@ -0,0 +1,26 @@
+#Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+#
+#Licensed under the Mozilla Public License Version 2.0 (the "License");
+#you may not use this file except in compliance with the License.
+#You may obtain a copy of the License at
+#
+#    http://mozilla.org/MPL/2.0/
+#
+#
+#Unless required by applicable law or agreed to in writing, software
+#distributed under the License is distributed on an "AS IS" BASIS,
+#WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+#See the License for the specific language governing permissions and
+#limitations under the License.
+
+
+# Below are the steps to import ip port :
+# Step 1 - To import an ip port , we need the id of that ip port and the id of the network assigned to it
+# Step 2 - To check the ids we can make use of ip port and network datasources. Alternatively, we can make GET request to ip port endpoint. eg. https://10.0.0.1/api/rest/ip_port?select=id,ip_pool_addresses(network_id) which will return list of all ip port ids with their network ids.
+# Step 3 - Add empty resource block in tf file. 
+# eg. 
+# resource "powerstore_ip_port" "resource_block_name" {
+  # (resource arguments)
+# }
+# Step 4 - Execute the command: terraform import "powerstore_ip_port.resource_block_name" "id_of_the_ip_port/id_of_the_network" (resource_block_name must be taken from step 3 and ids must be taken from step 2)
+# Step 5 - After successful execution of the command , check the state file
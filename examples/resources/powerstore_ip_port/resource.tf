@@ -0,0 +1,42 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Commands to run this tf file : terraform init && terraform plan && terraform apply
+# Create, Update, Delete and Import is supported for this resource
+
+# To assign storage IPs of a network to ports, we shall:
+# 1. get the IP ports which can be used for iSCSI
+data "powerstore_ip_port" "iscsi_capable" {
+  filter_expression = "available_usages=cs.{ISCSI}"
+}
+
+# 2. get the storage network
+data "powerstore_network" "iscsi" {
+  name = "iSCSI Storage Network"
+}
+
+# 3. map the network to the IP ports
+# the same settings are applied by PowerStore to the partner IP port on the peer node
+resource "powerstore_ip_port" "iscsi" {
+  for_each = toset(data.powerstore_ip_port.iscsi_capable.ip_ports[*].id)
+
+  // Required
+  ip_port_id = each.value
+  network_id = data.powerstore_network.iscsi.networks[0].id
+  # valid values are ISCSI, NVMe_TCP and External_Replication
+  usages = ["ISCSI"]
+}
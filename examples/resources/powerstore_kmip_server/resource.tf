@@ -0,0 +1,48 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Commands to run this tf file : terraform init && terraform plan && terraform apply
+# Create, Update, Delete and Import is supported for this resource
+# The cluster has exactly one KMIP configuration, so Create adopts it and Delete disables the external key management
+# The PowerStore REST API does not support deleting certificates, so imported certificates are kept on the array
+
+resource "powerstore_kmip_server" "kmip" {
+  # Required, IPv4, IPv6 addresses or host names of the KMIP servers
+  servers = ["kmip1.example.com", "kmip2.example.com"]
+  # Optional, defaults to 5696
+  port = 5696
+  # Optional, timeout in seconds, defaults to 5
+  server_timeout = 5
+
+  # Optional, credentials of the KMIP servers, the password is write-only and never stored in the state
+  username = "powerstore"
+  password = var.kmip_password
+  # Change password_version to send a new password
+  password_version = 1
+
+  # Optional, client certificate presented to the KMIP servers, changing it imports a new certificate
+  client_certificate = file("kmip_client.pem")
+  # Optional, write-only, the private key and its passphrase are never stored in the state
+  client_private_key            = file("kmip_client.key")
+  client_private_key_passphrase = var.kmip_client_key_passphrase
+
+  # Optional, CA certificate used to verify the KMIP servers, changing it imports a new certificate
+  ca_certificate = file("kmip_ca.pem")
+
+  # Optional, enable the external key management once the servers are reachable
+  is_enabled = true
+}
@@ -0,0 +1,39 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Commands to run this tf file : terraform init && terraform plan && terraform apply
+# Create, Update, Delete and Import is supported for this resource
+
+# To map a directory group to a PowerStore role, we shall:
+# 1. configure the management LDAP domain, see the powerstore_management_ldap resource
+# 2. get the id of the role to map the group to
+data "powerstore_role" "storage_admin" {
+  name = "Storage Administrator"
+}
+
+# 3. map the group to the role
+resource "powerstore_ldap_account" "test" {
+  # Required, cannot be updated
+  domain_id = powerstore_management_ldap.test.id
+  name      = "storage.admins"
+
+  # Optional, valid values are User and Group, defaults to User, cannot be updated
+  type = "Group"
+
+  # Required
+  role_id = data.powerstore_role.storage_admin.roles[0].id
+}
@@ -0,0 +1,43 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Commands to run this tf file : terraform init && terraform plan && terraform apply
+# Create, Update, Delete and Import is supported for this resource
+# The password is write-only and is never stored in the state, so it requires Terraform 1.11 or later.
+# To change the password, update it and increment password_version.
+
+# get the id of the role to map the local user to
+data "powerstore_role" "operator" {
+  name = "Operator"
+}
+
+resource "powerstore_local_user" "test" {
+  # Required, cannot be updated
+  name = "pipeline.operator"
+
+  # Required
+  role_id = data.powerstore_role.operator.roles[0].id
+
+  # Required, write-only
+  password = var.local_user_password
+
+  # Optional, change this value to apply a new password
+  password_version = 1
+
+  # Optional, defaults to false
+  is_locked = false
+}
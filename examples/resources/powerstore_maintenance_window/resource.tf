@@ -0,0 +1,34 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Commands to run this tf file : terraform init && terraform plan && terraform apply
+# Create, Update, Delete and Import is supported for this resource
+# Every appliance has exactly one maintenance window. Creating this resource adopts the window
+# of the appliance and deleting it closes the window.
+# While the window is enabled, call-home alerts of the appliance are suppressed.
+
+resource "powerstore_maintenance_window" "test" {
+  # Required, id of the appliance, see the appliance datasource
+  appliance_id = "A1"
+
+  # Optional, defaults to true
+  is_enabled = true
+
+  # Optional, number of seconds after which the window expires, defaults to 3600
+  # Valid values are between 60 and 432000
+  duration = 7200
+}
@@ -0,0 +1,56 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Commands to run this tf file : terraform init && terraform plan && terraform apply
+# Create, Update, Delete and Import is supported for this resource
+# The bind password is write-only and is never stored in the state, so it requires Terraform 1.11 or later.
+# To change the bind password, update it and increment bind_password_version.
+
+resource "powerstore_management_ldap" "test" {
+  # Required, cannot be updated
+  domain_name = "corp.example.com"
+
+  # Required
+  ldap_servers      = ["10.10.10.10", "10.10.10.11"]
+  bind_user         = "cn=powerstore,cn=Users,dc=corp,dc=example,dc=com"
+  bind_password     = var.ldap_bind_password
+  user_search_path  = "cn=Users,dc=corp,dc=example,dc=com"
+  group_search_path = "ou=Groups,dc=corp,dc=example,dc=com"
+
+  # Optional, change this value to apply a new bind password
+  bind_password_version = 1
+
+  # Optional, valid values are LDAP and LDAPS
+  protocol = "LDAPS"
+  # Optional, valid values are AD and OpenLDAP
+  ldap_server_type = "AD"
+  # Optional, defaults to 636 for LDAPS
+  port = 636
+
+  # Optional, CA certificate of the LDAP servers, required for LDAPS
+  ca_certificate = file("ldap-ca.pem")
+
+  # Optional
+  # ldap_timeout           = 30000
+  # is_global_catalog      = false
+  # user_id_attribute      = "sAMAccountName"
+  # user_object_class      = "user"
+  # group_name_attribute   = "cn"
+  # group_member_attribute = "member"
+  # group_object_class     = "group"
+  # group_search_level     = 0
+}
@@ -0,0 +1,40 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Commands to run this tf file : terraform init && terraform plan && terraform apply
+# Create, Update, Delete and Import is supported for this resource
+
+resource "powerstore_network" "iscsi" {
+  // Required
+  name          = "iSCSI Storage Network"
+  type          = "Storage"
+  prefix_length = 24
+  mtu           = 9000
+
+  // Optional
+  ip_version = "IPv4"
+  purposes   = ["ISCSI", "NVMe_TCP"]
+  vlan_id    = 42
+  gateway    = "10.230.42.1"
+
+  # storage discovery address of the network
+  storage_discovery_address = "10.230.42.10"
+
+  # IP pool of the network
+  # each address must be listed individually, so a range can be built using cidrhost
+  addresses = [for i in range(11, 19) : cidrhost("10.230.42.0/24", i)]
+}
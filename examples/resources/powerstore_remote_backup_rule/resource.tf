@@ -0,0 +1,40 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Commands to run this tf file : terraform init && terraform plan && terraform apply
+# Create, Update, Delete and Import is supported for this resource
+
+resource "powerstore_remote_backup_rule" "test" {
+  # Required
+  name              = "daily_dd_backup"
+  remote_system_id  = "db11abb3-789e-47f9-96b5-84b5374cbcd2"
+  desired_retention = 720
+
+  # Required, exactly one of interval and time_of_day
+  time_of_day = "22:30"
+
+  # Optional
+  timezone     = "UTC"
+  days_of_week = ["Monday", "Tuesday", "Wednesday", "Thursday", "Friday"]
+  delete_snaps = false
+}
+
+# The remote backup rule is used like any snapshot rule by a protection policy
+resource "powerstore_protectionpolicy" "test" {
+  name              = "dd_backup_policy"
+  snapshot_rule_ids = [powerstore_remote_backup_rule.test.id]
+}
@@ -0,0 +1,38 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Commands to run this tf file : terraform init && terraform plan && terraform apply
+# Create, Update, Delete and Import is supported for this resource
+
+resource "powerstore_remote_syslog_server" "test" {
+  # Required
+  remote_server_address = "10.230.24.60"
+
+  # Optional, defaults to 514
+  port = 6514
+  # Optional, TCP or UDP, defaults to UDP
+  protocol_type = "TCP"
+  # Optional, TLS or None, TLS requires TCP
+  encryption = "TLS"
+
+  # Optional, defaults to all audit types
+  audit_types = ["Authentication", "Authorization", "Config"]
+  # Optional, send the alert events along with the audit events
+  is_alert_events_included = true
+  # Optional, defaults to true
+  is_enabled = true
+}
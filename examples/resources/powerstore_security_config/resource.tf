@@ -0,0 +1,37 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Commands to run this tf file : terraform init && terraform plan && terraform apply
+# Create, Update, Delete and Import is supported for this resource
+# The cluster has exactly one security configuration, so Create adopts it and Delete leaves the settings unchanged on the array
+# Settings which are not configured keep the values found on the array, configured settings are enforced on every apply
+# Password complexity and account lockout are not exposed by the PowerStore REST API and cannot be managed by this resource
+
+resource "powerstore_security_config" "baseline" {
+  # Optional, idle session timeout in seconds, can only be changed in STIG mode to 300, 600 or 1200
+  idle_timeout = 600
+  # Optional, enabling STIG mode reboots the appliances and cannot be reverted
+  is_stig_enabled = true
+  # Optional, only allow HTTPS
+  is_http_redirect_enabled = false
+  # Optional, valid values are UPN and Subject_CN
+  cert_user_name_policy = "UPN"
+
+  # Optional, login message shown on the login page
+  login_banner_text       = "Authorized use only. Activity may be monitored and reported."
+  is_login_banner_enabled = true
+}
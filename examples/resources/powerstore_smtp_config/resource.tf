@@ -0,0 +1,34 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Commands to run this tf file : terraform init && terraform plan && terraform apply
+# Create, Update, Delete and Import is supported for this resource
+# The cluster has exactly one SMTP configuration, creating the resource adopts it and destroying the resource restores the baseline configuration
+
+resource "powerstore_smtp_config" "test" {
+  # Required
+  address      = "10.230.24.40"
+  source_email = "powerstore@example.com"
+
+  # Optional, defaults to 25
+  port = 25
+
+  # Optional, configuration restored on destroy, defaults to the configuration of the array before the resource was created
+  baseline_address      = "10.230.24.41"
+  baseline_port         = 25
+  baseline_source_email = "powerstore@example.com"
+}
@@ -0,0 +1,50 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Commands to run this tf file : terraform init && terraform plan && terraform apply
+# Create, Update, Delete and Import is supported for this resource
+
+# SNMPv2c trap destination
+resource "powerstore_snmp_server" "v2c" {
+  # Required
+  ip_address     = "10.230.24.50"
+  version        = "V2c"
+  alert_severity = "Critical"
+
+  # Optional, either 162 or between 1024 and 49151, defaults to 162
+  port = 162
+
+  # Optional, write-only, change credentials_version to apply a new value
+  trap_community      = var.snmp_trap_community
+  credentials_version = 1
+}
+
+# SNMPv3 trap destination
+resource "powerstore_snmp_server" "v3" {
+  ip_address     = "10.230.24.51"
+  version        = "V3"
+  alert_severity = "Major"
+
+  # Optional, relevant only for SNMPv3
+  user_name        = "powerstore"
+  auth_protocol    = "SHA256"
+  privacy_protocol = "AES256"
+
+  # Optional, write-only, change credentials_version to apply a new value
+  authpass            = var.snmp_authpass
+  credentials_version = 1
+}
@@ -0,0 +1,34 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Commands to run this tf file : terraform init && terraform plan && terraform apply
+# Create, Update and Delete is supported for this resource
+# Creating this resource uploads the software package, runs the pre-upgrade health check,
+# starts the upgrade of all appliances and waits until the package is installed.
+# Installed software cannot be removed, so deleting this resource only removes it from the state.
+# To suppress call-home alerts during the upgrade, set auto_maintenance_window in the provider configuration.
+
+resource "powerstore_software_upgrade" "test" {
+  # Required, path of the software package on the machine running Terraform
+  file_path = "/path/to/PowerStoreT-4.1.0.0-1234567-retail.tgz.bin"
+
+  # Optional, defaults to true
+  run_health_check = true
+
+  # Optional, number of seconds to wait for the upgrade to complete, defaults to 14400
+  timeout = 14400
+}
@@ -0,0 +1,51 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Commands to run this tf file : terraform init && terraform plan && terraform apply
+# Create, Update, Delete and Import is supported for this resource
+# The PowerStore REST API does not support deleting certificates, so Delete only removes the certificate from the state
+
+# Import a CA certificate used to validate the certificates of the LDAP server
+resource "powerstore_x509_certificate" "ldap_ca" {
+  # Required, valid values are Server, Client, CA_Client_Validation and CA_Server_Validation, cannot be updated
+  type = "CA_Server_Validation"
+  # Required, cannot be updated
+  service = "LDAP_HTTP"
+  # Optional, cannot be updated
+  scope = "example.com"
+  # Required, PEM encoded certificate chain, changing it imports a new CA certificate
+  certificate = file("ldap_ca.pem")
+}
+
+# Import a server certificate with its private key for the management HTTPS endpoint
+resource "powerstore_x509_certificate" "management" {
+  type        = "Server"
+  service     = "Management_HTTP"
+  certificate = file("management.pem")
+
+  # Optional, write-only, the private key and its passphrase are never stored in the state
+  private_key = file("management.key")
+  passphrase  = var.private_key_passphrase
+
+  # Optional, defaults to true
+  is_current = true
+}
+
+# Alert before the management certificate expires
+output "management_certificate_expiry" {
+  value = powerstore_x509_certificate.management.valid_to
+}
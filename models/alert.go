@@ -0,0 +1,35 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// Alert - acknowledgement of an alert
+type Alert struct {
+	ID                    types.String `tfsdk:"id"`
+	IsAcknowledged        types.Bool   `tfsdk:"is_acknowledged"`
+	AcknowledgedSeverity  types.String `tfsdk:"acknowledged_severity"`
+	Severity              types.String `tfsdk:"severity"`
+	State                 types.String `tfsdk:"state"`
+	EventCode             types.String `tfsdk:"event_code"`
+	ResourceType          types.String `tfsdk:"resource_type"`
+	ResourceID            types.String `tfsdk:"resource_id"`
+	ResourceName          types.String `tfsdk:"resource_name"`
+	Description           types.String `tfsdk:"description"`
+	AcknowledgedTimestamp types.String `tfsdk:"acknowledged_timestamp"`
+}
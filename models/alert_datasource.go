@@ -0,0 +1,48 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// AlertDataSourceModel is the schema that is used to fetch alerts based on id, resource name or filter expression
+type AlertDataSourceModel struct {
+	ID           types.String          `tfsdk:"id"`
+	ResourceName types.String          `tfsdk:"resource_name"`
+	Filters      FilterExpressionValue `tfsdk:"filter_expression"`
+	Alerts       []AlertDataSource     `tfsdk:"alerts"`
+}
+
+// AlertDataSource represents the schema of an alert
+type AlertDataSource struct {
+	ID                    types.String `tfsdk:"id"`
+	EventCode             types.String `tfsdk:"event_code"`
+	Severity              types.String `tfsdk:"severity"`
+	ResourceType          types.String `tfsdk:"resource_type"`
+	ResourceID            types.String `tfsdk:"resource_id"`
+	ResourceName          types.String `tfsdk:"resource_name"`
+	Description           types.String `tfsdk:"description"`
+	GeneratedTimestamp    types.String `tfsdk:"generated_timestamp"`
+	State                 types.String `tfsdk:"state"`
+	IsAcknowledged        types.Bool   `tfsdk:"is_acknowledged"`
+	RaisedTimestamp       types.String `tfsdk:"raised_timestamp"`
+	ClearedTimestamp      types.String `tfsdk:"cleared_timestamp"`
+	AcknowledgedTimestamp types.String `tfsdk:"acknowledged_timestamp"`
+	CalledHomeTimestamp   types.String `tfsdk:"called_home_timestamp"`
+	EmailSentTimestamp    types.String `tfsdk:"email_sent_timestamp"`
+	SnmpSentTimestamp     types.String `tfsdk:"snmp_sent_timestamp"`
+}
@@ -0,0 +1,58 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// EmailNotifyDestination - email address receiving alert notifications
+type EmailNotifyDestination struct {
+	ID             types.String `tfsdk:"id"`
+	EmailAddress   types.String `tfsdk:"email_address"`
+	NotifyCritical types.Bool   `tfsdk:"notify_critical"`
+	NotifyMajor    types.Bool   `tfsdk:"notify_major"`
+	NotifyMinor    types.Bool   `tfsdk:"notify_minor"`
+	NotifyInfo     types.Bool   `tfsdk:"notify_info"`
+}
+
+// SnmpServer - SNMP server receiving alert traps
+type SnmpServer struct {
+	ID                 types.String `tfsdk:"id"`
+	IPAddress          types.String `tfsdk:"ip_address"`
+	Port               types.Int64  `tfsdk:"port"`
+	Version            types.String `tfsdk:"version"`
+	AlertSeverity      types.String `tfsdk:"alert_severity"`
+	TrapCommunity      types.String `tfsdk:"trap_community"`
+	UserName           types.String `tfsdk:"user_name"`
+	AuthProtocol       types.String `tfsdk:"auth_protocol"`
+	PrivacyProtocol    types.String `tfsdk:"privacy_protocol"`
+	Authpass           types.String `tfsdk:"authpass"`
+	CredentialsVersion types.Int64  `tfsdk:"credentials_version"`
+}
+
+// RemoteSyslogServer - remote syslog server receiving audit and alert events
+type RemoteSyslogServer struct {
+	ID                    types.String `tfsdk:"id"`
+	RemoteServerAddress   types.String `tfsdk:"remote_server_address"`
+	Port                  types.Int64  `tfsdk:"port"`
+	ProtocolType          types.String `tfsdk:"protocol_type"`
+	Encryption            types.String `tfsdk:"encryption"`
+	AuditTypes            types.Set    `tfsdk:"audit_types"`
+	IsEnabled             types.Bool   `tfsdk:"is_enabled"`
+	IsAlertEventsIncluded types.Bool   `tfsdk:"is_alert_events_included"`
+	Status                types.String `tfsdk:"status"`
+}
@@ -0,0 +1,57 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// ApplianceDataSourceModel is the schema that is used to fetch appliances based on id, name or filter expression
+type ApplianceDataSourceModel struct {
+	ID         types.String          `tfsdk:"id"`
+	Name       types.String          `tfsdk:"name"`
+	Filters    FilterExpressionValue `tfsdk:"filter_expression"`
+	Appliances []ApplianceDataSource `tfsdk:"appliances"`
+}
+
+// ApplianceDataSource represents the schema of an appliance
+type ApplianceDataSource struct {
+	ID                             types.String       `tfsdk:"id"`
+	Name                           types.String       `tfsdk:"name"`
+	ServiceTag                     types.String       `tfsdk:"service_tag"`
+	ExpressServiceCode             types.String       `tfsdk:"express_service_code"`
+	Model                          types.String       `tfsdk:"model"`
+	Mode                           types.String       `tfsdk:"mode"`
+	NodeCount                      types.Int64        `tfsdk:"node_count"`
+	DriveFailureToleranceLevel     types.String       `tfsdk:"drive_failure_tolerance_level"`
+	StorageClass                   types.String       `tfsdk:"storage_class"`
+	IsHyperConverged               types.Bool         `tfsdk:"is_hyper_converged"`
+	ModeL10n                       types.String       `tfsdk:"mode_l10n"`
+	DriveFailureToleranceLevelL10n types.String       `tfsdk:"drive_failure_tolerance_level_l10n"`
+	StorageClassL10n               types.String       `tfsdk:"storage_class_l10n"`
+	NodeIDs                        []types.String     `tfsdk:"node_ids"`
+	Capacity                       CapacityDataSource `tfsdk:"capacity"`
+}
+
+// CapacityDataSource represents the latest space usage of an appliance or cluster
+type CapacityDataSource struct {
+	PhysicalTotal      types.Int64   `tfsdk:"physical_total"`
+	PhysicalUsed       types.Int64   `tfsdk:"physical_used"`
+	LogicalProvisioned types.Int64   `tfsdk:"logical_provisioned"`
+	LogicalUsed        types.Int64   `tfsdk:"logical_used"`
+	DataReduction      types.Float64 `tfsdk:"data_reduction"`
+	EfficiencyRatio    types.Float64 `tfsdk:"efficiency_ratio"`
+}
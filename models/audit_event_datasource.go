@@ -0,0 +1,50 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// AuditEventDataSourceModel is the schema that is used to fetch audit events based on time range, user, resource type, outcome or filter expression
+type AuditEventDataSourceModel struct {
+	ID           types.String           `tfsdk:"id"`
+	StartTime    types.String           `tfsdk:"start_time"`
+	EndTime      types.String           `tfsdk:"end_time"`
+	Username     types.String           `tfsdk:"username"`
+	ResourceType types.String           `tfsdk:"resource_type"`
+	IsSuccessful types.Bool             `tfsdk:"is_successful"`
+	Filters      FilterExpressionValue  `tfsdk:"filter_expression"`
+	AuditEvents  []AuditEventDataSource `tfsdk:"audit_events"`
+}
+
+// AuditEventDataSource represents the schema of an audit event
+type AuditEventDataSource struct {
+	ID               types.String   `tfsdk:"id"`
+	Type             types.String   `tfsdk:"type"`
+	Timestamp        types.String   `tfsdk:"timestamp"`
+	Username         types.String   `tfsdk:"username"`
+	IsSuccessful     types.Bool     `tfsdk:"is_successful"`
+	ClientAddress    types.String   `tfsdk:"client_address"`
+	ServerAddress    types.String   `tfsdk:"server_address"`
+	ApplianceID      types.String   `tfsdk:"appliance_id"`
+	JobID            types.String   `tfsdk:"job_id"`
+	ResourceType     types.String   `tfsdk:"resource_type"`
+	ResourceAction   types.String   `tfsdk:"resource_action"`
+	MessageCode      types.String   `tfsdk:"message_code"`
+	MessageArguments []types.String `tfsdk:"message_arguments"`
+	Message          types.String   `tfsdk:"message"`
+}
@@ -0,0 +1,36 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// Bond - link aggregation properties managed by the bond resource
+type Bond struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	PortIDs     types.Set    `tfsdk:"port_ids"`
+	Description types.String `tfsdk:"description"`
+	Mode        types.String `tfsdk:"mode"`
+	MTU         types.Int64  `tfsdk:"mtu"`
+	Status      types.String `tfsdk:"status"`
+	IsLinkUp    types.Bool   `tfsdk:"is_link_up"`
+	Type        types.String `tfsdk:"type"`
+	PartnerID   types.String `tfsdk:"partner_id"`
+	FsnID       types.String `tfsdk:"fsn_id"`
+	IPPortIDs   types.List   `tfsdk:"ip_port_ids"`
+}
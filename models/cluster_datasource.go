@@ -0,0 +1,47 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// ClusterDataSourceModel is the schema that is used to fetch clusters based on id, name or filter expression
+type ClusterDataSourceModel struct {
+	ID       types.String          `tfsdk:"id"`
+	Name     types.String          `tfsdk:"name"`
+	Filters  FilterExpressionValue `tfsdk:"filter_expression"`
+	Clusters []ClusterDataSource   `tfsdk:"clusters"`
+}
+
+// ClusterDataSource represents the schema of a cluster
+type ClusterDataSource struct {
+	ID                      types.String       `tfsdk:"id"`
+	GlobalID                types.String       `tfsdk:"global_id"`
+	Name                    types.String       `tfsdk:"name"`
+	ManagementAddress       types.String       `tfsdk:"management_address"`
+	StorageDiscoveryAddress types.String       `tfsdk:"storage_discovery_address"`
+	PrimaryApplianceID      types.String       `tfsdk:"primary_appliance_id"`
+	ApplianceCount          types.Int64        `tfsdk:"appliance_count"`
+	PhysicalMtu             types.Int64        `tfsdk:"physical_mtu"`
+	IsEncryptionEnabled     types.Bool         `tfsdk:"is_encryption_enabled"`
+	CompatibilityLevel      types.Int64        `tfsdk:"compatibility_level"`
+	State                   types.String       `tfsdk:"state"`
+	StateL10n               types.String       `tfsdk:"state_l10n"`
+	SystemTime              types.String       `tfsdk:"system_time"`
+	NvmSubsystemNqn         types.String       `tfsdk:"nvm_subsystem_nqn"`
+	Capacity                CapacityDataSource `tfsdk:"capacity"`
+}
@@ -0,0 +1,59 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// Ntp - NTP settings of the cluster
+type Ntp struct {
+	ID                types.String `tfsdk:"id"`
+	Addresses         types.List   `tfsdk:"addresses"`
+	BaselineAddresses types.List   `tfsdk:"baseline_addresses"`
+}
+
+// DNS - DNS settings of the cluster
+type DNS struct {
+	ID                types.String `tfsdk:"id"`
+	Addresses         types.List   `tfsdk:"addresses"`
+	BaselineAddresses types.List   `tfsdk:"baseline_addresses"`
+}
+
+// SMTPConfig - SMTP server used by the cluster to send alert emails
+type SMTPConfig struct {
+	ID                  types.String `tfsdk:"id"`
+	Address             types.String `tfsdk:"address"`
+	Port                types.Int64  `tfsdk:"port"`
+	SourceEmail         types.String `tfsdk:"source_email"`
+	BaselineAddress     types.String `tfsdk:"baseline_address"`
+	BaselinePort        types.Int64  `tfsdk:"baseline_port"`
+	BaselineSourceEmail types.String `tfsdk:"baseline_source_email"`
+}
+
+// SecurityConfig - security settings and login banner of the cluster
+type SecurityConfig struct {
+	ID                    types.String `tfsdk:"id"`
+	IdleTimeout           types.Int64  `tfsdk:"idle_timeout"`
+	ProtocolMode          types.String `tfsdk:"protocol_mode"`
+	IsStigEnabled         types.Bool   `tfsdk:"is_stig_enabled"`
+	IsFipsEnabled         types.Bool   `tfsdk:"is_fips_enabled"`
+	IsHTTPRedirectEnabled types.Bool   `tfsdk:"is_http_redirect_enabled"`
+	VasaRetainCertificate types.Bool   `tfsdk:"vasa_retain_certificate"`
+	CertUserNamePolicy    types.String `tfsdk:"cert_user_name_policy"`
+	LoginBannerText       types.String `tfsdk:"login_banner_text"`
+	IsLoginBannerEnabled  types.Bool   `tfsdk:"is_login_banner_enabled"`
+}
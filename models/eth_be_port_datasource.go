@@ -0,0 +1,47 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// EthBePortDataSourceModel is the schema that is used to fetch back-end Ethernet ports based on id, name or filter expression
+type EthBePortDataSourceModel struct {
+	ID         types.String          `tfsdk:"id"`
+	Name       types.String          `tfsdk:"name"`
+	Filters    FilterExpressionValue `tfsdk:"filter_expression"`
+	EthBePorts []EthBePortDataSource `tfsdk:"eth_be_ports"`
+}
+
+// EthBePortDataSource represents the schema of a back-end Ethernet port
+type EthBePortDataSource struct {
+	ID                types.String   `tfsdk:"id"`
+	Name              types.String   `tfsdk:"name"`
+	ApplianceID       types.String   `tfsdk:"appliance_id"`
+	NodeID            types.String   `tfsdk:"node_id"`
+	MACAddress        types.String   `tfsdk:"mac_address"`
+	IsLinkUp          types.Bool     `tfsdk:"is_link_up"`
+	Speed             types.String   `tfsdk:"speed"`
+	SFPID             types.String   `tfsdk:"sfp_id"`
+	PortIndex         types.Int64    `tfsdk:"port_index"`
+	PortConnectorType types.String   `tfsdk:"port_connector_type"`
+	HardwareParentID  types.String   `tfsdk:"hardware_parent_id"`
+	ExpectedPeerID    types.String   `tfsdk:"expected_peer_id"`
+	ActualPeerID      types.String   `tfsdk:"actual_peer_id"`
+	Protocols         []types.String `tfsdk:"protocols"`
+	StaleState        types.String   `tfsdk:"stale_state"`
+}
@@ -0,0 +1,53 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// EthPortDataSourceModel is the schema that is used to fetch Ethernet ports based on id, name or filter expression
+type EthPortDataSourceModel struct {
+	ID       types.String          `tfsdk:"id"`
+	Name     types.String          `tfsdk:"name"`
+	Filters  FilterExpressionValue `tfsdk:"filter_expression"`
+	EthPorts []EthPortDataSource   `tfsdk:"eth_ports"`
+}
+
+// EthPortDataSource represents the schema of an Ethernet port
+type EthPortDataSource struct {
+	ID                  types.String   `tfsdk:"id"`
+	Name                types.String   `tfsdk:"name"`
+	ApplianceID         types.String   `tfsdk:"appliance_id"`
+	NodeID              types.String   `tfsdk:"node_id"`
+	BondID              types.String   `tfsdk:"bond_id"`
+	FsnID               types.String   `tfsdk:"fsn_id"`
+	MACAddress          types.String   `tfsdk:"mac_address"`
+	PermanentMACAddress types.String   `tfsdk:"permanent_mac_address"`
+	IsLinkUp            types.Bool     `tfsdk:"is_link_up"`
+	IsHypervisorManaged types.Bool     `tfsdk:"is_hypervisor_managed"`
+	SupportedSpeeds     []types.String `tfsdk:"supported_speeds"`
+	CurrentSpeed        types.String   `tfsdk:"current_speed"`
+	RequestedSpeed      types.String   `tfsdk:"requested_speed"`
+	CurrentMTU          types.Int64    `tfsdk:"current_mtu"`
+	SFPID               types.String   `tfsdk:"sfp_id"`
+	IoModuleID          types.String   `tfsdk:"io_module_id"`
+	HardwareParentID    types.String   `tfsdk:"hardware_parent_id"`
+	PortIndex           types.Int64    `tfsdk:"port_index"`
+	PortConnectorType   types.String   `tfsdk:"port_connector_type"`
+	PartnerID           types.String   `tfsdk:"partner_id"`
+	StaleState          types.String   `tfsdk:"stale_state"`
+}
@@ -0,0 +1,42 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// EventDataSourceModel is the schema that is used to fetch events based on id, resource name or filter expression
+type EventDataSourceModel struct {
+	ID           types.String          `tfsdk:"id"`
+	ResourceName types.String          `tfsdk:"resource_name"`
+	Filters      FilterExpressionValue `tfsdk:"filter_expression"`
+	Events       []EventDataSource     `tfsdk:"events"`
+}
+
+// EventDataSource represents the schema of an event
+type EventDataSource struct {
+	ID                 types.String `tfsdk:"id"`
+	EventCode          types.String `tfsdk:"event_code"`
+	Severity           types.String `tfsdk:"severity"`
+	ResourceType       types.String `tfsdk:"resource_type"`
+	ResourceID         types.String `tfsdk:"resource_id"`
+	ResourceName       types.String `tfsdk:"resource_name"`
+	GeneratedTimestamp types.String `tfsdk:"generated_timestamp"`
+	Description        types.String `tfsdk:"description"`
+	SystemImpact       types.String `tfsdk:"system_impact"`
+	RepairFlow         types.String `tfsdk:"repair_flow"`
+}
@@ -0,0 +1,53 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// FcPortDataSourceModel is the schema that is used to fetch FC ports based on id, name or filter expression
+type FcPortDataSourceModel struct {
+	ID      types.String          `tfsdk:"id"`
+	Name    types.String          `tfsdk:"name"`
+	Filters FilterExpressionValue `tfsdk:"filter_expression"`
+	FCPorts []FcPortDataSource    `tfsdk:"fc_ports"`
+}
+
+// FcPortDataSource represents the schema of a FC port
+type FcPortDataSource struct {
+	ID                types.String   `tfsdk:"id"`
+	Name              types.String   `tfsdk:"name"`
+	ApplianceID       types.String   `tfsdk:"appliance_id"`
+	NodeID            types.String   `tfsdk:"node_id"`
+	WWN               types.String   `tfsdk:"wwn"`
+	WWNNvme           types.String   `tfsdk:"wwn_nvme"`
+	WWNNode           types.String   `tfsdk:"wwn_node"`
+	IsLinkUp          types.Bool     `tfsdk:"is_link_up"`
+	IsInUse           types.Bool     `tfsdk:"is_in_use"`
+	SupportedSpeeds   []types.String `tfsdk:"supported_speeds"`
+	CurrentSpeed      types.String   `tfsdk:"current_speed"`
+	RequestedSpeed    types.String   `tfsdk:"requested_speed"`
+	SFPID             types.String   `tfsdk:"sfp_id"`
+	IoModuleID        types.String   `tfsdk:"io_module_id"`
+	HardwareParentID  types.String   `tfsdk:"hardware_parent_id"`
+	PortIndex         types.Int64    `tfsdk:"port_index"`
+	PortConnectorType types.String   `tfsdk:"port_connector_type"`
+	PartnerID         types.String   `tfsdk:"partner_id"`
+	Protocols         []types.String `tfsdk:"protocols"`
+	ScsiMode          types.String   `tfsdk:"scsi_mode"`
+	StaleState        types.String   `tfsdk:"stale_state"`
+}
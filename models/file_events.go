@@ -0,0 +1,52 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// FileEventsPool - set of CEPA servers the file events are published to
+type FileEventsPool struct {
+	ID                         types.String         `tfsdk:"id"`
+	Name                       types.String         `tfsdk:"name"`
+	FileEventsPublisherServers types.Set            `tfsdk:"file_events_publisher_servers"`
+	FileEventsSettings         []FileEventsSettings `tfsdk:"file_events_settings"`
+	IsReplica                  types.Bool           `tfsdk:"is_replica"`
+}
+
+// FileEventsSettings - file events published for an events category
+type FileEventsSettings struct {
+	EventsCategory types.String `tfsdk:"events_category"`
+	Events         types.Set    `tfsdk:"events"`
+}
+
+// FileEventsPublisher - publisher sending the file events of NAS servers to file events pools
+type FileEventsPublisher struct {
+	ID                              types.String `tfsdk:"id"`
+	Name                            types.String `tfsdk:"name"`
+	IsEnabled                       types.Bool   `tfsdk:"is_enabled"`
+	Heartbeat                       types.Int64  `tfsdk:"heartbeat"`
+	ConnectionTimeout               types.Int64  `tfsdk:"connection_timeout"`
+	PostEventPolicy                 types.String `tfsdk:"post_event_policy"`
+	DenyAccessWhenAllServersOffline types.Bool   `tfsdk:"deny_access_when_all_servers_offline"`
+	Username                        types.String `tfsdk:"username"`
+	Password                        types.String `tfsdk:"password"`
+	PasswordVersion                 types.Int64  `tfsdk:"password_version"`
+	HTTPPort                        types.Int64  `tfsdk:"http_port"`
+	FileEventsPoolIDs               types.Set    `tfsdk:"file_events_pool_ids"`
+	NasServerIDs                    types.Set    `tfsdk:"nas_server_ids"`
+}
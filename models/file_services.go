@@ -0,0 +1,77 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// FileFtp - FTP and SFTP server of a NAS server
+type FileFtp struct {
+	ID                               types.String `tfsdk:"id"`
+	NasServerID                      types.String `tfsdk:"nas_server_id"`
+	IsFtpEnabled                     types.Bool   `tfsdk:"is_ftp_enabled"`
+	IsSftpEnabled                    types.Bool   `tfsdk:"is_sftp_enabled"`
+	IsSmbAuthenticationEnabled       types.Bool   `tfsdk:"is_smb_authentication_enabled"`
+	IsUnixAuthenticationEnabled      types.Bool   `tfsdk:"is_unix_authentication_enabled"`
+	IsAnonymousAuthenticationEnabled types.Bool   `tfsdk:"is_anonymous_authentication_enabled"`
+	IsHomedirLimitEnabled            types.Bool   `tfsdk:"is_homedir_limit_enabled"`
+	DefaultHomedir                   types.String `tfsdk:"default_homedir"`
+	WelcomeMessage                   types.String `tfsdk:"welcome_message"`
+	MessageOfTheDay                  types.String `tfsdk:"message_of_the_day"`
+	IsAuditEnabled                   types.Bool   `tfsdk:"is_audit_enabled"`
+	AuditDir                         types.String `tfsdk:"audit_dir"`
+	AuditMaxSize                     types.Int64  `tfsdk:"audit_max_size"`
+	Hosts                            types.Set    `tfsdk:"hosts"`
+	IsAllowedHosts                   types.Bool   `tfsdk:"is_allowed_hosts"`
+	Users                            types.Set    `tfsdk:"users"`
+	IsAllowedUsers                   types.Bool   `tfsdk:"is_allowed_users"`
+	Groups                           types.Set    `tfsdk:"groups"`
+	IsAllowedGroups                  types.Bool   `tfsdk:"is_allowed_groups"`
+}
+
+// FileNdmp - NDMP service of a NAS server
+type FileNdmp struct {
+	ID              types.String `tfsdk:"id"`
+	NasServerID     types.String `tfsdk:"nas_server_id"`
+	UserName        types.String `tfsdk:"user_name"`
+	Password        types.String `tfsdk:"password"`
+	PasswordVersion types.Int64  `tfsdk:"password_version"`
+}
+
+// FileDhsmConfig - DHSM (Distributed Hierarchical Storage Management) service of a NAS server
+type FileDhsmConfig struct {
+	ID              types.String `tfsdk:"id"`
+	NasServerID     types.String `tfsdk:"nas_server_id"`
+	UserName        types.String `tfsdk:"user_name"`
+	Password        types.String `tfsdk:"password"`
+	PasswordVersion types.Int64  `tfsdk:"password_version"`
+}
+
+// FileDhsmConfigDataSourceModel - used to fetch the DHSM services based on id, NAS server id or filter expression
+type FileDhsmConfigDataSourceModel struct {
+	ID              types.String               `tfsdk:"id"`
+	NasServerID     types.String               `tfsdk:"nas_server_id"`
+	Filters         FilterExpressionValue      `tfsdk:"filter_expression"`
+	FileDhsmConfigs []FileDhsmConfigDataSource `tfsdk:"file_dhsm_configs"`
+}
+
+// FileDhsmConfigDataSource - DHSM service of a NAS server as read by the data source
+type FileDhsmConfigDataSource struct {
+	ID          types.String `tfsdk:"id"`
+	NasServerID types.String `tfsdk:"nas_server_id"`
+	UserName    types.String `tfsdk:"user_name"`
+}
@@ -0,0 +1,73 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// HardwareDataSourceModel is the schema that is used to fetch hardware components based on id, name, type or filter expression
+type HardwareDataSourceModel struct {
+	ID       types.String          `tfsdk:"id"`
+	Name     types.String          `tfsdk:"name"`
+	Type     types.String          `tfsdk:"type"`
+	Filters  FilterExpressionValue `tfsdk:"filter_expression"`
+	Hardware []HardwareDataSource  `tfsdk:"hardware"`
+}
+
+// HardwareDataSource represents the schema of a hardware component
+type HardwareDataSource struct {
+	ID                 types.String                   `tfsdk:"id"`
+	Name               types.String                   `tfsdk:"name"`
+	Type               types.String                   `tfsdk:"type"`
+	LifecycleState     types.String                   `tfsdk:"lifecycle_state"`
+	ParentID           types.String                   `tfsdk:"parent_id"`
+	ApplianceID        types.String                   `tfsdk:"appliance_id"`
+	Slot               types.Int64                    `tfsdk:"slot"`
+	PartNumber         types.String                   `tfsdk:"part_number"`
+	SerialNumber       types.String                   `tfsdk:"serial_number"`
+	StatusLedState     types.String                   `tfsdk:"status_led_state"`
+	IsMarked           types.Bool                     `tfsdk:"is_marked"`
+	StaleState         types.String                   `tfsdk:"stale_state"`
+	TypeL10n           types.String                   `tfsdk:"type_l10n"`
+	LifecycleStateL10n types.String                   `tfsdk:"lifecycle_state_l10n"`
+	StatusLedStateL10n types.String                   `tfsdk:"status_led_state_l10n"`
+	ExtraDetails       HardwareExtraDetailsDataSource `tfsdk:"extra_details"`
+}
+
+// HardwareExtraDetailsDataSource represents the type specific details of a hardware component
+type HardwareExtraDetailsDataSource struct {
+	CPUModel                  types.String   `tfsdk:"cpu_model"`
+	PhysicalMemorySizeGB      types.Int64    `tfsdk:"physical_memory_size_gb"`
+	CPUCores                  types.Int64    `tfsdk:"cpu_cores"`
+	CPUSockets                types.Int64    `tfsdk:"cpu_sockets"`
+	BusNumber                 types.Int64    `tfsdk:"bus_number"`
+	EnclosureNumber           types.Int64    `tfsdk:"enclosure_number"`
+	ModelName                 types.String   `tfsdk:"model_name"`
+	FirmwareVersion           types.String   `tfsdk:"firmware_version"`
+	Mode                      types.String   `tfsdk:"mode"`
+	SupportedSpeeds           []types.String `tfsdk:"supported_speeds"`
+	SupportedProtocol         types.String   `tfsdk:"supported_protocol"`
+	ConnectorType             types.String   `tfsdk:"connector_type"`
+	DriveType                 types.String   `tfsdk:"drive_type"`
+	Size                      types.Int64    `tfsdk:"size"`
+	EncryptionStatus          types.String   `tfsdk:"encryption_status"`
+	FipsStatus                types.String   `tfsdk:"fips_status"`
+	DellServiceTag            types.String   `tfsdk:"dell_service_tag"`
+	ExpressServiceCode        types.String   `tfsdk:"express_service_code"`
+	EnclosureModelDescription types.String   `tfsdk:"enclosure_model_description"`
+	StorageClass              types.String   `tfsdk:"storage_class"`
+}
@@ -0,0 +1,42 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// IPPortDataSourceModel is the schema that is used to fetch IP ports based on id, eth port id or filter expression
+type IPPortDataSourceModel struct {
+	ID        types.String          `tfsdk:"id"`
+	EthPortID types.String          `tfsdk:"eth_port_id"`
+	Filters   FilterExpressionValue `tfsdk:"filter_expression"`
+	IPPorts   []IPPortDataSource    `tfsdk:"ip_ports"`
+}
+
+// IPPortDataSource represents the schema of an IP port
+type IPPortDataSource struct {
+	ID              types.String   `tfsdk:"id"`
+	PartnerID       types.String   `tfsdk:"partner_id"`
+	TargetIqn       types.String   `tfsdk:"target_iqn"`
+	AvailableUsages []types.String `tfsdk:"available_usages"`
+	CurrentUsages   []types.String `tfsdk:"current_usages"`
+	FsnID           types.String   `tfsdk:"fsn_id"`
+	BondID          types.String   `tfsdk:"bond_id"`
+	EthPortID       types.String   `tfsdk:"eth_port_id"`
+	VethPortID      types.String   `tfsdk:"veth_port_id"`
+	IPAddresses     []types.String `tfsdk:"ip_addresses"`
+}
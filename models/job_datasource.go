@@ -0,0 +1,61 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// JobDataSourceModel is the schema that is used to fetch jobs based on id, resource id or filter expression
+type JobDataSourceModel struct {
+	ID         types.String          `tfsdk:"id"`
+	ResourceID types.String          `tfsdk:"resource_id"`
+	Filters    FilterExpressionValue `tfsdk:"filter_expression"`
+	Jobs       []JobDataSource       `tfsdk:"jobs"`
+}
+
+// JobDataSource represents the schema of a job
+type JobDataSource struct {
+	ID                      types.String    `tfsdk:"id"`
+	Description             types.String    `tfsdk:"description"`
+	ResourceAction          types.String    `tfsdk:"resource_action"`
+	ResourceType            types.String    `tfsdk:"resource_type"`
+	ResourceID              types.String    `tfsdk:"resource_id"`
+	ResourceName            types.String    `tfsdk:"resource_name"`
+	State                   types.String    `tfsdk:"state"`
+	Phase                   types.String    `tfsdk:"phase"`
+	StartTime               types.String    `tfsdk:"start_time"`
+	EndTime                 types.String    `tfsdk:"end_time"`
+	EstimatedCompletionTime types.String    `tfsdk:"estimated_completion_time"`
+	ProgressPercentage      types.Int64     `tfsdk:"progress_percentage"`
+	ParentID                types.String    `tfsdk:"parent_id"`
+	RootID                  types.String    `tfsdk:"root_id"`
+	User                    types.String    `tfsdk:"user"`
+	ResponseStatus          types.String    `tfsdk:"response_status"`
+	StepOrder               types.Int64     `tfsdk:"step_order"`
+	ErrorMessages           []types.String  `tfsdk:"error_messages"`
+	ChildJobs               []JobStepSource `tfsdk:"child_jobs"`
+}
+
+// JobStepSource represents the schema of a child job, a step of its parent job
+type JobStepSource struct {
+	ID                 types.String   `tfsdk:"id"`
+	Description        types.String   `tfsdk:"description"`
+	State              types.String   `tfsdk:"state"`
+	StepOrder          types.Int64    `tfsdk:"step_order"`
+	ProgressPercentage types.Int64    `tfsdk:"progress_percentage"`
+	ErrorMessages      []types.String `tfsdk:"error_messages"`
+}
@@ -0,0 +1,55 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// KmipServer - external key manager (KMIP) configuration of the cluster
+type KmipServer struct {
+	ID                         types.String `tfsdk:"id"`
+	Servers                    types.Set    `tfsdk:"servers"`
+	Port                       types.Int64  `tfsdk:"port"`
+	ServerTimeout              types.Int64  `tfsdk:"server_timeout"`
+	Username                   types.String `tfsdk:"username"`
+	Password                   types.String `tfsdk:"password"`
+	PasswordVersion            types.Int64  `tfsdk:"password_version"`
+	IsEnabled                  types.Bool   `tfsdk:"is_enabled"`
+	ClientCertificate          types.String `tfsdk:"client_certificate"`
+	ClientPrivateKey           types.String `tfsdk:"client_private_key"`
+	ClientPrivateKeyPassphrase types.String `tfsdk:"client_private_key_passphrase"`
+	ClientCertificateID        types.String `tfsdk:"client_certificate_id"`
+	CaCertificate              types.String `tfsdk:"ca_certificate"`
+	CaCertificateID            types.String `tfsdk:"ca_certificate_id"`
+}
+
+// KmipServerDataSourceModel - external key manager (KMIP) configuration and connectivity as read by the data source
+type KmipServerDataSourceModel struct {
+	ID            types.String          `tfsdk:"id"`
+	Verify        types.Bool            `tfsdk:"verify"`
+	IsEnabled     types.Bool            `tfsdk:"is_enabled"`
+	Port          types.Int64           `tfsdk:"port"`
+	ServerTimeout types.Int64           `tfsdk:"server_timeout"`
+	Username      types.String          `tfsdk:"username"`
+	Servers       []KmipServerConnected `tfsdk:"servers"`
+}
+
+// KmipServerConnected - address and connectivity status of a KMIP server
+type KmipServerConnected struct {
+	Address types.String `tfsdk:"address"`
+	Status  types.String `tfsdk:"status"`
+}
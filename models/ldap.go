@@ -0,0 +1,55 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// ManagementLdap - LDAP domain used to authenticate management users
+type ManagementLdap struct {
+	ID                   types.String `tfsdk:"id"`
+	DomainName           types.String `tfsdk:"domain_name"`
+	LdapServers          types.Set    `tfsdk:"ldap_servers"`
+	Port                 types.Int64  `tfsdk:"port"`
+	Protocol             types.String `tfsdk:"protocol"`
+	LdapServerType       types.String `tfsdk:"ldap_server_type"`
+	BindUser             types.String `tfsdk:"bind_user"`
+	BindPassword         types.String `tfsdk:"bind_password"`
+	BindPasswordVersion  types.Int64  `tfsdk:"bind_password_version"`
+	LdapTimeout          types.Int64  `tfsdk:"ldap_timeout"`
+	IsGlobalCatalog      types.Bool   `tfsdk:"is_global_catalog"`
+	UserIDAttribute      types.String `tfsdk:"user_id_attribute"`
+	UserObjectClass      types.String `tfsdk:"user_object_class"`
+	UserSearchPath       types.String `tfsdk:"user_search_path"`
+	GroupNameAttribute   types.String `tfsdk:"group_name_attribute"`
+	GroupMemberAttribute types.String `tfsdk:"group_member_attribute"`
+	GroupObjectClass     types.String `tfsdk:"group_object_class"`
+	GroupSearchPath      types.String `tfsdk:"group_search_path"`
+	GroupSearchLevel     types.Int64  `tfsdk:"group_search_level"`
+	CaCertificate        types.String `tfsdk:"ca_certificate"`
+	CaCertificateID      types.String `tfsdk:"ca_certificate_id"`
+}
+
+// LdapAccount - mapping of an LDAP user or group to a role
+type LdapAccount struct {
+	ID       types.String `tfsdk:"id"`
+	DomainID types.String `tfsdk:"domain_id"`
+	Name     types.String `tfsdk:"name"`
+	Type     types.String `tfsdk:"type"`
+	RoleID   types.String `tfsdk:"role_id"`
+	DN       types.String `tfsdk:"dn"`
+}
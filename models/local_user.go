@@ -0,0 +1,34 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// LocalUser - local user account properties
+type LocalUser struct {
+	ID                          types.String `tfsdk:"id"`
+	Name                        types.String `tfsdk:"name"`
+	RoleID                      types.String `tfsdk:"role_id"`
+	IsLocked                    types.Bool   `tfsdk:"is_locked"`
+	IsMfaBypass                 types.Bool   `tfsdk:"is_mfa_bypass"`
+	Password                    types.String `tfsdk:"password"`
+	PasswordVersion             types.Int64  `tfsdk:"password_version"`
+	IsBuiltIn                   types.Bool   `tfsdk:"is_built_in"`
+	IsDefaultPassword           types.Bool   `tfsdk:"is_default_password"`
+	PasswordExpirationTimestamp types.String `tfsdk:"password_expiration_timestamp"`
+}
@@ -0,0 +1,48 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// MetricsDataSourceModel is the schema that is used to fetch performance metrics of an entity
+type MetricsDataSourceModel struct {
+	ID         types.String                   `tfsdk:"id"`
+	EntityType types.String                   `tfsdk:"entity_type"`
+	EntityID   types.String                   `tfsdk:"entity_id"`
+	Interval   types.String                   `tfsdk:"interval"`
+	Metrics    []PerformanceMetricsDataSource `tfsdk:"metrics"`
+}
+
+// PerformanceMetricsDataSource represents a performance metrics sample
+type PerformanceMetricsDataSource struct {
+	Timestamp         types.String  `tfsdk:"timestamp"`
+	RepeatCount       types.Int64   `tfsdk:"repeat_count"`
+	ReadIops          types.Float64 `tfsdk:"read_iops"`
+	WriteIops         types.Float64 `tfsdk:"write_iops"`
+	TotalIops         types.Float64 `tfsdk:"total_iops"`
+	ReadBandwidth     types.Float64 `tfsdk:"read_bandwidth"`
+	WriteBandwidth    types.Float64 `tfsdk:"write_bandwidth"`
+	TotalBandwidth    types.Float64 `tfsdk:"total_bandwidth"`
+	AvgLatency        types.Float64 `tfsdk:"avg_latency"`
+	AvgReadLatency    types.Float64 `tfsdk:"avg_read_latency"`
+	AvgWriteLatency   types.Float64 `tfsdk:"avg_write_latency"`
+	AvgIoSize         types.Float64 `tfsdk:"avg_io_size"`
+	MaxTotalIops      types.Float64 `tfsdk:"max_total_iops"`
+	MaxTotalBandwidth types.Float64 `tfsdk:"max_total_bandwidth"`
+	MaxAvgLatency     types.Float64 `tfsdk:"max_avg_latency"`
+}
@@ -0,0 +1,49 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// Network - network properties managed by the network resource
+type Network struct {
+	ID                      types.String `tfsdk:"id"`
+	Name                    types.String `tfsdk:"name"`
+	Type                    types.String `tfsdk:"type"`
+	IPVersion               types.String `tfsdk:"ip_version"`
+	Purposes                types.Set    `tfsdk:"purposes"`
+	VlanID                  types.Int64  `tfsdk:"vlan_id"`
+	Gateway                 types.String `tfsdk:"gateway"`
+	PrefixLength            types.Int64  `tfsdk:"prefix_length"`
+	MTU                     types.Int64  `tfsdk:"mtu"`
+	StorageDiscoveryAddress types.String `tfsdk:"storage_discovery_address"`
+	Addresses               types.Set    `tfsdk:"addresses"`
+}
+
+// IPPort - usages of an IP port on a network managed by the ip port resource
+type IPPort struct {
+	ID              types.String `tfsdk:"id"`
+	IPPortID        types.String `tfsdk:"ip_port_id"`
+	NetworkID       types.String `tfsdk:"network_id"`
+	Usages          types.Set    `tfsdk:"usages"`
+	CurrentUsages   types.List   `tfsdk:"current_usages"`
+	AvailableUsages types.List   `tfsdk:"available_usages"`
+	TargetIqn       types.String `tfsdk:"target_iqn"`
+	EthPortID       types.String `tfsdk:"eth_port_id"`
+	BondID          types.String `tfsdk:"bond_id"`
+	IPAddresses     types.List   `tfsdk:"ip_addresses"`
+}
@@ -0,0 +1,45 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// NetworkDataSourceModel is the schema that is used to fetch networks based on id, name or filter expression
+type NetworkDataSourceModel struct {
+	ID       types.String          `tfsdk:"id"`
+	Name     types.String          `tfsdk:"name"`
+	Filters  FilterExpressionValue `tfsdk:"filter_expression"`
+	Networks []NetworkDataSource   `tfsdk:"networks"`
+}
+
+// NetworkDataSource represents the schema of a network
+type NetworkDataSource struct {
+	ID                types.String   `tfsdk:"id"`
+	Name              types.String   `tfsdk:"name"`
+	Type              types.String   `tfsdk:"type"`
+	IPVersion         types.String   `tfsdk:"ip_version"`
+	Purposes          []types.String `tfsdk:"purposes"`
+	VLANID            types.Int64    `tfsdk:"vlan_id"`
+	PrefixLength      types.Int64    `tfsdk:"prefix_length"`
+	Gateway           types.String   `tfsdk:"gateway"`
+	MTU               types.Int64    `tfsdk:"mtu"`
+	NvmeDiscoveryMode types.String   `tfsdk:"nvme_discovery_mode"`
+	NvmeCdcAddress    types.String   `tfsdk:"nvme_cdc_address"`
+	NvmeCdcPort       types.Int64    `tfsdk:"nvme_cdc_port"`
+	Addresses         []types.String `tfsdk:"addresses"`
+}
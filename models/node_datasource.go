@@ -0,0 +1,36 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// NodeDataSourceModel is the schema that is used to fetch nodes based on id, appliance or filter expression
+type NodeDataSourceModel struct {
+	ID          types.String          `tfsdk:"id"`
+	ApplianceID types.String          `tfsdk:"appliance_id"`
+	Filters     FilterExpressionValue `tfsdk:"filter_expression"`
+	Nodes       []NodeDataSource      `tfsdk:"nodes"`
+}
+
+// NodeDataSource represents the schema of a node
+type NodeDataSource struct {
+	ID            types.String `tfsdk:"id"`
+	Slot          types.Int64  `tfsdk:"slot"`
+	ApplianceID   types.String `tfsdk:"appliance_id"`
+	ApplianceName types.String `tfsdk:"appliance_name"`
+}
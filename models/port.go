@@ -0,0 +1,52 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// EthPort - Ethernet port properties managed by the eth port resource
+type EthPort struct {
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	RequestedSpeed  types.String `tfsdk:"requested_speed"`
+	CurrentSpeed    types.String `tfsdk:"current_speed"`
+	SupportedSpeeds types.List   `tfsdk:"supported_speeds"`
+	IsLinkUp        types.Bool   `tfsdk:"is_link_up"`
+	CurrentMTU      types.Int64  `tfsdk:"current_mtu"`
+	MacAddress      types.String `tfsdk:"mac_address"`
+	ApplianceID     types.String `tfsdk:"appliance_id"`
+	NodeID          types.String `tfsdk:"node_id"`
+	BondID          types.String `tfsdk:"bond_id"`
+	PartnerID       types.String `tfsdk:"partner_id"`
+}
+
+// FcPort - FC port properties managed by the fc port resource
+type FcPort struct {
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	RequestedSpeed  types.String `tfsdk:"requested_speed"`
+	CurrentSpeed    types.String `tfsdk:"current_speed"`
+	SupportedSpeeds types.List   `tfsdk:"supported_speeds"`
+	IsLinkUp        types.Bool   `tfsdk:"is_link_up"`
+	WWN             types.String `tfsdk:"wwn"`
+	ScsiMode        types.String `tfsdk:"scsi_mode"`
+	Protocols       types.List   `tfsdk:"protocols"`
+	ApplianceID     types.String `tfsdk:"appliance_id"`
+	NodeID          types.String `tfsdk:"node_id"`
+	PartnerID       types.String `tfsdk:"partner_id"`
+}
@@ -0,0 +1,71 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// RemoteBackupRule - snapshot rule backing up to a PowerProtect DD remote system
+type RemoteBackupRule struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	RemoteSystemID   types.String `tfsdk:"remote_system_id"`
+	Interval         types.String `tfsdk:"interval"`
+	TimeOfDay        types.String `tfsdk:"time_of_day"`
+	Timezone         types.String `tfsdk:"timezone"`
+	DaysOfWeek       types.Set    `tfsdk:"days_of_week"`
+	DesiredRetention types.Int64  `tfsdk:"desired_retention"`
+	ManagedBy        types.String `tfsdk:"managed_by"`
+	ManagedByID      types.String `tfsdk:"managed_by_id"`
+	DeleteSnaps      types.Bool   `tfsdk:"delete_snaps"`
+}
+
+// RemoteSnapshotDataSourceModel - used to fetch the remote snapshots of a volume or a volume group
+type RemoteSnapshotDataSourceModel struct {
+	ID              types.String               `tfsdk:"id"`
+	VolumeID        types.String               `tfsdk:"volume_id"`
+	VolumeGroupID   types.String               `tfsdk:"volume_group_id"`
+	RemoteSnapshots []RemoteSnapshotDataSource `tfsdk:"remote_snapshots"`
+}
+
+// RemoteSnapshotDataSource - snapshot stored on a remote system
+type RemoteSnapshotDataSource struct {
+	ID                     types.String          `tfsdk:"id"`
+	Name                   types.String          `tfsdk:"name"`
+	RemoteBackupIdentifier types.String          `tfsdk:"remote_backup_identifier"`
+	VolumeID               types.String          `tfsdk:"volume_id"`
+	VolumeGroupID          types.String          `tfsdk:"volume_group_id"`
+	IsSourceInRecycleBin   types.Bool            `tfsdk:"is_source_in_recycle_bin"`
+	State                  types.String          `tfsdk:"state"`
+	ResourceType           types.String          `tfsdk:"resource_type"`
+	RemoteMemberDetails    []RemoteMemberDetails `tfsdk:"remote_member_details"`
+	CreationTimestamp      types.String          `tfsdk:"creation_timestamp"`
+	ExpirationTimestamp    types.String          `tfsdk:"expiration_timestamp"`
+	RemoteSystemID         types.String          `tfsdk:"remote_system_id"`
+	SourceID               types.String          `tfsdk:"source_id"`
+	SourceName             types.String          `tfsdk:"source_name"`
+	CreatorType            types.String          `tfsdk:"creator_type"`
+}
+
+// RemoteMemberDetails - member volume snapshot of a remote volume group snapshot
+type RemoteMemberDetails struct {
+	VolumeID             types.String `tfsdk:"volume_id"`
+	IsSourceInRecycleBin types.Bool   `tfsdk:"is_source_in_recycle_bin"`
+	RemoteIdentifier     types.String `tfsdk:"remote_identifier"`
+	SnapshotName         types.String `tfsdk:"snapshot_name"`
+	SnapshotSize         types.Int64  `tfsdk:"snapshot_size"`
+}
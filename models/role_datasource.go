@@ -0,0 +1,36 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// RoleDataSourceModel is the schema that is used to fetch roles based on id, name or filter expression
+type RoleDataSourceModel struct {
+	ID      types.String          `tfsdk:"id"`
+	Name    types.String          `tfsdk:"name"`
+	Filters FilterExpressionValue `tfsdk:"filter_expression"`
+	Roles   []RoleDataSource      `tfsdk:"roles"`
+}
+
+// RoleDataSource represents the schema of a role
+type RoleDataSource struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	IsBuiltIn   types.Bool   `tfsdk:"is_built_in"`
+	Description types.String `tfsdk:"description"`
+}
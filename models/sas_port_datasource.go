@@ -0,0 +1,45 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// SasPortDataSourceModel is the schema that is used to fetch SAS ports based on id, name or filter expression
+type SasPortDataSourceModel struct {
+	ID       types.String          `tfsdk:"id"`
+	Name     types.String          `tfsdk:"name"`
+	Filters  FilterExpressionValue `tfsdk:"filter_expression"`
+	SasPorts []SasPortDataSource   `tfsdk:"sas_ports"`
+}
+
+// SasPortDataSource represents the schema of a SAS port
+type SasPortDataSource struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	ApplianceID      types.String `tfsdk:"appliance_id"`
+	NodeID           types.String `tfsdk:"node_id"`
+	IsLinkUp         types.Bool   `tfsdk:"is_link_up"`
+	IsInUse          types.Bool   `tfsdk:"is_in_use"`
+	Speed            types.String `tfsdk:"speed"`
+	SFPID            types.String `tfsdk:"sfp_id"`
+	IoModuleID       types.String `tfsdk:"io_module_id"`
+	HardwareParentID types.String `tfsdk:"hardware_parent_id"`
+	PortIndex        types.Int64  `tfsdk:"port_index"`
+	PartnerID        types.String `tfsdk:"partner_id"`
+	StaleState       types.String `tfsdk:"stale_state"`
+}
@@ -0,0 +1,43 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// SoftwareInstalledDataSourceModel is the schema that is used to fetch installed software based on id, release version or filter expression
+type SoftwareInstalledDataSourceModel struct {
+	ID                types.String                  `tfsdk:"id"`
+	ReleaseVersion    types.String                  `tfsdk:"release_version"`
+	Filters           FilterExpressionValue         `tfsdk:"filter_expression"`
+	SoftwareInstalled []SoftwareInstalledDataSource `tfsdk:"software_installed"`
+}
+
+// SoftwareInstalledDataSource represents the schema of an installed software
+type SoftwareInstalledDataSource struct {
+	ID               types.String `tfsdk:"id"`
+	IsCluster        types.Bool   `tfsdk:"is_cluster"`
+	ReleaseVersion   types.String `tfsdk:"release_version"`
+	BuildVersion     types.String `tfsdk:"build_version"`
+	ReleaseTimestamp types.String `tfsdk:"release_timestamp"`
+	InstalledDate    types.String `tfsdk:"installed_date"`
+	BuildFlavor      types.String `tfsdk:"build_flavor"`
+	BuildType        types.String `tfsdk:"build_type"`
+	BuildID          types.String `tfsdk:"build_id"`
+	ApplianceID      types.String `tfsdk:"appliance_id"`
+	ApplianceName    types.String `tfsdk:"appliance_name"`
+}
@@ -0,0 +1,34 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// SoftwareUpgrade - software package uploaded and installed by the software upgrade resource
+type SoftwareUpgrade struct {
+	ID                   types.String `tfsdk:"id"`
+	FilePath             types.String `tfsdk:"file_path"`
+	FileSha256           types.String `tfsdk:"file_sha256"`
+	RunHealthCheck       types.Bool   `tfsdk:"run_health_check"`
+	Timeout              types.Int64  `tfsdk:"timeout"`
+	Name                 types.String `tfsdk:"name"`
+	ReleaseVersion       types.String `tfsdk:"release_version"`
+	BuildVersion         types.String `tfsdk:"build_version"`
+	SoftwarePackageState types.String `tfsdk:"software_package_state"`
+	IsRebootRequired     types.Bool   `tfsdk:"is_reboot_required"`
+}
@@ -0,0 +1,40 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// SpaceMetricsDataSourceModel is the schema that is used to fetch space metrics of an entity
+type SpaceMetricsDataSourceModel struct {
+	ID                         types.String  `tfsdk:"id"`
+	EntityType                 types.String  `tfsdk:"entity_type"`
+	EntityID                   types.String  `tfsdk:"entity_id"`
+	Timestamp                  types.String  `tfsdk:"timestamp"`
+	LogicalProvisioned         types.Int64   `tfsdk:"logical_provisioned"`
+	LogicalUsed                types.Int64   `tfsdk:"logical_used"`
+	PhysicalTotal              types.Int64   `tfsdk:"physical_total"`
+	PhysicalUsed               types.Int64   `tfsdk:"physical_used"`
+	DataReduction              types.Float64 `tfsdk:"data_reduction"`
+	EfficiencyRatio            types.Float64 `tfsdk:"efficiency_ratio"`
+	SnapshotSavings            types.Float64 `tfsdk:"snapshot_savings"`
+	ThinSavings                types.Float64 `tfsdk:"thin_savings"`
+	LogicalUsedGrowthLastDay   types.Int64   `tfsdk:"logical_used_growth_last_day"`
+	LogicalUsedGrowthLastWeek  types.Int64   `tfsdk:"logical_used_growth_last_week"`
+	PhysicalUsedGrowthLastDay  types.Int64   `tfsdk:"physical_used_growth_last_day"`
+	PhysicalUsedGrowthLastWeek types.Int64   `tfsdk:"physical_used_growth_last_week"`
+}
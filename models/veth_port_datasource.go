@@ -0,0 +1,45 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// VethPortDataSourceModel is the schema that is used to fetch virtual Ethernet ports based on id, name or filter expression
+type VethPortDataSourceModel struct {
+	ID        types.String          `tfsdk:"id"`
+	Name      types.String          `tfsdk:"name"`
+	Filters   FilterExpressionValue `tfsdk:"filter_expression"`
+	VethPorts []VethPortDataSource  `tfsdk:"veth_ports"`
+}
+
+// VethPortDataSource represents the schema of a virtual Ethernet port
+type VethPortDataSource struct {
+	ID                   types.String `tfsdk:"id"`
+	Name                 types.String `tfsdk:"name"`
+	ApplianceID          types.String `tfsdk:"appliance_id"`
+	NodeID               types.String `tfsdk:"node_id"`
+	PartnerID            types.String `tfsdk:"partner_id"`
+	MACAddress           types.String `tfsdk:"mac_address"`
+	IsLinkUp             types.Bool   `tfsdk:"is_link_up"`
+	CurrentSpeed         types.Int64  `tfsdk:"current_speed"`
+	CurrentMTU           types.Int64  `tfsdk:"current_mtu"`
+	VswitchName          types.String `tfsdk:"vswitch_name"`
+	VswitchPortGroupName types.String `tfsdk:"vswitch_port_group_name"`
+	VswitchPortID        types.Int64  `tfsdk:"vswitch_port_id"`
+	VswitchPortName      types.String `tfsdk:"vswitch_port_name"`
+}
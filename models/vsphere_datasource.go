@@ -0,0 +1,75 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// DatastoreDataSourceModel is the schema that is used to fetch datastores based on id, name or filter expression
+type DatastoreDataSourceModel struct {
+	ID         types.String          `tfsdk:"id"`
+	Name       types.String          `tfsdk:"name"`
+	Filters    FilterExpressionValue `tfsdk:"filter_expression"`
+	Datastores []DatastoreDataSource `tfsdk:"datastores"`
+}
+
+// DatastoreDataSource represents the schema of a vSphere datastore
+type DatastoreDataSource struct {
+	ID                 types.String   `tfsdk:"id"`
+	Name               types.String   `tfsdk:"name"`
+	Type               types.String   `tfsdk:"type"`
+	InstanceUUID       types.String   `tfsdk:"instance_uuid"`
+	VsphereObjectID    types.String   `tfsdk:"vsphere_object_id"`
+	VcenterID          types.String   `tfsdk:"vcenter_id"`
+	StorageContainerID types.String   `tfsdk:"storage_container_id"`
+	NfsExportID        types.String   `tfsdk:"nfs_export_id"`
+	FileSystemID       types.String   `tfsdk:"file_system_id"`
+	VolumeIDs          []types.String `tfsdk:"volume_ids"`
+	VsphereHostIDs     []types.String `tfsdk:"vsphere_host_ids"`
+	VirtualMachineIDs  []types.String `tfsdk:"virtual_machine_ids"`
+}
+
+// VsphereHostDataSourceModel is the schema that is used to fetch vSphere hosts based on id, name or filter expression
+type VsphereHostDataSourceModel struct {
+	ID           types.String            `tfsdk:"id"`
+	Name         types.String            `tfsdk:"name"`
+	Filters      FilterExpressionValue   `tfsdk:"filter_expression"`
+	VsphereHosts []VsphereHostDataSource `tfsdk:"vsphere_hosts"`
+}
+
+// VsphereHostDataSource represents the schema of a vSphere ESXi host
+type VsphereHostDataSource struct {
+	ID                 types.String                             `tfsdk:"id"`
+	Name               types.String                             `tfsdk:"name"`
+	VsphereObjectID    types.String                             `tfsdk:"vsphere_object_id"`
+	VcenterID          types.String                             `tfsdk:"vcenter_id"`
+	Version            types.String                             `tfsdk:"version"`
+	Build              types.String                             `tfsdk:"build"`
+	HostIDs            []types.String                           `tfsdk:"host_ids"`
+	DatastoreIDs       []types.String                           `tfsdk:"datastore_ids"`
+	VirtualMachineIDs  []types.String                           `tfsdk:"virtual_machine_ids"`
+	LicenseAssignments []VsphereHostLicenseAssignmentDataSource `tfsdk:"license_assignments"`
+}
+
+// VsphereHostLicenseAssignmentDataSource represents a license assigned to a vSphere host
+type VsphereHostLicenseAssignmentDataSource struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	LicenseKey     types.String `tfsdk:"license_key"`
+	EditionKey     types.String `tfsdk:"edition_key"`
+	ExpirationDate types.String `tfsdk:"expiration_date"`
+}
@@ -0,0 +1,40 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// X509Certificate - certificate imported for a PowerStore service
+type X509Certificate struct {
+	ID                   types.String `tfsdk:"id"`
+	Type                 types.String `tfsdk:"type"`
+	Service              types.String `tfsdk:"service"`
+	Scope                types.String `tfsdk:"scope"`
+	Certificate          types.String `tfsdk:"certificate"`
+	PrivateKey           types.String `tfsdk:"private_key"`
+	Passphrase           types.String `tfsdk:"passphrase"`
+	IsCurrent            types.Bool   `tfsdk:"is_current"`
+	IsValid              types.Bool   `tfsdk:"is_valid"`
+	Subject              types.String `tfsdk:"subject"`
+	Issuer               types.String `tfsdk:"issuer"`
+	SerialNumber         types.String `tfsdk:"serial_number"`
+	Fingerprint          types.String `tfsdk:"fingerprint"`
+	FingerprintAlgorithm types.String `tfsdk:"fingerprint_algorithm"`
+	ValidFrom            types.String `tfsdk:"valid_from"`
+	ValidTo              types.String `tfsdk:"valid_to"`
+}
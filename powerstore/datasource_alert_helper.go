@@ -0,0 +1,51 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+)
+
+// alertDatasourceSelect lists the alert fields queried by the alert datasource
+const alertDatasourceSelect = "id,event_code,severity,resource_type,resource_id,resource_name,description_l10n,generated_timestamp,state,is_acknowledged,raised_timestamp,cleared_timestamp,acknowledged_timestamp,called_home_timestamp,email_sent_timestamp,snmp_sent_timestamp"
+
+// updateAlertState iterates over the alert list and update the state
+func updateAlertState(in []clientgen.AlertInstance) []models.AlertDataSource {
+	return helper.SliceTransform(in, func(in clientgen.AlertInstance) models.AlertDataSource {
+		return models.AlertDataSource{
+			ID:                    helper.TfString(in.Id),
+			EventCode:             helper.TfString(in.EventCode),
+			Severity:              helper.TfString(in.Severity),
+			ResourceType:          helper.TfString(in.ResourceType),
+			ResourceID:            helper.TfString(in.ResourceId),
+			ResourceName:          helper.TfString(in.ResourceName),
+			Description:           helper.TfString(in.DescriptionL10n),
+			GeneratedTimestamp:    helper.TfStringFromPTime(in.GeneratedTimestamp),
+			State:                 helper.TfString(in.State),
+			IsAcknowledged:        helper.TfBool(in.IsAcknowledged),
+			RaisedTimestamp:       helper.TfStringFromPTime(in.RaisedTimestamp),
+			ClearedTimestamp:      helper.TfStringFromPTime(in.ClearedTimestamp),
+			AcknowledgedTimestamp: helper.TfStringFromPTime(in.AcknowledgedTimestamp),
+			CalledHomeTimestamp:   helper.TfStringFromPTime(in.CalledHomeTimestamp),
+			EmailSentTimestamp:    helper.TfStringFromPTime(in.EmailSentTimestamp),
+			SnmpSentTimestamp:     helper.TfStringFromPTime(in.SnmpSentTimestamp),
+		}
+	})
+}
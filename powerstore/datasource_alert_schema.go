@@ -0,0 +1,108 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+// AlertDatasourceSchema is a function that returns the schema for alert datasource
+func AlertDatasourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "Unique identifier of the alert.",
+			MarkdownDescription: "Unique identifier of the alert.",
+			Computed:            true,
+		},
+		"event_code": schema.StringAttribute{
+			Description:         "Event code of the latest event of the alert.",
+			MarkdownDescription: "Event code of the latest event of the alert.",
+			Computed:            true,
+		},
+		"severity": schema.StringAttribute{
+			Description:         "Severity of the latest event of the alert.",
+			MarkdownDescription: "Severity of the latest event of the alert.",
+			Computed:            true,
+		},
+		"resource_type": schema.StringAttribute{
+			Description:         "Type of the resource instance which generated the alert.",
+			MarkdownDescription: "Type of the resource instance which generated the alert.",
+			Computed:            true,
+		},
+		"resource_id": schema.StringAttribute{
+			Description:         "Unique identifier of the resource instance which generated the alert.",
+			MarkdownDescription: "Unique identifier of the resource instance which generated the alert.",
+			Computed:            true,
+		},
+		"resource_name": schema.StringAttribute{
+			Description:         "Name of the resource instance which generated the alert.",
+			MarkdownDescription: "Name of the resource instance which generated the alert.",
+			Computed:            true,
+		},
+		"description": schema.StringAttribute{
+			Description:         "Description of the latest event of the alert.",
+			MarkdownDescription: "Description of the latest event of the alert.",
+			Computed:            true,
+		},
+		"generated_timestamp": schema.StringAttribute{
+			Description:         "Timestamp of the latest event of the alert.",
+			MarkdownDescription: "Timestamp of the latest event of the alert.",
+			Computed:            true,
+		},
+		"state": schema.StringAttribute{
+			Description:         "State of the alert, either ACTIVE or CLEARED.",
+			MarkdownDescription: "State of the alert, either ACTIVE or CLEARED.",
+			Computed:            true,
+		},
+		"is_acknowledged": schema.BoolAttribute{
+			Description:         "Whether the alert has been acknowledged.",
+			MarkdownDescription: "Whether the alert has been acknowledged.",
+			Computed:            true,
+		},
+		"raised_timestamp": schema.StringAttribute{
+			Description:         "Timestamp of the first event of the alert.",
+			MarkdownDescription: "Timestamp of the first event of the alert.",
+			Computed:            true,
+		},
+		"cleared_timestamp": schema.StringAttribute{
+			Description:         "Timestamp of the event that cleared the alert.",
+			MarkdownDescription: "Timestamp of the event that cleared the alert.",
+			Computed:            true,
+		},
+		"acknowledged_timestamp": schema.StringAttribute{
+			Description:         "Timestamp when the alert was acknowledged, if any.",
+			MarkdownDescription: "Timestamp when the alert was acknowledged, if any.",
+			Computed:            true,
+		},
+		"called_home_timestamp": schema.StringAttribute{
+			Description:         "Timestamp when the alert was reported to support, if any.",
+			MarkdownDescription: "Timestamp when the alert was reported to support, if any.",
+			Computed:            true,
+		},
+		"email_sent_timestamp": schema.StringAttribute{
+			Description:         "Timestamp when the email was sent for the alert, if any.",
+			MarkdownDescription: "Timestamp when the email was sent for the alert, if any.",
+			Computed:            true,
+		},
+		"snmp_sent_timestamp": schema.StringAttribute{
+			Description:         "Timestamp when the SNMP trap was sent for the alert, if any.",
+			MarkdownDescription: "Timestamp when the SNMP trap was sent for the alert, if any.",
+			Computed:            true,
+		},
+	}
+}
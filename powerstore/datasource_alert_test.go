@@ -0,0 +1,117 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Test to Fetch Alerts
+func TestAccAlertDs(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				// Get all Alerts
+				Config: ProviderConfigForTesting + AlertDataSourceParamsAll,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerstore_alert.test", "alerts.0.id"),
+					resource.TestCheckResourceAttrSet("data.powerstore_alert.test", "alerts.0.state"),
+				),
+			},
+			{
+				// Get Alert by ID
+				Config: ProviderConfigForTesting + AlertDataSourceParamsAll + AlertDataSourceParamsID,
+				Check:  resource.TestCheckResourceAttr("data.powerstore_alert.test1", "alerts.#", "1"),
+			},
+			{
+				// Get Alerts by resource name
+				Config: ProviderConfigForTesting + AlertDataSourceParamsAll + AlertDataSourceParamsResourceName,
+				Check:  resource.TestCheckResourceAttrPair("data.powerstore_alert.test1", "alerts.0.resource_name", "data.powerstore_alert.test", "alerts.0.resource_name"),
+			},
+			{
+				// Get Alerts by filter expression
+				Config: ProviderConfigForTesting + AlertDataSourceParamsFilter,
+				Check:  resource.TestCheckResourceAttr("data.powerstore_alert.test", "alerts.0.state", "ACTIVE"),
+			},
+			{
+				Config:      ProviderConfigForTesting + AlertDataSourceParamsIDNegative,
+				ExpectError: regexp.MustCompile("Unable to Read PowerStore Alerts"),
+			},
+			{
+				Config:      ProviderConfigForTesting + AlertDataSourceParamsFilterNegative,
+				ExpectError: regexp.MustCompile("Unable to Read PowerStore Alerts"),
+			},
+			{
+				Config:      ProviderConfigForTesting + AlertDataSourceParamsIDAndResourceNameNegative,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
+
+var AlertDataSourceParamsAll = `
+data "powerstore_alert" "test" {
+}
+`
+
+var AlertDataSourceParamsID = `
+data "powerstore_alert" "test1" {
+	id = data.powerstore_alert.test.alerts[0].id
+}
+`
+
+var AlertDataSourceParamsResourceName = `
+data "powerstore_alert" "test1" {
+	resource_name = data.powerstore_alert.test.alerts[0].resource_name
+}
+`
+
+var AlertDataSourceParamsFilter = `
+data "powerstore_alert" "test" {
+	filter_expression = "state=eq.ACTIVE"
+}
+`
+
+var AlertDataSourceParamsIDNegative = `
+data "powerstore_alert" "test" {
+	id = "invalid-id"
+}
+`
+
+var AlertDataSourceParamsFilterNegative = `
+data "powerstore_alert" "test" {
+	filter_expression = "name=inv.invalid"
+}
+`
+
+var AlertDataSourceParamsIDAndResourceNameNegative = `
+data "powerstore_alert" "test" {
+	id = "invalid-id"
+	resource_name = "invalid"
+}
+`
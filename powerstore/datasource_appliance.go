@@ -0,0 +1,164 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"context"
+	"net/url"
+	"terraform-provider-powerstore/client"
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &applianceDataSource{}
+	_ datasource.DataSourceWithConfigure = &applianceDataSource{}
+)
+
+// newApplianceDataSource returns the appliance data source object
+func newApplianceDataSource() datasource.DataSource {
+	return &applianceDataSource{}
+}
+
+// applianceDataSource is the data source implementation
+type applianceDataSource struct {
+	client *client.Client
+}
+
+// Metadata returns the data source type name
+func (d *applianceDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_appliance"
+}
+
+// Schema defines the schema for the data source
+func (d *applianceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "This datasource is used to query the existing appliances from PowerStore array. The information fetched from this datasource can be used for getting the details for further processing in resource block.",
+		MarkdownDescription: "This datasource is used to query the existing appliances from PowerStore array. The information fetched from this datasource can be used for getting the details for further processing in resource block.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Unique identifier of the appliance. Conflicts with `name` and `filter_expression`.",
+				MarkdownDescription: "Unique identifier of the appliance. Conflicts with `name` and `filter_expression`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("name")),
+					stringvalidator.ConflictsWith(path.MatchRoot("filter_expression")),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description:         "Appliance name. Conflicts with `id` and `filter_expression`.",
+				MarkdownDescription: "Appliance name. Conflicts with `id` and `filter_expression`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("filter_expression")),
+				},
+			},
+			"filter_expression": schema.StringAttribute{
+				Description:         "PowerStore filter expression to filter appliances by. Conflicts with `id` and `name`.",
+				MarkdownDescription: "PowerStore filter expression to filter appliances by. Conflicts with `id` and `name`.",
+				Optional:            true,
+				CustomType:          models.FilterExpressionType{},
+			},
+			"appliances": schema.ListNestedAttribute{
+				Description:         "List of appliances.",
+				MarkdownDescription: "List of appliances.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: ApplianceDatasourceSchema(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source
+func (d *applianceDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*client.Client)
+}
+
+// Read updates the Terraform state with the latest appliance data
+func (d *applianceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state models.ApplianceDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	queries := make(url.Values)
+	queries.Set("select", applianceDatasourceSelect)
+	// Read the appliances based on id/name/filter and if nothing is mentioned, then it returns all the appliances
+	dsreq := helper.DsReq[clientgen.ApplianceInstance, clientgen.ApiGetApplianceByIdRequest, clientgen.ApiGetAllAppliancesRequest]{
+		Instance:   d.client.GenClient.ApplianceApi.GetApplianceById,
+		Collection: d.client.GenClient.ApplianceApi.GetAllAppliances,
+	}
+	if !state.Name.IsNull() {
+		queries.Set("name", "eq."+state.Name.ValueString())
+	} else if !state.Filters.IsNull() {
+		queries = helper.MergeValues(queries, state.Filters.ValueQueries())
+	}
+	appliances, err := dsreq.Execute(ctx, queries, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read PowerStore Appliances",
+			err.Error(),
+		)
+		return
+	}
+
+	// check that there is atleast one appliance if name is provided
+	if state.Name.ValueString() != "" && len(appliances) == 0 {
+		resp.Diagnostics.AddError(
+			"Unable to Read PowerStore Appliances",
+			"There is no appliance with name "+state.Name.ValueString(),
+		)
+		return
+	}
+
+	state.Appliances = make([]models.ApplianceDataSource, 0, len(appliances))
+	for _, appliance := range appliances {
+		applianceID := helper.TfString(appliance.Id).ValueString()
+		metrics, err := d.client.GetLatestApplianceSpaceMetrics(ctx, applianceID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read PowerStore Appliance capacity",
+				"Could not read space metrics of appliance "+applianceID+" with error "+err.Error(),
+			)
+			return
+		}
+		state.Appliances = append(state.Appliances, newApplianceState(appliance, metrics))
+	}
+	state.ID = types.StringValue("placeholder")
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,68 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+
+	"github.com/dell/gopowerstore"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// applianceDatasourceSelect lists the appliance fields queried by the appliance datasource
+const applianceDatasourceSelect = "id,name,service_tag,express_service_code,model,mode,node_count,drive_failure_tolerance_level," +
+	"storage_class,is_hyper_converged,mode_l10n,drive_failure_tolerance_level_l10n,storage_class_l10n,nodes(id)"
+
+// newApplianceState converts an appliance fetched from PowerStore to its terraform state
+func newApplianceState(in clientgen.ApplianceInstance, metrics *gopowerstore.SpaceMetricsByApplianceResponse) models.ApplianceDataSource {
+	return models.ApplianceDataSource{
+		ID:                             helper.TfString(in.Id),
+		Name:                           helper.TfString(in.Name),
+		ServiceTag:                     helper.TfString(in.ServiceTag),
+		ExpressServiceCode:             helper.TfString(in.ExpressServiceCode),
+		Model:                          helper.TfString(in.Model),
+		Mode:                           helper.TfString(in.Mode),
+		NodeCount:                      helper.TfInt64(in.NodeCount),
+		DriveFailureToleranceLevel:     helper.TfString(in.DriveFailureToleranceLevel),
+		StorageClass:                   helper.TfString(in.StorageClass),
+		IsHyperConverged:               helper.TfBool(in.IsHyperConverged),
+		ModeL10n:                       helper.TfString(in.ModeL10n),
+		DriveFailureToleranceLevelL10n: helper.TfString(in.DriveFailureToleranceLevelL10n),
+		StorageClassL10n:               helper.TfString(in.StorageClassL10n),
+		NodeIDs: helper.SliceTransform(in.Nodes, func(in clientgen.NodeInstance) types.String {
+			return helper.TfString(in.Id)
+		}),
+		Capacity: helper.TfObject(metrics, func(in gopowerstore.SpaceMetricsByApplianceResponse) models.CapacityDataSource {
+			return newCapacityState(in.PhysicalTotal, in.PhysicalUsed, in.LogicalProvisioned, in.LogicalUsed, in.DataReduction, in.EfficiencyRatio)
+		}),
+	}
+}
+
+// newCapacityState builds the capacity state from the latest space metrics sample
+func newCapacityState(physicalTotal, physicalUsed, logicalProvisioned, logicalUsed *int64, dataReduction, efficiencyRatio float32) models.CapacityDataSource {
+	return models.CapacityDataSource{
+		PhysicalTotal:      helper.TfInt64(physicalTotal),
+		PhysicalUsed:       helper.TfInt64(physicalUsed),
+		LogicalProvisioned: helper.TfInt64(logicalProvisioned),
+		LogicalUsed:        helper.TfInt64(logicalUsed),
+		DataReduction:      types.Float64Value(float64(dataReduction)),
+		EfficiencyRatio:    types.Float64Value(float64(efficiencyRatio)),
+	}
+}
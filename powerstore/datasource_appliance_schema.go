@@ -0,0 +1,142 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ApplianceDatasourceSchema is a function that returns the schema for appliance datasource
+func ApplianceDatasourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "Unique identifier of the appliance.",
+			MarkdownDescription: "Unique identifier of the appliance.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			Description:         "Name of the appliance.",
+			MarkdownDescription: "Name of the appliance.",
+			Computed:            true,
+		},
+		"service_tag": schema.StringAttribute{
+			Description:         "Dell Service Tag of the appliance.",
+			MarkdownDescription: "Dell Service Tag of the appliance.",
+			Computed:            true,
+		},
+		"express_service_code": schema.StringAttribute{
+			Description:         "Express Service Code of the appliance.",
+			MarkdownDescription: "Express Service Code of the appliance.",
+			Computed:            true,
+		},
+		"model": schema.StringAttribute{
+			Description:         "Model of the appliance.",
+			MarkdownDescription: "Model of the appliance.",
+			Computed:            true,
+		},
+		"mode": schema.StringAttribute{
+			Description:         "Mode of the appliance. Possible values are Unified and Block.",
+			MarkdownDescription: "Mode of the appliance. Possible values are `Unified` and `Block`.",
+			Computed:            true,
+		},
+		"node_count": schema.Int64Attribute{
+			Description:         "The number of nodes deployed on the appliance.",
+			MarkdownDescription: "The number of nodes deployed on the appliance.",
+			Computed:            true,
+		},
+		"drive_failure_tolerance_level": schema.StringAttribute{
+			Description:         "Drive failure tolerance level of the appliance. Possible values are Single and Double.",
+			MarkdownDescription: "Drive failure tolerance level of the appliance. Possible values are `Single` and `Double`.",
+			Computed:            true,
+		},
+		"storage_class": schema.StringAttribute{
+			Description:         "Storage class of the appliance. Possible values are QLC_Based_Flash and TLC_Based_Flash.",
+			MarkdownDescription: "Storage class of the appliance. Possible values are `QLC_Based_Flash` and `TLC_Based_Flash`.",
+			Computed:            true,
+		},
+		"is_hyper_converged": schema.BoolAttribute{
+			Description:         "Whether the appliance is a HyperConverged appliance.",
+			MarkdownDescription: "Whether the appliance is a HyperConverged appliance.",
+			Computed:            true,
+		},
+		"mode_l10n": schema.StringAttribute{
+			Description:         "Localized message string corresponding to mode.",
+			MarkdownDescription: "Localized message string corresponding to mode.",
+			Computed:            true,
+		},
+		"drive_failure_tolerance_level_l10n": schema.StringAttribute{
+			Description:         "Localized message string corresponding to drive failure tolerance level.",
+			MarkdownDescription: "Localized message string corresponding to drive failure tolerance level.",
+			Computed:            true,
+		},
+		"storage_class_l10n": schema.StringAttribute{
+			Description:         "Localized message string corresponding to storage class.",
+			MarkdownDescription: "Localized message string corresponding to storage class.",
+			Computed:            true,
+		},
+		"node_ids": schema.ListAttribute{
+			Description:         "Unique identifiers of the nodes of the appliance.",
+			MarkdownDescription: "Unique identifiers of the nodes of the appliance.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"capacity": schema.SingleNestedAttribute{
+			Description:         "Latest space usage of the appliance.",
+			MarkdownDescription: "Latest space usage of the appliance.",
+			Computed:            true,
+			Attributes:          CapacityDatasourceSchema(),
+		},
+	}
+}
+
+// CapacityDatasourceSchema is a function that returns the schema for the latest space usage of an appliance or cluster
+func CapacityDatasourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"physical_total": schema.Int64Attribute{
+			Description:         "Total physical space in bytes.",
+			MarkdownDescription: "Total physical space in bytes.",
+			Computed:            true,
+		},
+		"physical_used": schema.Int64Attribute{
+			Description:         "Physical space used in bytes, after deduplication and compression.",
+			MarkdownDescription: "Physical space used in bytes, after deduplication and compression.",
+			Computed:            true,
+		},
+		"logical_provisioned": schema.Int64Attribute{
+			Description:         "Total configured size in bytes of all storage objects.",
+			MarkdownDescription: "Total configured size in bytes of all storage objects.",
+			Computed:            true,
+		},
+		"logical_used": schema.Int64Attribute{
+			Description:         "Amount of data in bytes written to all storage objects, without any deduplication and compression.",
+			MarkdownDescription: "Amount of data in bytes written to all storage objects, without any deduplication and compression.",
+			Computed:            true,
+		},
+		"data_reduction": schema.Float64Attribute{
+			Description:         "Ratio of the logical used space to data physical used space.",
+			MarkdownDescription: "Ratio of the logical used space to data physical used space.",
+			Computed:            true,
+		},
+		"efficiency_ratio": schema.Float64Attribute{
+			Description:         "Ratio of the total space provisioned to physical used space.",
+			MarkdownDescription: "Ratio of the total space provisioned to physical used space.",
+			Computed:            true,
+		},
+	}
+}
@@ -0,0 +1,131 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Test to Fetch Appliances
+func TestAccApplianceDs(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				// Get all Appliances
+				Config: ProviderConfigForTesting + ApplianceDataSourceParamsAll,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerstore_appliance.test", "appliances.0.id"),
+					resource.TestCheckResourceAttrSet("data.powerstore_appliance.test", "appliances.0.model"),
+					resource.TestCheckResourceAttrSet("data.powerstore_appliance.test", "appliances.0.service_tag"),
+					resource.TestCheckResourceAttrSet("data.powerstore_appliance.test", "appliances.0.mode"),
+				),
+			},
+			{
+				// Get Appliance by ID
+				Config: ProviderConfigForTesting + ApplianceDataSourceParamsAll + ApplianceDataSourceParamsID,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerstore_appliance.test1", "appliances.#", "1"),
+					resource.TestCheckResourceAttrPair("data.powerstore_appliance.test1", "appliances.0.id", "data.powerstore_appliance.test", "appliances.0.id"),
+				),
+			},
+			{
+				// Get Appliance by name
+				Config: ProviderConfigForTesting + ApplianceDataSourceParamsAll + ApplianceDataSourceParamsName,
+				Check:  resource.TestCheckResourceAttrPair("data.powerstore_appliance.test1", "appliances.0.name", "data.powerstore_appliance.test", "appliances.0.name"),
+			},
+			{
+				// Get Appliances by filter expression
+				Config: ProviderConfigForTesting + ApplianceDataSourceParamsFilter,
+				Check:  resource.TestMatchResourceAttr("data.powerstore_appliance.test", "appliances.0.mode", regexp.MustCompile(`^(Unified|Block)$`)),
+			},
+			{
+				Config:      ProviderConfigForTesting + ApplianceDataSourceParamsIDNegative,
+				ExpectError: regexp.MustCompile("Unable to Read PowerStore Appliances"),
+			},
+			{
+				Config:      ProviderConfigForTesting + ApplianceDataSourceParamsNameNegative,
+				ExpectError: regexp.MustCompile("Unable to Read PowerStore Appliances"),
+			},
+			{
+				Config:      ProviderConfigForTesting + ApplianceDataSourceParamsFilterNegative,
+				ExpectError: regexp.MustCompile("Unable to Read PowerStore Appliances"),
+			},
+			{
+				Config:      ProviderConfigForTesting + ApplianceDataSourceParamsEmptyIDNegative,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Length"),
+			},
+		},
+	})
+}
+
+var ApplianceDataSourceParamsAll = `
+data "powerstore_appliance" "test" {
+}
+`
+
+var ApplianceDataSourceParamsID = `
+data "powerstore_appliance" "test1" {
+	id = data.powerstore_appliance.test.appliances[0].id
+}
+`
+
+var ApplianceDataSourceParamsName = `
+data "powerstore_appliance" "test1" {
+	name = data.powerstore_appliance.test.appliances[0].name
+}
+`
+
+var ApplianceDataSourceParamsFilter = `
+data "powerstore_appliance" "test" {
+	filter_expression = "mode=in.(Unified,Block)"
+}
+`
+
+var ApplianceDataSourceParamsIDNegative = `
+data "powerstore_appliance" "test" {
+	id = "invalid-id"
+}
+`
+
+var ApplianceDataSourceParamsNameNegative = `
+data "powerstore_appliance" "test" {
+	name = "invalid-name"
+}
+`
+
+var ApplianceDataSourceParamsFilterNegative = `
+data "powerstore_appliance" "test" {
+	filter_expression = "name=inv.invalid"
+}
+`
+
+var ApplianceDataSourceParamsEmptyIDNegative = `
+data "powerstore_appliance" "test" {
+	id = ""
+}
+`
@@ -0,0 +1,167 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"context"
+	"regexp"
+	"terraform-provider-powerstore/client"
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &auditEventDataSource{}
+	_ datasource.DataSourceWithConfigure = &auditEventDataSource{}
+)
+
+// newAuditEventDataSource returns the audit event data source object
+func newAuditEventDataSource() datasource.DataSource {
+	return &auditEventDataSource{}
+}
+
+// auditEventDataSource is the data source implementation
+type auditEventDataSource struct {
+	client *client.Client
+}
+
+// auditEventTimestampRegex matches the UTC timestamps accepted by the time range filters
+var auditEventTimestampRegex = regexp.MustCompile(`^[0-9]{4}-[0-9]{2}-[0-9]{2}T[0-9]{2}:[0-9]{2}:[0-9]{2}Z$`)
+
+// Metadata returns the data source type name
+func (d *auditEventDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_audit_event"
+}
+
+// Schema defines the schema for the data source
+func (d *auditEventDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "This datasource is used to query the audit log of PowerStore array, most recent events first. The information fetched from this datasource can be used for tracking the changes made on the array.",
+		MarkdownDescription: "This datasource is used to query the audit log of PowerStore array, most recent events first. The information fetched from this datasource can be used for tracking the changes made on the array.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Placeholder identifier of the datasource.",
+				MarkdownDescription: "Placeholder identifier of the datasource.",
+				Computed:            true,
+			},
+			"start_time": schema.StringAttribute{
+				Description:         "Only fetch the audit events recorded at or after this time. Only UTC (+Z) format is allowed eg: 2023-05-06T09:01:47Z. Conflicts with filter_expression.",
+				MarkdownDescription: "Only fetch the audit events recorded at or after this time. Only UTC (+Z) format is allowed eg: 2023-05-06T09:01:47Z. Conflicts with `filter_expression`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(auditEventTimestampRegex, "Only UTC (+Z) format is allowed eg: 2023-05-06T09:01:47Z"),
+					stringvalidator.ConflictsWith(path.MatchRoot("filter_expression")),
+				},
+			},
+			"end_time": schema.StringAttribute{
+				Description:         "Only fetch the audit events recorded at or before this time. Only UTC (+Z) format is allowed eg: 2023-05-06T09:01:47Z. Conflicts with filter_expression.",
+				MarkdownDescription: "Only fetch the audit events recorded at or before this time. Only UTC (+Z) format is allowed eg: 2023-05-06T09:01:47Z. Conflicts with `filter_expression`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(auditEventTimestampRegex, "Only UTC (+Z) format is allowed eg: 2023-05-06T09:01:47Z"),
+					stringvalidator.ConflictsWith(path.MatchRoot("filter_expression")),
+				},
+			},
+			"username": schema.StringAttribute{
+				Description:         "Only fetch the audit events of operations performed by this user. Conflicts with filter_expression.",
+				MarkdownDescription: "Only fetch the audit events of operations performed by this user. Conflicts with `filter_expression`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("filter_expression")),
+				},
+			},
+			"resource_type": schema.StringAttribute{
+				Description:         "Only fetch the audit events of operations performed on this type of resource. Conflicts with filter_expression.",
+				MarkdownDescription: "Only fetch the audit events of operations performed on this type of resource. Conflicts with `filter_expression`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(helper.SliceTransform(clientgen.AllowedResourceTypeEnumEnumValues, func(in clientgen.ResourceTypeEnum) string {
+						return string(in)
+					})...),
+					stringvalidator.ConflictsWith(path.MatchRoot("filter_expression")),
+				},
+			},
+			"is_successful": schema.BoolAttribute{
+				Description:         "Only fetch the audit events of operations which succeeded or, if false, failed. Conflicts with filter_expression.",
+				MarkdownDescription: "Only fetch the audit events of operations which succeeded or, if false, failed. Conflicts with `filter_expression`.",
+				Optional:            true,
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("filter_expression")),
+				},
+			},
+			"filter_expression": schema.StringAttribute{
+				Description:         "PowerStore filter expression to filter audit events by. Conflicts with start_time, end_time, username, resource_type and is_successful.",
+				MarkdownDescription: "PowerStore filter expression to filter audit events by. Conflicts with `start_time`, `end_time`, `username`, `resource_type` and `is_successful`.",
+				Optional:            true,
+				CustomType:          models.FilterExpressionType{},
+			},
+			"audit_events": schema.ListNestedAttribute{
+				Description:         "List of audit events.",
+				MarkdownDescription: "List of audit events.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: AuditEventDatasourceSchema(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source
+func (d *auditEventDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*client.Client)
+}
+
+// Read updates the Terraform state with the audit events
+func (d *auditEventDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state models.AuditEventDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the audit log can be large, so the events are filtered and paginated on the array
+	items, err := d.client.GetAuditEvents(ctx, auditEventFilters(state))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read PowerStore Audit Events",
+			err.Error(),
+		)
+		return
+	}
+
+	state.AuditEvents = updateAuditEventState(items)
+	state.ID = types.StringValue("placeholder")
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
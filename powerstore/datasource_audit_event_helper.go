@@ -0,0 +1,78 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"strconv"
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// auditEventFilters converts the audit event datasource filters to PowerStore query filters
+func auditEventFilters(state models.AuditEventDataSourceModel) map[string]string {
+	if !state.Filters.IsNull() {
+		return convertQueriesToMap(state.Filters.ValueQueries())
+	}
+	filters := make(map[string]string)
+	// a filter can only be given once per field, so a closed time range is expressed as a conjunction
+	switch {
+	case !state.StartTime.IsNull() && !state.EndTime.IsNull():
+		filters["and"] = "(timestamp.gte." + state.StartTime.ValueString() + ",timestamp.lte." + state.EndTime.ValueString() + ")"
+	case !state.StartTime.IsNull():
+		filters["timestamp"] = "gte." + state.StartTime.ValueString()
+	case !state.EndTime.IsNull():
+		filters["timestamp"] = "lte." + state.EndTime.ValueString()
+	}
+	if !state.Username.IsNull() {
+		filters["username"] = "eq." + state.Username.ValueString()
+	}
+	if !state.ResourceType.IsNull() {
+		filters["resource_type"] = "eq." + state.ResourceType.ValueString()
+	}
+	if !state.IsSuccessful.IsNull() {
+		filters["is_successful"] = "eq." + strconv.FormatBool(state.IsSuccessful.ValueBool())
+	}
+	return filters
+}
+
+// updateAuditEventState iterates over the audit event list and update the state
+func updateAuditEventState(in []clientgen.AuditEventInstance) []models.AuditEventDataSource {
+	return helper.SliceTransform(in, func(in clientgen.AuditEventInstance) models.AuditEventDataSource {
+		return models.AuditEventDataSource{
+			ID:             helper.TfString(in.Id),
+			Type:           helper.TfString(in.Type),
+			Timestamp:      helper.TfStringFromPTime(in.Timestamp),
+			Username:       helper.TfString(in.Username),
+			IsSuccessful:   helper.TfBool(in.IsSuccessful),
+			ClientAddress:  helper.TfString(in.ClientAddress),
+			ServerAddress:  helper.TfString(in.ServerAddress),
+			ApplianceID:    helper.TfString(in.ApplianceId),
+			JobID:          helper.TfString(in.JobId),
+			ResourceType:   helper.TfString(in.ResourceType),
+			ResourceAction: helper.TfString(in.ResourceAction),
+			MessageCode:    helper.TfString(in.MessageCode),
+			MessageArguments: helper.SliceTransform(in.MessageArguments, func(in string) types.String {
+				return types.StringValue(in)
+			}),
+			Message: helper.TfString(in.MessageL10n),
+		}
+	})
+}
@@ -0,0 +1,100 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AuditEventDatasourceSchema is a function that returns the schema for audit event datasource
+func AuditEventDatasourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "Unique identifier of the audit event.",
+			MarkdownDescription: "Unique identifier of the audit event.",
+			Computed:            true,
+		},
+		"type": schema.StringAttribute{
+			Description:         "Type of the audit event.",
+			MarkdownDescription: "Type of the audit event.",
+			Computed:            true,
+		},
+		"timestamp": schema.StringAttribute{
+			Description:         "Time when the audit event was recorded.",
+			MarkdownDescription: "Time when the audit event was recorded.",
+			Computed:            true,
+		},
+		"username": schema.StringAttribute{
+			Description:         "Name of the user who performed the audited operation.",
+			MarkdownDescription: "Name of the user who performed the audited operation.",
+			Computed:            true,
+		},
+		"is_successful": schema.BoolAttribute{
+			Description:         "Whether the audited operation succeeded.",
+			MarkdownDescription: "Whether the audited operation succeeded.",
+			Computed:            true,
+		},
+		"client_address": schema.StringAttribute{
+			Description:         "Address of the client which requested the operation.",
+			MarkdownDescription: "Address of the client which requested the operation.",
+			Computed:            true,
+		},
+		"server_address": schema.StringAttribute{
+			Description:         "Address of the server which handled the operation.",
+			MarkdownDescription: "Address of the server which handled the operation.",
+			Computed:            true,
+		},
+		"appliance_id": schema.StringAttribute{
+			Description:         "Unique identifier of the appliance on which the operation was performed.",
+			MarkdownDescription: "Unique identifier of the appliance on which the operation was performed.",
+			Computed:            true,
+		},
+		"job_id": schema.StringAttribute{
+			Description:         "Unique identifier of the job which ran the operation.",
+			MarkdownDescription: "Unique identifier of the job which ran the operation.",
+			Computed:            true,
+		},
+		"resource_type": schema.StringAttribute{
+			Description:         "Type of the resource the operation was performed on.",
+			MarkdownDescription: "Type of the resource the operation was performed on.",
+			Computed:            true,
+		},
+		"resource_action": schema.StringAttribute{
+			Description:         "Action performed on the resource.",
+			MarkdownDescription: "Action performed on the resource.",
+			Computed:            true,
+		},
+		"message_code": schema.StringAttribute{
+			Description:         "Code identifying the message of the audit event.",
+			MarkdownDescription: "Code identifying the message of the audit event.",
+			Computed:            true,
+		},
+		"message_arguments": schema.ListAttribute{
+			Description:         "Values substituted in the message of the audit event.",
+			MarkdownDescription: "Values substituted in the message of the audit event.",
+			ElementType:         types.StringType,
+			Computed:            true,
+		},
+		"message": schema.StringAttribute{
+			Description:         "Localized message of the audit event.",
+			MarkdownDescription: "Localized message of the audit event.",
+			Computed:            true,
+		},
+	}
+}
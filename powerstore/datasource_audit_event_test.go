@@ -0,0 +1,112 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Test to Fetch Audit Events
+func TestAccAuditEventDs(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				// Get all Audit Events
+				Config: ProviderConfigForTesting + AuditEventDataSourceParamsAll,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerstore_audit_event.test", "audit_events.0.id"),
+					resource.TestCheckResourceAttrSet("data.powerstore_audit_event.test", "audit_events.0.timestamp"),
+				),
+			},
+			{
+				// Get Audit Events by time range, user, resource type and outcome
+				Config: ProviderConfigForTesting + AuditEventDataSourceParamsAll + AuditEventDataSourceParamsFilters,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerstore_audit_event.test1", "audit_events.#"),
+				),
+			},
+			{
+				// Get Audit Events by filter expression
+				Config: ProviderConfigForTesting + AuditEventDataSourceParamsFilter,
+				Check:  resource.TestCheckResourceAttr("data.powerstore_audit_event.test", "audit_events.0.type", "Config"),
+			},
+			{
+				Config:      ProviderConfigForTesting + AuditEventDataSourceParamsFilterNegative,
+				ExpectError: regexp.MustCompile("Unable to Read PowerStore Audit Events"),
+			},
+			{
+				Config:      ProviderConfigForTesting + AuditEventDataSourceParamsInvalidTime,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+			{
+				Config:      ProviderConfigForTesting + AuditEventDataSourceParamsUsernameAndFilterNegative,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
+
+var AuditEventDataSourceParamsAll = `
+data "powerstore_audit_event" "test" {
+}
+`
+
+var AuditEventDataSourceParamsFilters = `
+data "powerstore_audit_event" "test1" {
+	start_time = "2023-01-01T00:00:00Z"
+	end_time = "2099-01-01T00:00:00Z"
+	username = data.powerstore_audit_event.test.audit_events[0].username
+	resource_type = "volume"
+	is_successful = true
+}
+`
+
+var AuditEventDataSourceParamsFilter = `
+data "powerstore_audit_event" "test" {
+	filter_expression = "type=eq.Config"
+}
+`
+
+var AuditEventDataSourceParamsFilterNegative = `
+data "powerstore_audit_event" "test" {
+	filter_expression = "name=inv.invalid"
+}
+`
+
+var AuditEventDataSourceParamsInvalidTime = `
+data "powerstore_audit_event" "test" {
+	start_time = "yesterday"
+}
+`
+
+var AuditEventDataSourceParamsUsernameAndFilterNegative = `
+data "powerstore_audit_event" "test" {
+	username = "admin"
+	filter_expression = "type=eq.Config"
+}
+`
@@ -0,0 +1,164 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"context"
+	"net/url"
+	"terraform-provider-powerstore/client"
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &clusterDataSource{}
+	_ datasource.DataSourceWithConfigure = &clusterDataSource{}
+)
+
+// newClusterDataSource returns the cluster data source object
+func newClusterDataSource() datasource.DataSource {
+	return &clusterDataSource{}
+}
+
+// clusterDataSource is the data source implementation
+type clusterDataSource struct {
+	client *client.Client
+}
+
+// Metadata returns the data source type name
+func (d *clusterDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster"
+}
+
+// Schema defines the schema for the data source
+func (d *clusterDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "This datasource is used to query the existing clusters from PowerStore array. The information fetched from this datasource can be used for getting the details for further processing in resource block.",
+		MarkdownDescription: "This datasource is used to query the existing clusters from PowerStore array. The information fetched from this datasource can be used for getting the details for further processing in resource block.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Unique identifier of the cluster. Conflicts with `name` and `filter_expression`.",
+				MarkdownDescription: "Unique identifier of the cluster. Conflicts with `name` and `filter_expression`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("name")),
+					stringvalidator.ConflictsWith(path.MatchRoot("filter_expression")),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description:         "Cluster name. Conflicts with `id` and `filter_expression`.",
+				MarkdownDescription: "Cluster name. Conflicts with `id` and `filter_expression`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("filter_expression")),
+				},
+			},
+			"filter_expression": schema.StringAttribute{
+				Description:         "PowerStore filter expression to filter clusters by. Conflicts with `id` and `name`.",
+				MarkdownDescription: "PowerStore filter expression to filter clusters by. Conflicts with `id` and `name`.",
+				Optional:            true,
+				CustomType:          models.FilterExpressionType{},
+			},
+			"clusters": schema.ListNestedAttribute{
+				Description:         "List of clusters.",
+				MarkdownDescription: "List of clusters.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: ClusterDatasourceSchema(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source
+func (d *clusterDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*client.Client)
+}
+
+// Read updates the Terraform state with the latest cluster data
+func (d *clusterDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state models.ClusterDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	queries := make(url.Values)
+	queries.Set("select", clusterDatasourceSelect)
+	// Read the clusters based on id/name/filter and if nothing is mentioned, then it returns all the clusters
+	dsreq := helper.DsReq[clientgen.ClusterInstance, clientgen.ApiGetClusterByIdRequest, clientgen.ApiGetAllClustersRequest]{
+		Instance:   d.client.GenClient.ClusterApi.GetClusterById,
+		Collection: d.client.GenClient.ClusterApi.GetAllClusters,
+	}
+	if !state.Name.IsNull() {
+		queries.Set("name", "eq."+state.Name.ValueString())
+	} else if !state.Filters.IsNull() {
+		queries = helper.MergeValues(queries, state.Filters.ValueQueries())
+	}
+	clusters, err := dsreq.Execute(ctx, queries, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read PowerStore Clusters",
+			err.Error(),
+		)
+		return
+	}
+
+	// check that there is atleast one cluster if name is provided
+	if state.Name.ValueString() != "" && len(clusters) == 0 {
+		resp.Diagnostics.AddError(
+			"Unable to Read PowerStore Clusters",
+			"There is no cluster with name "+state.Name.ValueString(),
+		)
+		return
+	}
+
+	state.Clusters = make([]models.ClusterDataSource, 0, len(clusters))
+	for _, cluster := range clusters {
+		clusterID := helper.TfString(cluster.Id).ValueString()
+		metrics, err := d.client.GetLatestClusterSpaceMetrics(ctx, clusterID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read PowerStore Cluster capacity",
+				"Could not read space metrics of cluster "+clusterID+" with error "+err.Error(),
+			)
+			return
+		}
+		state.Clusters = append(state.Clusters, newClusterState(cluster, metrics))
+	}
+	state.ID = types.StringValue("placeholder")
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
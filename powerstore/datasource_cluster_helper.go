@@ -0,0 +1,53 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+
+	"github.com/dell/gopowerstore"
+)
+
+// clusterDatasourceSelect lists the cluster fields queried by the cluster datasource
+const clusterDatasourceSelect = "id,global_id,name,management_address,storage_discovery_address,primary_appliance_id,appliance_count," +
+	"physical_mtu,is_encryption_enabled,compatibility_level,state,state_l10n,system_time,nvm_subsystem_nqn"
+
+// newClusterState converts a cluster fetched from PowerStore to its terraform state
+func newClusterState(in clientgen.ClusterInstance, metrics *gopowerstore.SpaceMetricsByClusterResponse) models.ClusterDataSource {
+	return models.ClusterDataSource{
+		ID:                      helper.TfString(in.Id),
+		GlobalID:                helper.TfString(in.GlobalId),
+		Name:                    helper.TfString(in.Name),
+		ManagementAddress:       helper.TfString(in.ManagementAddress),
+		StorageDiscoveryAddress: helper.TfString(in.StorageDiscoveryAddress),
+		PrimaryApplianceID:      helper.TfString(in.PrimaryApplianceId),
+		ApplianceCount:          helper.TfInt64(in.ApplianceCount),
+		PhysicalMtu:             helper.TfInt64(in.PhysicalMtu),
+		IsEncryptionEnabled:     helper.TfBool(in.IsEncryptionEnabled),
+		CompatibilityLevel:      helper.TfInt64(in.CompatibilityLevel),
+		State:                   helper.TfString(in.State),
+		StateL10n:               helper.TfString(in.StateL10n),
+		SystemTime:              helper.TfStringFromPTime(in.SystemTime),
+		NvmSubsystemNqn:         helper.TfString(in.NvmSubsystemNqn),
+		Capacity: helper.TfObject(metrics, func(in gopowerstore.SpaceMetricsByClusterResponse) models.CapacityDataSource {
+			return newCapacityState(in.PhysicalTotal, in.PhysicalUsed, in.LogicalProvisioned, in.LogicalUsed, in.DataReduction, in.EfficiencyRatio)
+		}),
+	}
+}
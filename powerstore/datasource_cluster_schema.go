@@ -0,0 +1,104 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+// ClusterDatasourceSchema is a function that returns the schema for cluster datasource
+func ClusterDatasourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "Unique identifier of the cluster.",
+			MarkdownDescription: "Unique identifier of the cluster.",
+			Computed:            true,
+		},
+		"global_id": schema.StringAttribute{
+			Description:         "The global unique identifier of the cluster.",
+			MarkdownDescription: "The global unique identifier of the cluster.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			Description:         "Name of the cluster.",
+			MarkdownDescription: "Name of the cluster.",
+			Computed:            true,
+		},
+		"management_address": schema.StringAttribute{
+			Description:         "The floating management IP address for the cluster in IPv4 or IPv6 format.",
+			MarkdownDescription: "The floating management IP address for the cluster in IPv4 or IPv6 format.",
+			Computed:            true,
+		},
+		"storage_discovery_address": schema.StringAttribute{
+			Description:         "The floating storage discovery IP address for the cluster in IPv4 or IPv6 format.",
+			MarkdownDescription: "The floating storage discovery IP address for the cluster in IPv4 or IPv6 format.",
+			Computed:            true,
+		},
+		"primary_appliance_id": schema.StringAttribute{
+			Description:         "The unique identifier of the appliance acting as primary.",
+			MarkdownDescription: "The unique identifier of the appliance acting as primary.",
+			Computed:            true,
+		},
+		"appliance_count": schema.Int64Attribute{
+			Description:         "Number of appliances configured in the cluster.",
+			MarkdownDescription: "Number of appliances configured in the cluster.",
+			Computed:            true,
+		},
+		"physical_mtu": schema.Int64Attribute{
+			Description:         "MTU setting of the physical ethernet ports of the cluster.",
+			MarkdownDescription: "MTU setting of the physical ethernet ports of the cluster.",
+			Computed:            true,
+		},
+		"is_encryption_enabled": schema.BoolAttribute{
+			Description:         "Whether Data at Rest Encryption is enabled on the cluster.",
+			MarkdownDescription: "Whether Data at Rest Encryption is enabled on the cluster.",
+			Computed:            true,
+		},
+		"compatibility_level": schema.Int64Attribute{
+			Description:         "The behavioral version of the software version API.",
+			MarkdownDescription: "The behavioral version of the software version API.",
+			Computed:            true,
+		},
+		"state": schema.StringAttribute{
+			Description:         "Lifecycle state of the cluster.",
+			MarkdownDescription: "Lifecycle state of the cluster.",
+			Computed:            true,
+		},
+		"state_l10n": schema.StringAttribute{
+			Description:         "Localized message string corresponding to state.",
+			MarkdownDescription: "Localized message string corresponding to state.",
+			Computed:            true,
+		},
+		"system_time": schema.StringAttribute{
+			Description:         "Current clock time of the cluster in UTC.",
+			MarkdownDescription: "Current clock time of the cluster in UTC.",
+			Computed:            true,
+		},
+		"nvm_subsystem_nqn": schema.StringAttribute{
+			Description:         "NVMe Subsystem NQN of the cluster.",
+			MarkdownDescription: "NVMe Subsystem NQN of the cluster.",
+			Computed:            true,
+		},
+		"capacity": schema.SingleNestedAttribute{
+			Description:         "Latest space usage of the cluster.",
+			MarkdownDescription: "Latest space usage of the cluster.",
+			Computed:            true,
+			Attributes:          CapacityDatasourceSchema(),
+		},
+	}
+}
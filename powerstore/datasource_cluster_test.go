@@ -0,0 +1,131 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Test to Fetch Clusters
+func TestAccClusterDs(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				// Get all Clusters
+				Config: ProviderConfigForTesting + ClusterDataSourceParamsAll,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerstore_cluster.test", "clusters.0.id"),
+					resource.TestCheckResourceAttrSet("data.powerstore_cluster.test", "clusters.0.name"),
+					resource.TestCheckResourceAttrSet("data.powerstore_cluster.test", "clusters.0.management_address"),
+					resource.TestCheckResourceAttrSet("data.powerstore_cluster.test", "clusters.0.state"),
+				),
+			},
+			{
+				// Get Cluster by ID
+				Config: ProviderConfigForTesting + ClusterDataSourceParamsAll + ClusterDataSourceParamsID,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerstore_cluster.test1", "clusters.#", "1"),
+					resource.TestCheckResourceAttrPair("data.powerstore_cluster.test1", "clusters.0.id", "data.powerstore_cluster.test", "clusters.0.id"),
+				),
+			},
+			{
+				// Get Cluster by name
+				Config: ProviderConfigForTesting + ClusterDataSourceParamsAll + ClusterDataSourceParamsName,
+				Check:  resource.TestCheckResourceAttrPair("data.powerstore_cluster.test1", "clusters.0.name", "data.powerstore_cluster.test", "clusters.0.name"),
+			},
+			{
+				// Get Clusters by filter expression
+				Config: ProviderConfigForTesting + ClusterDataSourceParamsFilter,
+				Check:  resource.TestMatchResourceAttr("data.powerstore_cluster.test", "clusters.0.appliance_count", regexp.MustCompile(`^[1-9][0-9]*$`)),
+			},
+			{
+				Config:      ProviderConfigForTesting + ClusterDataSourceParamsIDNegative,
+				ExpectError: regexp.MustCompile("Unable to Read PowerStore Clusters"),
+			},
+			{
+				Config:      ProviderConfigForTesting + ClusterDataSourceParamsNameNegative,
+				ExpectError: regexp.MustCompile("Unable to Read PowerStore Clusters"),
+			},
+			{
+				Config:      ProviderConfigForTesting + ClusterDataSourceParamsFilterNegative,
+				ExpectError: regexp.MustCompile("Unable to Read PowerStore Clusters"),
+			},
+			{
+				Config:      ProviderConfigForTesting + ClusterDataSourceParamsEmptyIDNegative,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Length"),
+			},
+		},
+	})
+}
+
+var ClusterDataSourceParamsAll = `
+data "powerstore_cluster" "test" {
+}
+`
+
+var ClusterDataSourceParamsID = `
+data "powerstore_cluster" "test1" {
+	id = data.powerstore_cluster.test.clusters[0].id
+}
+`
+
+var ClusterDataSourceParamsName = `
+data "powerstore_cluster" "test1" {
+	name = data.powerstore_cluster.test.clusters[0].name
+}
+`
+
+var ClusterDataSourceParamsFilter = `
+data "powerstore_cluster" "test" {
+	filter_expression = "appliance_count=gte.1"
+}
+`
+
+var ClusterDataSourceParamsIDNegative = `
+data "powerstore_cluster" "test" {
+	id = "invalid-id"
+}
+`
+
+var ClusterDataSourceParamsNameNegative = `
+data "powerstore_cluster" "test" {
+	name = "invalid-name"
+}
+`
+
+var ClusterDataSourceParamsFilterNegative = `
+data "powerstore_cluster" "test" {
+	filter_expression = "name=inv.invalid"
+}
+`
+
+var ClusterDataSourceParamsEmptyIDNegative = `
+data "powerstore_cluster" "test" {
+	id = ""
+}
+`
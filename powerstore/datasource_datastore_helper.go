@@ -0,0 +1,60 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// datastoreDatasourceSelect lists the datastore fields queried by the datastore datasource
+const datastoreDatasourceSelect = "id,name,type,instance_uuid,vsphere_object_id,vcenter_id,storage_container_id,nfs_export_id," +
+	"nfs_export(file_system_id),volumes(id),vsphere_hosts(id),virtual_machines(id)"
+
+// updateDatastoreState iterates over the datastore list and update the state
+func updateDatastoreState(in []clientgen.DatastoreInstance) []models.DatastoreDataSource {
+	return helper.SliceTransform(in, func(in clientgen.DatastoreInstance) models.DatastoreDataSource {
+		var fileSystemID *string
+		if in.NfsExport != nil {
+			fileSystemID = in.NfsExport.FileSystemId
+		}
+		return models.DatastoreDataSource{
+			ID:                 helper.TfString(in.Id),
+			Name:               helper.TfString(in.Name),
+			Type:               helper.TfString(in.Type),
+			InstanceUUID:       helper.TfString(in.InstanceUuid),
+			VsphereObjectID:    helper.TfString(in.VsphereObjectId),
+			VcenterID:          helper.TfString(in.VcenterId),
+			StorageContainerID: helper.TfString(in.StorageContainerId),
+			NfsExportID:        helper.TfString(in.NfsExportId),
+			FileSystemID:       helper.TfString(fileSystemID),
+			VolumeIDs: helper.SliceTransform(in.Volumes, func(in clientgen.VolumeInstance) types.String {
+				return helper.TfString(in.Id)
+			}),
+			VsphereHostIDs: helper.SliceTransform(in.VsphereHosts, func(in clientgen.VsphereHostInstance) types.String {
+				return helper.TfString(in.Id)
+			}),
+			VirtualMachineIDs: helper.SliceTransform(in.VirtualMachines, func(in clientgen.VirtualMachineInstance) types.String {
+				return helper.TfString(in.Id)
+			}),
+		}
+	})
+}
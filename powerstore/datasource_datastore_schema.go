@@ -0,0 +1,92 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DatastoreDatasourceSchema is a function that returns the schema for datastore datasource
+func DatastoreDatasourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "Unique identifier of the datastore.",
+			MarkdownDescription: "Unique identifier of the datastore.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			Description:         "Name of the datastore in vCenter.",
+			MarkdownDescription: "Name of the datastore in vCenter.",
+			Computed:            true,
+		},
+		"type": schema.StringAttribute{
+			Description:         "Type of the datastore. Valid values are `vVol`, `VMFS` and `NFS`.",
+			MarkdownDescription: "Type of the datastore. Valid values are `vVol`, `VMFS` and `NFS`.",
+			Computed:            true,
+		},
+		"instance_uuid": schema.StringAttribute{
+			Description:         "UUID of the datastore instance in vCenter.",
+			MarkdownDescription: "UUID of the datastore instance in vCenter.",
+			Computed:            true,
+		},
+		"vsphere_object_id": schema.StringAttribute{
+			Description:         "Identifier of the datastore in vCenter.",
+			MarkdownDescription: "Identifier of the datastore in vCenter.",
+			Computed:            true,
+		},
+		"vcenter_id": schema.StringAttribute{
+			Description:         "Unique identifier of the vCenter the datastore belongs to.",
+			MarkdownDescription: "Unique identifier of the vCenter the datastore belongs to.",
+			Computed:            true,
+		},
+		"storage_container_id": schema.StringAttribute{
+			Description:         "Unique identifier of the storage container backing a vVol datastore.",
+			MarkdownDescription: "Unique identifier of the storage container backing a vVol datastore.",
+			Computed:            true,
+		},
+		"nfs_export_id": schema.StringAttribute{
+			Description:         "Unique identifier of the NFS export backing an NFS datastore.",
+			MarkdownDescription: "Unique identifier of the NFS export backing an NFS datastore.",
+			Computed:            true,
+		},
+		"file_system_id": schema.StringAttribute{
+			Description:         "Unique identifier of the file system exported by the NFS export backing the datastore.",
+			MarkdownDescription: "Unique identifier of the file system exported by the NFS export backing the datastore.",
+			Computed:            true,
+		},
+		"volume_ids": schema.ListAttribute{
+			Description:         "Unique identifiers of the volumes backing the datastore. Can be used to check that a volume is not in use by vSphere before destroying it.",
+			MarkdownDescription: "Unique identifiers of the volumes backing the datastore. Can be used to check that a volume is not in use by vSphere before destroying it.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"vsphere_host_ids": schema.ListAttribute{
+			Description:         "Unique identifiers of the vSphere hosts the datastore is mounted on.",
+			MarkdownDescription: "Unique identifiers of the vSphere hosts the datastore is mounted on.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"virtual_machine_ids": schema.ListAttribute{
+			Description:         "Unique identifiers of the virtual machines residing on the datastore.",
+			MarkdownDescription: "Unique identifiers of the virtual machines residing on the datastore.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+	}
+}
@@ -0,0 +1,117 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Test to Fetch Datastores
+func TestAccDatastoreDs(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				// Get all Datastores
+				Config: ProviderConfigForTesting + DatastoreDataSourceParamsAll,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerstore_datastore.test", "datastores.0.id"),
+					resource.TestCheckResourceAttrSet("data.powerstore_datastore.test", "datastores.0.name"),
+				),
+			},
+			{
+				// Get Datastore by ID
+				Config: ProviderConfigForTesting + DatastoreDataSourceParamsAll + DatastoreDataSourceParamsID,
+				Check:  resource.TestCheckResourceAttr("data.powerstore_datastore.test1", "datastores.#", "1"),
+			},
+			{
+				// Get Datastores by name
+				Config: ProviderConfigForTesting + DatastoreDataSourceParamsAll + DatastoreDataSourceParamsName,
+				Check:  resource.TestCheckResourceAttrPair("data.powerstore_datastore.test1", "datastores.0.name", "data.powerstore_datastore.test", "datastores.0.name"),
+			},
+			{
+				// Get Datastores by filter expression
+				Config: ProviderConfigForTesting + DatastoreDataSourceParamsFilter,
+				Check:  resource.TestCheckResourceAttr("data.powerstore_datastore.test", "datastores.0.type", "vVol"),
+			},
+			{
+				Config:      ProviderConfigForTesting + DatastoreDataSourceParamsIDNegative,
+				ExpectError: regexp.MustCompile("Unable to Read PowerStore Datastores"),
+			},
+			{
+				Config:      ProviderConfigForTesting + DatastoreDataSourceParamsFilterNegative,
+				ExpectError: regexp.MustCompile("Unable to Read PowerStore Datastores"),
+			},
+			{
+				Config:      ProviderConfigForTesting + DatastoreDataSourceParamsIDAndNameNegative,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
+
+var DatastoreDataSourceParamsAll = `
+data "powerstore_datastore" "test" {
+}
+`
+
+var DatastoreDataSourceParamsID = `
+data "powerstore_datastore" "test1" {
+	id = data.powerstore_datastore.test.datastores[0].id
+}
+`
+
+var DatastoreDataSourceParamsName = `
+data "powerstore_datastore" "test1" {
+	name = data.powerstore_datastore.test.datastores[0].name
+}
+`
+
+var DatastoreDataSourceParamsFilter = `
+data "powerstore_datastore" "test" {
+	filter_expression = "type=eq.vVol"
+}
+`
+
+var DatastoreDataSourceParamsIDNegative = `
+data "powerstore_datastore" "test" {
+	id = "invalid-id"
+}
+`
+
+var DatastoreDataSourceParamsFilterNegative = `
+data "powerstore_datastore" "test" {
+	filter_expression = "name=inv.invalid"
+}
+`
+
+var DatastoreDataSourceParamsIDAndNameNegative = `
+data "powerstore_datastore" "test" {
+	id = "invalid-id"
+	name = "invalid"
+}
+`
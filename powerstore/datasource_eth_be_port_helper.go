@@ -0,0 +1,54 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ethBePortDatasourceSelect lists the back-end Ethernet port fields queried by the back-end Ethernet port datasource
+const ethBePortDatasourceSelect = "id,name,appliance_id,node_id,mac_address,is_link_up,speed,sfp_id,port_index,port_connector_type,hardware_parent_id,expected_peer_id,actual_peer_id,protocols,stale_state"
+
+// updateEthBePortState iterates over the back-end Ethernet port list and update the state
+func updateEthBePortState(in []clientgen.EthBePortInstance) []models.EthBePortDataSource {
+	return helper.SliceTransform(in, func(in clientgen.EthBePortInstance) models.EthBePortDataSource {
+		return models.EthBePortDataSource{
+			ID:                helper.TfString(in.Id),
+			Name:              helper.TfString(in.Name),
+			ApplianceID:       helper.TfString(in.ApplianceId),
+			NodeID:            helper.TfString(in.NodeId),
+			MACAddress:        helper.TfString(in.MacAddress),
+			IsLinkUp:          helper.TfBool(in.IsLinkUp),
+			Speed:             helper.TfString(in.Speed),
+			SFPID:             helper.TfString(in.SfpId),
+			PortIndex:         helper.TfInt64(in.PortIndex),
+			PortConnectorType: helper.TfString(in.PortConnectorType),
+			HardwareParentID:  helper.TfString(in.HardwareParentId),
+			ExpectedPeerID:    helper.TfString(in.ExpectedPeerId),
+			ActualPeerID:      helper.TfString(in.ActualPeerId),
+			Protocols: helper.SliceTransform(in.Protocols, func(in clientgen.EthBEPortProtocolEnum) types.String {
+				return types.StringValue(string(in))
+			}),
+			StaleState: helper.TfString(in.StaleState),
+		}
+	})
+}
@@ -0,0 +1,105 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// EthBePortDatasourceSchema is a function that returns the schema for back-end Ethernet port datasource
+func EthBePortDatasourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "Unique identifier of the back-end Ethernet port.",
+			MarkdownDescription: "Unique identifier of the back-end Ethernet port.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			Description:         "Name of the back-end Ethernet port.",
+			MarkdownDescription: "Name of the back-end Ethernet port.",
+			Computed:            true,
+		},
+		"appliance_id": schema.StringAttribute{
+			Description:         "Unique identifier of the appliance containing the port.",
+			MarkdownDescription: "Unique identifier of the appliance containing the port.",
+			Computed:            true,
+		},
+		"node_id": schema.StringAttribute{
+			Description:         "Unique identifier of the node containing the port.",
+			MarkdownDescription: "Unique identifier of the node containing the port.",
+			Computed:            true,
+		},
+		"mac_address": schema.StringAttribute{
+			Description:         "MAC address of the port.",
+			MarkdownDescription: "MAC address of the port.",
+			Computed:            true,
+		},
+		"is_link_up": schema.BoolAttribute{
+			Description:         "Indicates whether the port link is up.",
+			MarkdownDescription: "Indicates whether the port link is up.",
+			Computed:            true,
+		},
+		"speed": schema.StringAttribute{
+			Description:         "Speed of the port.",
+			MarkdownDescription: "Speed of the port.",
+			Computed:            true,
+		},
+		"sfp_id": schema.StringAttribute{
+			Description:         "Unique identifier of the SFP inserted into the port.",
+			MarkdownDescription: "Unique identifier of the SFP inserted into the port.",
+			Computed:            true,
+		},
+		"port_index": schema.Int64Attribute{
+			Description:         "Index of the port within its hardware parent.",
+			MarkdownDescription: "Index of the port within its hardware parent.",
+			Computed:            true,
+		},
+		"port_connector_type": schema.StringAttribute{
+			Description:         "Connector type of the port.",
+			MarkdownDescription: "Connector type of the port.",
+			Computed:            true,
+		},
+		"hardware_parent_id": schema.StringAttribute{
+			Description:         "Unique identifier of the hardware parent of the port.",
+			MarkdownDescription: "Unique identifier of the hardware parent of the port.",
+			Computed:            true,
+		},
+		"expected_peer_id": schema.StringAttribute{
+			Description:         "Unique identifier of the peer port this port is expected to be cabled to.",
+			MarkdownDescription: "Unique identifier of the peer port this port is expected to be cabled to.",
+			Computed:            true,
+		},
+		"actual_peer_id": schema.StringAttribute{
+			Description:         "Unique identifier of the peer port this port is actually cabled to.",
+			MarkdownDescription: "Unique identifier of the peer port this port is actually cabled to.",
+			Computed:            true,
+		},
+		"protocols": schema.ListAttribute{
+			Description:         "Protocols enabled on the port.",
+			MarkdownDescription: "Protocols enabled on the port.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"stale_state": schema.StringAttribute{
+			Description:         "Stale state of the port.",
+			MarkdownDescription: "Stale state of the port.",
+			Computed:            true,
+		},
+	}
+}
@@ -0,0 +1,117 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Test to Fetch Back-end Ethernet Ports
+func TestAccEthBePortDs(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				// Get all Back-end Ethernet Ports
+				Config: ProviderConfigForTesting + EthBePortDataSourceParamsAll,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerstore_eth_be_port.test", "eth_be_ports.0.id"),
+					resource.TestCheckResourceAttrSet("data.powerstore_eth_be_port.test", "eth_be_ports.0.name"),
+				),
+			},
+			{
+				// Get Back-end Ethernet Port by ID
+				Config: ProviderConfigForTesting + EthBePortDataSourceParamsAll + EthBePortDataSourceParamsID,
+				Check:  resource.TestCheckResourceAttr("data.powerstore_eth_be_port.test1", "eth_be_ports.#", "1"),
+			},
+			{
+				// Get Back-end Ethernet Ports by name
+				Config: ProviderConfigForTesting + EthBePortDataSourceParamsAll + EthBePortDataSourceParamsName,
+				Check:  resource.TestCheckResourceAttrPair("data.powerstore_eth_be_port.test1", "eth_be_ports.0.name", "data.powerstore_eth_be_port.test", "eth_be_ports.0.name"),
+			},
+			{
+				// Get Back-end Ethernet Ports by filter expression
+				Config: ProviderConfigForTesting + EthBePortDataSourceParamsFilter,
+				Check:  resource.TestCheckResourceAttr("data.powerstore_eth_be_port.test", "eth_be_ports.0.is_link_up", "true"),
+			},
+			{
+				Config:      ProviderConfigForTesting + EthBePortDataSourceParamsIDNegative,
+				ExpectError: regexp.MustCompile("Unable to Read PowerStore Back-end Ethernet Ports"),
+			},
+			{
+				Config:      ProviderConfigForTesting + EthBePortDataSourceParamsFilterNegative,
+				ExpectError: regexp.MustCompile("Unable to Read PowerStore Back-end Ethernet Ports"),
+			},
+			{
+				Config:      ProviderConfigForTesting + EthBePortDataSourceParamsIDAndNameNegative,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
+
+var EthBePortDataSourceParamsAll = `
+data "powerstore_eth_be_port" "test" {
+}
+`
+
+var EthBePortDataSourceParamsID = `
+data "powerstore_eth_be_port" "test1" {
+	id = data.powerstore_eth_be_port.test.eth_be_ports[0].id
+}
+`
+
+var EthBePortDataSourceParamsName = `
+data "powerstore_eth_be_port" "test1" {
+	name = data.powerstore_eth_be_port.test.eth_be_ports[0].name
+}
+`
+
+var EthBePortDataSourceParamsFilter = `
+data "powerstore_eth_be_port" "test" {
+	filter_expression = "is_link_up=eq.true"
+}
+`
+
+var EthBePortDataSourceParamsIDNegative = `
+data "powerstore_eth_be_port" "test" {
+	id = "invalid-id"
+}
+`
+
+var EthBePortDataSourceParamsFilterNegative = `
+data "powerstore_eth_be_port" "test" {
+	filter_expression = "name=inv.invalid"
+}
+`
+
+var EthBePortDataSourceParamsIDAndNameNegative = `
+data "powerstore_eth_be_port" "test" {
+	id = "invalid-id"
+	name = "invalid"
+}
+`
@@ -0,0 +1,60 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ethPortDatasourceSelect lists the Ethernet port fields queried by the Ethernet port datasource
+const ethPortDatasourceSelect = "id,name,appliance_id,node_id,bond_id,fsn_id,mac_address,permanent_mac_address,is_link_up,supported_speeds,current_speed,requested_speed,current_mtu,sfp_id,io_module_id,hardware_parent_id,port_index,port_connector_type,partner_id,stale_state,is_hypervisor_managed"
+
+// updateEthPortState iterates over the Ethernet port list and update the state
+func updateEthPortState(in []clientgen.EthPortInstance) []models.EthPortDataSource {
+	return helper.SliceTransform(in, func(in clientgen.EthPortInstance) models.EthPortDataSource {
+		return models.EthPortDataSource{
+			ID:                  helper.TfString(in.Id),
+			Name:                helper.TfString(in.Name),
+			ApplianceID:         helper.TfString(in.ApplianceId),
+			NodeID:              helper.TfString(in.NodeId),
+			BondID:              helper.TfString(in.BondId),
+			FsnID:               helper.TfString(in.FsnId),
+			MACAddress:          helper.TfString(in.MacAddress),
+			PermanentMACAddress: helper.TfString(in.PermanentMacAddress),
+			IsLinkUp:            helper.TfBool(in.IsLinkUp),
+			IsHypervisorManaged: helper.TfBool(in.IsHypervisorManaged),
+			SupportedSpeeds: helper.SliceTransform(in.SupportedSpeeds, func(in clientgen.EthPortSpeedEnum) types.String {
+				return types.StringValue(string(in))
+			}),
+			CurrentSpeed:      helper.TfString(in.CurrentSpeed),
+			RequestedSpeed:    helper.TfString(in.RequestedSpeed),
+			CurrentMTU:        helper.TfInt64(in.CurrentMtu),
+			SFPID:             helper.TfString(in.SfpId),
+			IoModuleID:        helper.TfString(in.IoModuleId),
+			HardwareParentID:  helper.TfString(in.HardwareParentId),
+			PortIndex:         helper.TfInt64(in.PortIndex),
+			PortConnectorType: helper.TfString(in.PortConnectorType),
+			PartnerID:         helper.TfString(in.PartnerId),
+			StaleState:        helper.TfString(in.StaleState),
+		}
+	})
+}
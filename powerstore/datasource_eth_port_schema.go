@@ -0,0 +1,135 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// EthPortDatasourceSchema is a function that returns the schema for Ethernet port datasource
+func EthPortDatasourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "Unique identifier of the Ethernet port.",
+			MarkdownDescription: "Unique identifier of the Ethernet port.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			Description:         "Name of the Ethernet port.",
+			MarkdownDescription: "Name of the Ethernet port.",
+			Computed:            true,
+		},
+		"appliance_id": schema.StringAttribute{
+			Description:         "Unique identifier of the appliance containing the port.",
+			MarkdownDescription: "Unique identifier of the appliance containing the port.",
+			Computed:            true,
+		},
+		"node_id": schema.StringAttribute{
+			Description:         "Unique identifier of the node containing the port.",
+			MarkdownDescription: "Unique identifier of the node containing the port.",
+			Computed:            true,
+		},
+		"bond_id": schema.StringAttribute{
+			Description:         "Unique identifier of the bond the port belongs to, if any.",
+			MarkdownDescription: "Unique identifier of the bond the port belongs to, if any.",
+			Computed:            true,
+		},
+		"fsn_id": schema.StringAttribute{
+			Description:         "Unique identifier of the fail-safe network the port belongs to, if any.",
+			MarkdownDescription: "Unique identifier of the fail-safe network the port belongs to, if any.",
+			Computed:            true,
+		},
+		"mac_address": schema.StringAttribute{
+			Description:         "Current MAC address of the port.",
+			MarkdownDescription: "Current MAC address of the port.",
+			Computed:            true,
+		},
+		"permanent_mac_address": schema.StringAttribute{
+			Description:         "Permanent MAC address of the port.",
+			MarkdownDescription: "Permanent MAC address of the port.",
+			Computed:            true,
+		},
+		"is_link_up": schema.BoolAttribute{
+			Description:         "Indicates whether the port link is up.",
+			MarkdownDescription: "Indicates whether the port link is up.",
+			Computed:            true,
+		},
+		"is_hypervisor_managed": schema.BoolAttribute{
+			Description:         "Indicates whether the port is managed by the hypervisor.",
+			MarkdownDescription: "Indicates whether the port is managed by the hypervisor.",
+			Computed:            true,
+		},
+		"supported_speeds": schema.ListAttribute{
+			Description:         "Speeds supported by the port.",
+			MarkdownDescription: "Speeds supported by the port.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"current_speed": schema.StringAttribute{
+			Description:         "Current speed of the port.",
+			MarkdownDescription: "Current speed of the port.",
+			Computed:            true,
+		},
+		"requested_speed": schema.StringAttribute{
+			Description:         "Speed requested for the port.",
+			MarkdownDescription: "Speed requested for the port.",
+			Computed:            true,
+		},
+		"current_mtu": schema.Int64Attribute{
+			Description:         "Current MTU of the port.",
+			MarkdownDescription: "Current MTU of the port.",
+			Computed:            true,
+		},
+		"sfp_id": schema.StringAttribute{
+			Description:         "Unique identifier of the SFP inserted into the port.",
+			MarkdownDescription: "Unique identifier of the SFP inserted into the port.",
+			Computed:            true,
+		},
+		"io_module_id": schema.StringAttribute{
+			Description:         "Unique identifier of the I/O module containing the port.",
+			MarkdownDescription: "Unique identifier of the I/O module containing the port.",
+			Computed:            true,
+		},
+		"hardware_parent_id": schema.StringAttribute{
+			Description:         "Unique identifier of the hardware parent of the port.",
+			MarkdownDescription: "Unique identifier of the hardware parent of the port.",
+			Computed:            true,
+		},
+		"port_index": schema.Int64Attribute{
+			Description:         "Index of the port within its hardware parent.",
+			MarkdownDescription: "Index of the port within its hardware parent.",
+			Computed:            true,
+		},
+		"port_connector_type": schema.StringAttribute{
+			Description:         "Connector type of the port.",
+			MarkdownDescription: "Connector type of the port.",
+			Computed:            true,
+		},
+		"partner_id": schema.StringAttribute{
+			Description:         "Unique identifier of the partner port on the peer node.",
+			MarkdownDescription: "Unique identifier of the partner port on the peer node.",
+			Computed:            true,
+		},
+		"stale_state": schema.StringAttribute{
+			Description:         "Stale state of the port.",
+			MarkdownDescription: "Stale state of the port.",
+			Computed:            true,
+		},
+	}
+}
@@ -0,0 +1,118 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Test to Fetch Ethernet Ports
+func TestAccEthPortDs(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				// Get all Ethernet Ports
+				Config: ProviderConfigForTesting + EthPortDataSourceParamsAll,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerstore_eth_port.test", "eth_ports.0.id"),
+					resource.TestCheckResourceAttrSet("data.powerstore_eth_port.test", "eth_ports.0.name"),
+					resource.TestCheckResourceAttrSet("data.powerstore_eth_port.test", "eth_ports.0.appliance_id"),
+				),
+			},
+			{
+				// Get Ethernet Port by ID
+				Config: ProviderConfigForTesting + EthPortDataSourceParamsAll + EthPortDataSourceParamsID,
+				Check:  resource.TestCheckResourceAttr("data.powerstore_eth_port.test1", "eth_ports.#", "1"),
+			},
+			{
+				// Get Ethernet Ports by name
+				Config: ProviderConfigForTesting + EthPortDataSourceParamsAll + EthPortDataSourceParamsName,
+				Check:  resource.TestCheckResourceAttrPair("data.powerstore_eth_port.test1", "eth_ports.0.name", "data.powerstore_eth_port.test", "eth_ports.0.name"),
+			},
+			{
+				// Get Ethernet Ports by filter expression
+				Config: ProviderConfigForTesting + EthPortDataSourceParamsFilter,
+				Check:  resource.TestCheckResourceAttr("data.powerstore_eth_port.test", "eth_ports.0.is_link_up", "true"),
+			},
+			{
+				Config:      ProviderConfigForTesting + EthPortDataSourceParamsIDNegative,
+				ExpectError: regexp.MustCompile("Unable to Read PowerStore Ethernet Ports"),
+			},
+			{
+				Config:      ProviderConfigForTesting + EthPortDataSourceParamsFilterNegative,
+				ExpectError: regexp.MustCompile("Unable to Read PowerStore Ethernet Ports"),
+			},
+			{
+				Config:      ProviderConfigForTesting + EthPortDataSourceParamsIDAndNameNegative,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
+
+var EthPortDataSourceParamsAll = `
+data "powerstore_eth_port" "test" {
+}
+`
+
+var EthPortDataSourceParamsID = `
+data "powerstore_eth_port" "test1" {
+	id = data.powerstore_eth_port.test.eth_ports[0].id
+}
+`
+
+var EthPortDataSourceParamsName = `
+data "powerstore_eth_port" "test1" {
+	name = data.powerstore_eth_port.test.eth_ports[0].name
+}
+`
+
+var EthPortDataSourceParamsFilter = `
+data "powerstore_eth_port" "test" {
+	filter_expression = "is_link_up=eq.true"
+}
+`
+
+var EthPortDataSourceParamsIDNegative = `
+data "powerstore_eth_port" "test" {
+	id = "invalid-id"
+}
+`
+
+var EthPortDataSourceParamsFilterNegative = `
+data "powerstore_eth_port" "test" {
+	filter_expression = "name=inv.invalid"
+}
+`
+
+var EthPortDataSourceParamsIDAndNameNegative = `
+data "powerstore_eth_port" "test" {
+	id = "invalid-id"
+	name = "invalid"
+}
+`
@@ -0,0 +1,144 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"context"
+	"net/url"
+	"terraform-provider-powerstore/client"
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &eventDataSource{}
+	_ datasource.DataSourceWithConfigure = &eventDataSource{}
+)
+
+// newEventDataSource returns the event data source object
+func newEventDataSource() datasource.DataSource {
+	return &eventDataSource{}
+}
+
+// eventDataSource is the data source implementation
+type eventDataSource struct {
+	client *clientgen.APIClient
+}
+
+// Metadata returns the data source type name
+func (d *eventDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_event"
+}
+
+// Schema defines the schema for the data source
+func (d *eventDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "This datasource is used to query the existing events from PowerStore array. The information fetched from this datasource can be used for getting the details for further processing in resource block.",
+		MarkdownDescription: "This datasource is used to query the existing events from PowerStore array. The information fetched from this datasource can be used for getting the details for further processing in resource block.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Unique identifier of the event. Conflicts with `resource_name` and `filter_expression`.",
+				MarkdownDescription: "Unique identifier of the event. Conflicts with `resource_name` and `filter_expression`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("resource_name")),
+					stringvalidator.ConflictsWith(path.MatchRoot("filter_expression")),
+				},
+			},
+			"resource_name": schema.StringAttribute{
+				Description:         "Name of the resource instance which generated the event. Conflicts with `id` and `filter_expression`.",
+				MarkdownDescription: "Name of the resource instance which generated the event. Conflicts with `id` and `filter_expression`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("filter_expression")),
+				},
+			},
+			"filter_expression": schema.StringAttribute{
+				Description:         "PowerStore filter expression to filter events by. Conflicts with `id` and `resource_name`.",
+				MarkdownDescription: "PowerStore filter expression to filter events by. Conflicts with `id` and `resource_name`.",
+				Optional:            true,
+				CustomType:          models.FilterExpressionType{},
+			},
+			"events": schema.ListNestedAttribute{
+				Description:         "List of events.",
+				MarkdownDescription: "List of events.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: EventDatasourceSchema(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source
+func (d *eventDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c := req.ProviderData.(*client.Client)
+	d.client = c.GenClient
+}
+
+// Read updates the Terraform state with the latest event data
+func (d *eventDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state models.EventDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	queries := make(url.Values)
+	queries.Set("select", eventDatasourceSelect)
+	// Read the events based on id/resource name/filter and if nothing is mentioned, then it returns all the events
+	dsreq := helper.DsReq[clientgen.EventInstance, clientgen.ApiGetEventByIdRequest, clientgen.ApiGetAllEventsRequest]{
+		Instance:   d.client.EventApi.GetEventById,
+		Collection: d.client.EventApi.GetAllEvents,
+	}
+	if !state.ResourceName.IsNull() {
+		queries.Set("resource_name", "eq."+state.ResourceName.ValueString())
+	} else if !state.Filters.IsNull() {
+		queries = helper.MergeValues(queries, state.Filters.ValueQueries())
+	}
+	items, err := dsreq.Execute(ctx, queries, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read PowerStore Events",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Events = updateEventState(items)
+	state.ID = types.StringValue("placeholder")
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,45 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+)
+
+// eventDatasourceSelect lists the event fields queried by the event datasource
+const eventDatasourceSelect = "id,event_code,severity,resource_type,resource_id,resource_name,generated_timestamp,description_l10n,system_impact_l10n,repair_flow_l10n"
+
+// updateEventState iterates over the event list and update the state
+func updateEventState(in []clientgen.EventInstance) []models.EventDataSource {
+	return helper.SliceTransform(in, func(in clientgen.EventInstance) models.EventDataSource {
+		return models.EventDataSource{
+			ID:                 helper.TfString(in.Id),
+			EventCode:          helper.TfString(in.EventCode),
+			Severity:           helper.TfString(in.Severity),
+			ResourceType:       helper.TfString(in.ResourceType),
+			ResourceID:         helper.TfString(in.ResourceId),
+			ResourceName:       helper.TfString(in.ResourceName),
+			GeneratedTimestamp: helper.TfStringFromPTime(in.GeneratedTimestamp),
+			Description:        helper.TfString(in.DescriptionL10n),
+			SystemImpact:       helper.TfString(in.SystemImpactL10n),
+			RepairFlow:         helper.TfString(in.RepairFlowL10n),
+		}
+	})
+}
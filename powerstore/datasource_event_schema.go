@@ -0,0 +1,78 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+// EventDatasourceSchema is a function that returns the schema for event datasource
+func EventDatasourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "Unique identifier of the occurrence of the event.",
+			MarkdownDescription: "Unique identifier of the occurrence of the event.",
+			Computed:            true,
+		},
+		"event_code": schema.StringAttribute{
+			Description:         "Code identifying the kind of the event.",
+			MarkdownDescription: "Code identifying the kind of the event.",
+			Computed:            true,
+		},
+		"severity": schema.StringAttribute{
+			Description:         "Severity of the event.",
+			MarkdownDescription: "Severity of the event.",
+			Computed:            true,
+		},
+		"resource_type": schema.StringAttribute{
+			Description:         "Type of the resource instance which generated the event.",
+			MarkdownDescription: "Type of the resource instance which generated the event.",
+			Computed:            true,
+		},
+		"resource_id": schema.StringAttribute{
+			Description:         "Unique identifier of the resource instance which generated the event.",
+			MarkdownDescription: "Unique identifier of the resource instance which generated the event.",
+			Computed:            true,
+		},
+		"resource_name": schema.StringAttribute{
+			Description:         "Name of the resource instance which generated the event.",
+			MarkdownDescription: "Name of the resource instance which generated the event.",
+			Computed:            true,
+		},
+		"generated_timestamp": schema.StringAttribute{
+			Description:         "Timestamp at which the event occurred.",
+			MarkdownDescription: "Timestamp at which the event occurred.",
+			Computed:            true,
+		},
+		"description": schema.StringAttribute{
+			Description:         "Description of the event.",
+			MarkdownDescription: "Description of the event.",
+			Computed:            true,
+		},
+		"system_impact": schema.StringAttribute{
+			Description:         "Possible effect of the event on the system.",
+			MarkdownDescription: "Possible effect of the event on the system.",
+			Computed:            true,
+		},
+		"repair_flow": schema.StringAttribute{
+			Description:         "Suggestions to resolve the problems raised by the event.",
+			MarkdownDescription: "Suggestions to resolve the problems raised by the event.",
+			Computed:            true,
+		},
+	}
+}
@@ -0,0 +1,117 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Test to Fetch Events
+func TestAccEventDs(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				// Get all Events
+				Config: ProviderConfigForTesting + EventDataSourceParamsAll,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerstore_event.test", "events.0.id"),
+					resource.TestCheckResourceAttrSet("data.powerstore_event.test", "events.0.severity"),
+				),
+			},
+			{
+				// Get Event by ID
+				Config: ProviderConfigForTesting + EventDataSourceParamsAll + EventDataSourceParamsID,
+				Check:  resource.TestCheckResourceAttr("data.powerstore_event.test1", "events.#", "1"),
+			},
+			{
+				// Get Events by resource name
+				Config: ProviderConfigForTesting + EventDataSourceParamsAll + EventDataSourceParamsResourceName,
+				Check:  resource.TestCheckResourceAttrPair("data.powerstore_event.test1", "events.0.resource_name", "data.powerstore_event.test", "events.0.resource_name"),
+			},
+			{
+				// Get Events by filter expression
+				Config: ProviderConfigForTesting + EventDataSourceParamsFilter,
+				Check:  resource.TestCheckResourceAttr("data.powerstore_event.test", "events.0.severity", "Info"),
+			},
+			{
+				Config:      ProviderConfigForTesting + EventDataSourceParamsIDNegative,
+				ExpectError: regexp.MustCompile("Unable to Read PowerStore Events"),
+			},
+			{
+				Config:      ProviderConfigForTesting + EventDataSourceParamsFilterNegative,
+				ExpectError: regexp.MustCompile("Unable to Read PowerStore Events"),
+			},
+			{
+				Config:      ProviderConfigForTesting + EventDataSourceParamsIDAndResourceNameNegative,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
+
+var EventDataSourceParamsAll = `
+data "powerstore_event" "test" {
+}
+`
+
+var EventDataSourceParamsID = `
+data "powerstore_event" "test1" {
+	id = data.powerstore_event.test.events[0].id
+}
+`
+
+var EventDataSourceParamsResourceName = `
+data "powerstore_event" "test1" {
+	resource_name = data.powerstore_event.test.events[0].resource_name
+}
+`
+
+var EventDataSourceParamsFilter = `
+data "powerstore_event" "test" {
+	filter_expression = "severity=eq.Info"
+}
+`
+
+var EventDataSourceParamsIDNegative = `
+data "powerstore_event" "test" {
+	id = "invalid-id"
+}
+`
+
+var EventDataSourceParamsFilterNegative = `
+data "powerstore_event" "test" {
+	filter_expression = "name=inv.invalid"
+}
+`
+
+var EventDataSourceParamsIDAndResourceNameNegative = `
+data "powerstore_event" "test" {
+	id = "invalid-id"
+	resource_name = "invalid"
+}
+`
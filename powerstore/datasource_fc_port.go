@@ -0,0 +1,153 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"context"
+	"net/url"
+	"terraform-provider-powerstore/client"
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &fcPortDataSource{}
+	_ datasource.DataSourceWithConfigure = &fcPortDataSource{}
+)
+
+// newFcPortDataSource returns the FC port data source object
+func newFcPortDataSource() datasource.DataSource {
+	return &fcPortDataSource{}
+}
+
+// fcPortDataSource is the data source implementation
+type fcPortDataSource struct {
+	client *clientgen.APIClient
+}
+
+// Metadata returns the data source type name
+func (d *fcPortDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_fc_port"
+}
+
+// Schema defines the schema for the data source
+func (d *fcPortDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "This datasource is used to query the existing FC ports from PowerStore array. The information fetched from this datasource can be used for getting the details for further processing in resource block.",
+		MarkdownDescription: "This datasource is used to query the existing FC ports from PowerStore array. The information fetched from this datasource can be used for getting the details for further processing in resource block.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Unique identifier of the FC port. Conflicts with `name` and `filter_expression`.",
+				MarkdownDescription: "Unique identifier of the FC port. Conflicts with `name` and `filter_expression`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("name")),
+					stringvalidator.ConflictsWith(path.MatchRoot("filter_expression")),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description:         "Name of the FC port. Conflicts with `id` and `filter_expression`.",
+				MarkdownDescription: "Name of the FC port. Conflicts with `id` and `filter_expression`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("filter_expression")),
+				},
+			},
+			"filter_expression": schema.StringAttribute{
+				Description:         "PowerStore filter expression to filter FC ports by. Conflicts with `id` and `name`.",
+				MarkdownDescription: "PowerStore filter expression to filter FC ports by. Conflicts with `id` and `name`.",
+				Optional:            true,
+				CustomType:          models.FilterExpressionType{},
+			},
+			"fc_ports": schema.ListNestedAttribute{
+				Description:         "List of FC ports.",
+				MarkdownDescription: "List of FC ports.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: FcPortDatasourceSchema(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source
+func (d *fcPortDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c := req.ProviderData.(*client.Client)
+	d.client = c.GenClient
+}
+
+// Read updates the Terraform state with the latest FC port data
+func (d *fcPortDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state models.FcPortDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	queries := make(url.Values)
+	queries.Set("select", fcPortDatasourceSelect)
+	// Read the FC ports based on id/name/filter and if nothing is mentioned, then it returns all the FC ports
+	dsreq := helper.DsReq[clientgen.FcPortInstance, clientgen.ApiGetFcPortByIdRequest, clientgen.ApiGetAllFcPortsRequest]{
+		Instance:   d.client.FcPortApi.GetFcPortById,
+		Collection: d.client.FcPortApi.GetAllFcPorts,
+	}
+	if !state.Name.IsNull() {
+		queries.Set("name", "eq."+state.Name.ValueString())
+	} else if !state.Filters.IsNull() {
+		queries = helper.MergeValues(queries, state.Filters.ValueQueries())
+	}
+	items, err := dsreq.Execute(ctx, queries, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read PowerStore FC Ports",
+			err.Error(),
+		)
+		return
+	}
+
+	// check that there is atleast one FC port if name is provided
+	if state.Name.ValueString() != "" && len(items) == 0 {
+		resp.Diagnostics.AddError(
+			"Unable to Read PowerStore FC Ports",
+			"There is no FC port with name "+state.Name.ValueString(),
+		)
+		return
+	}
+
+	state.FCPorts = updateFcPortState(items)
+	state.ID = types.StringValue("placeholder")
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
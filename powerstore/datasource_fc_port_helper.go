@@ -0,0 +1,62 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// fcPortDatasourceSelect lists the FC port fields queried by the FC port datasource
+const fcPortDatasourceSelect = "id,name,appliance_id,node_id,wwn,wwn_nvme,wwn_node,is_link_up,is_in_use,supported_speeds,current_speed,requested_speed,sfp_id,io_module_id,hardware_parent_id,port_index,port_connector_type,partner_id,protocols,stale_state,scsi_mode"
+
+// updateFcPortState iterates over the FC port list and update the state
+func updateFcPortState(in []clientgen.FcPortInstance) []models.FcPortDataSource {
+	return helper.SliceTransform(in, func(in clientgen.FcPortInstance) models.FcPortDataSource {
+		return models.FcPortDataSource{
+			ID:          helper.TfString(in.Id),
+			Name:        helper.TfString(in.Name),
+			ApplianceID: helper.TfString(in.ApplianceId),
+			NodeID:      helper.TfString(in.NodeId),
+			WWN:         helper.TfString(in.Wwn),
+			WWNNvme:     helper.TfString(in.WwnNvme),
+			WWNNode:     helper.TfString(in.WwnNode),
+			IsLinkUp:    helper.TfBool(in.IsLinkUp),
+			IsInUse:     helper.TfBool(in.IsInUse),
+			SupportedSpeeds: helper.SliceTransform(in.SupportedSpeeds, func(in clientgen.FcPortSpeedEnum) types.String {
+				return types.StringValue(string(in))
+			}),
+			CurrentSpeed:      helper.TfString(in.CurrentSpeed),
+			RequestedSpeed:    helper.TfString(in.RequestedSpeed),
+			SFPID:             helper.TfString(in.SfpId),
+			IoModuleID:        helper.TfString(in.IoModuleId),
+			HardwareParentID:  helper.TfString(in.HardwareParentId),
+			PortIndex:         helper.TfInt64(in.PortIndex),
+			PortConnectorType: helper.TfString(in.PortConnectorType),
+			PartnerID:         helper.TfString(in.PartnerId),
+			Protocols: helper.SliceTransform(in.Protocols, func(in clientgen.FcPortProtocolEnum) types.String {
+				return types.StringValue(string(in))
+			}),
+			ScsiMode:   helper.TfString(in.ScsiMode),
+			StaleState: helper.TfString(in.StaleState),
+		}
+	})
+}
@@ -0,0 +1,136 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// FcPortDatasourceSchema is a function that returns the schema for FC port datasource
+func FcPortDatasourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "Unique identifier of the FC port.",
+			MarkdownDescription: "Unique identifier of the FC port.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			Description:         "Name of the FC port.",
+			MarkdownDescription: "Name of the FC port.",
+			Computed:            true,
+		},
+		"appliance_id": schema.StringAttribute{
+			Description:         "Unique identifier of the appliance containing the port.",
+			MarkdownDescription: "Unique identifier of the appliance containing the port.",
+			Computed:            true,
+		},
+		"node_id": schema.StringAttribute{
+			Description:         "Unique identifier of the node containing the port.",
+			MarkdownDescription: "Unique identifier of the node containing the port.",
+			Computed:            true,
+		},
+		"wwn": schema.StringAttribute{
+			Description:         "World Wide Name (WWN) of the port.",
+			MarkdownDescription: "World Wide Name (WWN) of the port.",
+			Computed:            true,
+		},
+		"wwn_nvme": schema.StringAttribute{
+			Description:         "NVMe World Wide Name of the port.",
+			MarkdownDescription: "NVMe World Wide Name of the port.",
+			Computed:            true,
+		},
+		"wwn_node": schema.StringAttribute{
+			Description:         "World Wide Name of the node containing the port.",
+			MarkdownDescription: "World Wide Name of the node containing the port.",
+			Computed:            true,
+		},
+		"is_link_up": schema.BoolAttribute{
+			Description:         "Indicates whether the port link is up.",
+			MarkdownDescription: "Indicates whether the port link is up.",
+			Computed:            true,
+		},
+		"is_in_use": schema.BoolAttribute{
+			Description:         "Indicates whether the port is in use.",
+			MarkdownDescription: "Indicates whether the port is in use.",
+			Computed:            true,
+		},
+		"supported_speeds": schema.ListAttribute{
+			Description:         "Speeds supported by the port.",
+			MarkdownDescription: "Speeds supported by the port.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"current_speed": schema.StringAttribute{
+			Description:         "Current speed of the port.",
+			MarkdownDescription: "Current speed of the port.",
+			Computed:            true,
+		},
+		"requested_speed": schema.StringAttribute{
+			Description:         "Speed requested for the port.",
+			MarkdownDescription: "Speed requested for the port.",
+			Computed:            true,
+		},
+		"sfp_id": schema.StringAttribute{
+			Description:         "Unique identifier of the SFP inserted into the port.",
+			MarkdownDescription: "Unique identifier of the SFP inserted into the port.",
+			Computed:            true,
+		},
+		"io_module_id": schema.StringAttribute{
+			Description:         "Unique identifier of the I/O module containing the port.",
+			MarkdownDescription: "Unique identifier of the I/O module containing the port.",
+			Computed:            true,
+		},
+		"hardware_parent_id": schema.StringAttribute{
+			Description:         "Unique identifier of the hardware parent of the port.",
+			MarkdownDescription: "Unique identifier of the hardware parent of the port.",
+			Computed:            true,
+		},
+		"port_index": schema.Int64Attribute{
+			Description:         "Index of the port within its hardware parent.",
+			MarkdownDescription: "Index of the port within its hardware parent.",
+			Computed:            true,
+		},
+		"port_connector_type": schema.StringAttribute{
+			Description:         "Connector type of the port.",
+			MarkdownDescription: "Connector type of the port.",
+			Computed:            true,
+		},
+		"partner_id": schema.StringAttribute{
+			Description:         "Unique identifier of the partner port on the peer node.",
+			MarkdownDescription: "Unique identifier of the partner port on the peer node.",
+			Computed:            true,
+		},
+		"protocols": schema.ListAttribute{
+			Description:         "Protocols enabled on the port.",
+			MarkdownDescription: "Protocols enabled on the port.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"scsi_mode": schema.StringAttribute{
+			Description:         "SCSI mode of the port.",
+			MarkdownDescription: "SCSI mode of the port.",
+			Computed:            true,
+		},
+		"stale_state": schema.StringAttribute{
+			Description:         "Stale state of the port.",
+			MarkdownDescription: "Stale state of the port.",
+			Computed:            true,
+		},
+	}
+}
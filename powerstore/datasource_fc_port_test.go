@@ -0,0 +1,118 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Test to Fetch FC Ports
+func TestAccFcPortDs(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				// Get all FC Ports
+				Config: ProviderConfigForTesting + FcPortDataSourceParamsAll,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerstore_fc_port.test", "fc_ports.0.id"),
+					resource.TestCheckResourceAttrSet("data.powerstore_fc_port.test", "fc_ports.0.name"),
+					resource.TestCheckResourceAttrSet("data.powerstore_fc_port.test", "fc_ports.0.wwn"),
+				),
+			},
+			{
+				// Get FC Port by ID
+				Config: ProviderConfigForTesting + FcPortDataSourceParamsAll + FcPortDataSourceParamsID,
+				Check:  resource.TestCheckResourceAttr("data.powerstore_fc_port.test1", "fc_ports.#", "1"),
+			},
+			{
+				// Get FC Ports by name
+				Config: ProviderConfigForTesting + FcPortDataSourceParamsAll + FcPortDataSourceParamsName,
+				Check:  resource.TestCheckResourceAttrPair("data.powerstore_fc_port.test1", "fc_ports.0.name", "data.powerstore_fc_port.test", "fc_ports.0.name"),
+			},
+			{
+				// Get FC Ports by filter expression
+				Config: ProviderConfigForTesting + FcPortDataSourceParamsFilter,
+				Check:  resource.TestCheckResourceAttr("data.powerstore_fc_port.test", "fc_ports.0.is_link_up", "true"),
+			},
+			{
+				Config:      ProviderConfigForTesting + FcPortDataSourceParamsIDNegative,
+				ExpectError: regexp.MustCompile("Unable to Read PowerStore FC Ports"),
+			},
+			{
+				Config:      ProviderConfigForTesting + FcPortDataSourceParamsFilterNegative,
+				ExpectError: regexp.MustCompile("Unable to Read PowerStore FC Ports"),
+			},
+			{
+				Config:      ProviderConfigForTesting + FcPortDataSourceParamsIDAndNameNegative,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
+
+var FcPortDataSourceParamsAll = `
+data "powerstore_fc_port" "test" {
+}
+`
+
+var FcPortDataSourceParamsID = `
+data "powerstore_fc_port" "test1" {
+	id = data.powerstore_fc_port.test.fc_ports[0].id
+}
+`
+
+var FcPortDataSourceParamsName = `
+data "powerstore_fc_port" "test1" {
+	name = data.powerstore_fc_port.test.fc_ports[0].name
+}
+`
+
+var FcPortDataSourceParamsFilter = `
+data "powerstore_fc_port" "test" {
+	filter_expression = "is_link_up=eq.true"
+}
+`
+
+var FcPortDataSourceParamsIDNegative = `
+data "powerstore_fc_port" "test" {
+	id = "invalid-id"
+}
+`
+
+var FcPortDataSourceParamsFilterNegative = `
+data "powerstore_fc_port" "test" {
+	filter_expression = "name=inv.invalid"
+}
+`
+
+var FcPortDataSourceParamsIDAndNameNegative = `
+data "powerstore_fc_port" "test" {
+	id = "invalid-id"
+	name = "invalid"
+}
+`
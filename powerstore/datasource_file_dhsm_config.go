@@ -0,0 +1,153 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"context"
+	"net/url"
+	"terraform-provider-powerstore/client"
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &fileDhsmConfigDataSource{}
+	_ datasource.DataSourceWithConfigure = &fileDhsmConfigDataSource{}
+)
+
+// newFileDhsmConfigDataSource returns the file dhsm config data source object
+func newFileDhsmConfigDataSource() datasource.DataSource {
+	return &fileDhsmConfigDataSource{}
+}
+
+// fileDhsmConfigDataSource is the data source implementation
+type fileDhsmConfigDataSource struct {
+	client *clientgen.APIClient
+}
+
+// Metadata returns the data source type name
+func (d *fileDhsmConfigDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_file_dhsm_config"
+}
+
+// Schema defines the schema for the data source
+func (d *fileDhsmConfigDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "This datasource is used to query the existing DHSM services of the NAS servers from PowerStore array. The information fetched from this datasource can be used for getting the details for further processing in resource block.",
+		MarkdownDescription: "This datasource is used to query the existing DHSM services of the NAS servers from PowerStore array. The information fetched from this datasource can be used for getting the details for further processing in resource block.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Unique identifier of the DHSM service. Conflicts with `nas_server_id` and `filter_expression`.",
+				MarkdownDescription: "Unique identifier of the DHSM service. Conflicts with `nas_server_id` and `filter_expression`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("nas_server_id")),
+					stringvalidator.ConflictsWith(path.MatchRoot("filter_expression")),
+				},
+			},
+			"nas_server_id": schema.StringAttribute{
+				Description:         "Unique identifier of the NAS server the DHSM service is configured on. Conflicts with `id` and `filter_expression`.",
+				MarkdownDescription: "Unique identifier of the NAS server the DHSM service is configured on. Conflicts with `id` and `filter_expression`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("filter_expression")),
+				},
+			},
+			"filter_expression": schema.StringAttribute{
+				Description:         "PowerStore filter expression to filter DHSM services by. Conflicts with `id` and `nas_server_id`.",
+				MarkdownDescription: "PowerStore filter expression to filter DHSM services by. Conflicts with `id` and `nas_server_id`.",
+				Optional:            true,
+				CustomType:          models.FilterExpressionType{},
+			},
+			"file_dhsm_configs": schema.ListNestedAttribute{
+				Description:         "List of DHSM services.",
+				MarkdownDescription: "List of DHSM services.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: FileDhsmConfigDatasourceSchema(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source
+func (d *fileDhsmConfigDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c := req.ProviderData.(*client.Client)
+	d.client = c.GenClient
+}
+
+// Read updates the Terraform state with the latest DHSM service data
+func (d *fileDhsmConfigDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state models.FileDhsmConfigDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	queries := make(url.Values)
+	queries.Set("select", fileDhsmConfigDatasourceSelect)
+	// Read the DHSM services based on id/NAS server id/filter and if nothing is mentioned, then it returns all the DHSM services
+	dsreq := helper.DsReq[clientgen.FileDhsmConfigInstance, clientgen.ApiGetFileDhsmConfigByIdRequest, clientgen.ApiGetAllFileDhsmConfigsRequest]{
+		Instance:   d.client.FileDhsmConfigApi.GetFileDhsmConfigById,
+		Collection: d.client.FileDhsmConfigApi.GetAllFileDhsmConfigs,
+	}
+	if !state.NasServerID.IsNull() {
+		queries.Set("nas_server_id", "eq."+state.NasServerID.ValueString())
+	} else if !state.Filters.IsNull() {
+		queries = helper.MergeValues(queries, state.Filters.ValueQueries())
+	}
+	items, err := dsreq.Execute(ctx, queries, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read PowerStore DHSM Services",
+			err.Error(),
+		)
+		return
+	}
+
+	// check that there is a DHSM service if NAS server id is provided
+	if state.NasServerID.ValueString() != "" && len(items) == 0 {
+		resp.Diagnostics.AddError(
+			"Unable to Read PowerStore DHSM Services",
+			"There is no DHSM service on NAS server "+state.NasServerID.ValueString(),
+		)
+		return
+	}
+
+	state.FileDhsmConfigs = updateFileDhsmConfigState(items)
+	state.ID = types.StringValue("placeholder")
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
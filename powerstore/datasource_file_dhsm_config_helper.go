@@ -0,0 +1,38 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+)
+
+// fileDhsmConfigDatasourceSelect lists the DHSM fields queried by the file dhsm config datasource
+const fileDhsmConfigDatasourceSelect = "id,nas_server_id,user_name"
+
+// updateFileDhsmConfigState iterates over the DHSM service list and update the state
+func updateFileDhsmConfigState(in []clientgen.FileDhsmConfigInstance) []models.FileDhsmConfigDataSource {
+	return helper.SliceTransform(in, func(in clientgen.FileDhsmConfigInstance) models.FileDhsmConfigDataSource {
+		return models.FileDhsmConfigDataSource{
+			ID:          helper.TfString(in.Id),
+			NasServerID: helper.TfString(in.NasServerId),
+			UserName:    helper.TfString(in.UserName),
+		}
+	})
+}
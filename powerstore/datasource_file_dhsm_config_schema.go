@@ -0,0 +1,43 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+// FileDhsmConfigDatasourceSchema is a function that returns the schema for file dhsm config datasource
+func FileDhsmConfigDatasourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "Unique identifier of the DHSM service.",
+			MarkdownDescription: "Unique identifier of the DHSM service.",
+			Computed:            true,
+		},
+		"nas_server_id": schema.StringAttribute{
+			Description:         "Unique identifier of the NAS server the DHSM service is configured on.",
+			MarkdownDescription: "Unique identifier of the NAS server the DHSM service is configured on.",
+			Computed:            true,
+		},
+		"user_name": schema.StringAttribute{
+			Description:         "User name used to authenticate to the DHSM service.",
+			MarkdownDescription: "User name used to authenticate to the DHSM service.",
+			Computed:            true,
+		},
+	}
+}
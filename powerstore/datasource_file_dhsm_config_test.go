@@ -0,0 +1,115 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Test to Fetch File DHSM Configs
+func TestAccFileDhsmConfigDs(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				// Get all File DHSM Configs
+				Config: ProviderConfigForTesting + FileDhsmConfigParamsCreate + FileDhsmConfigDataSourceParamsAll,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerstore_file_dhsm_config.test", "file_dhsm_configs.0.id"),
+					resource.TestCheckResourceAttrSet("data.powerstore_file_dhsm_config.test", "file_dhsm_configs.0.nas_server_id"),
+				),
+			},
+			{
+				// Get File DHSM Config by ID
+				Config: ProviderConfigForTesting + FileDhsmConfigParamsCreate + FileDhsmConfigDataSourceParamsID,
+				Check:  resource.TestCheckResourceAttr("data.powerstore_file_dhsm_config.test", "file_dhsm_configs.#", "1"),
+			},
+			{
+				// Get File DHSM Config by NAS server id
+				Config: ProviderConfigForTesting + FileDhsmConfigParamsCreate + FileDhsmConfigDataSourceParamsNasServerID,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerstore_file_dhsm_config.test", "file_dhsm_configs.#", "1"),
+					resource.TestCheckResourceAttr("data.powerstore_file_dhsm_config.test", "file_dhsm_configs.0.user_name", "tfacc_dhsm"),
+				),
+			},
+			{
+				// Get File DHSM Configs by filter expression
+				Config: ProviderConfigForTesting + FileDhsmConfigParamsCreate + FileDhsmConfigDataSourceParamsFilter,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerstore_file_dhsm_config.test", "file_dhsm_configs.#", "1"),
+					resource.TestCheckResourceAttr("data.powerstore_file_dhsm_config.test", "file_dhsm_configs.0.user_name", "tfacc_dhsm"),
+				),
+			},
+			{
+				Config:      ProviderConfigForTesting + FileDhsmConfigDataSourceParamsIDNegative,
+				ExpectError: regexp.MustCompile("Unable to Read PowerStore DHSM Services"),
+			},
+			{
+				Config:      ProviderConfigForTesting + FileDhsmConfigDataSourceParamsIDAndNasServerIDNegative,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
+
+var FileDhsmConfigDataSourceParamsAll = `
+data "powerstore_file_dhsm_config" "test" {
+	depends_on = [powerstore_file_dhsm_config.test]
+}
+`
+
+var FileDhsmConfigDataSourceParamsID = `
+data "powerstore_file_dhsm_config" "test" {
+	id = powerstore_file_dhsm_config.test.id
+}
+`
+
+var FileDhsmConfigDataSourceParamsNasServerID = `
+data "powerstore_file_dhsm_config" "test" {
+	nas_server_id = powerstore_file_dhsm_config.test.nas_server_id
+}
+`
+
+var FileDhsmConfigDataSourceParamsFilter = `
+data "powerstore_file_dhsm_config" "test" {
+	filter_expression = "user_name=eq.tfacc_dhsm"
+	depends_on = [powerstore_file_dhsm_config.test]
+}
+`
+
+var FileDhsmConfigDataSourceParamsIDNegative = `
+data "powerstore_file_dhsm_config" "test" {
+	id = "invalid-id"
+}
+`
+
+var FileDhsmConfigDataSourceParamsIDAndNasServerIDNegative = `
+data "powerstore_file_dhsm_config" "test" {
+	id = "invalid-id"
+	nas_server_id = "invalid"
+}
+`
@@ -0,0 +1,165 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"context"
+	"net/url"
+	"terraform-provider-powerstore/client"
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &hardwareDataSource{}
+	_ datasource.DataSourceWithConfigure = &hardwareDataSource{}
+)
+
+// newHardwareDataSource returns the hardware data source object
+func newHardwareDataSource() datasource.DataSource {
+	return &hardwareDataSource{}
+}
+
+// hardwareDataSource is the data source implementation
+type hardwareDataSource struct {
+	client *clientgen.APIClient
+}
+
+// Metadata returns the data source type name
+func (d *hardwareDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hardware"
+}
+
+// Schema defines the schema for the data source
+func (d *hardwareDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "This datasource is used to query the existing hardware components like drives, SFPs, DIMMs, power supplies and enclosures from PowerStore array. The information fetched from this datasource can be used for getting the details for further processing in resource block.",
+		MarkdownDescription: "This datasource is used to query the existing hardware components like drives, SFPs, DIMMs, power supplies and enclosures from PowerStore array. The information fetched from this datasource can be used for getting the details for further processing in resource block.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Unique identifier of the hardware component. Conflicts with `name`, `type` and `filter_expression`.",
+				MarkdownDescription: "Unique identifier of the hardware component. Conflicts with `name`, `type` and `filter_expression`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("name")),
+					stringvalidator.ConflictsWith(path.MatchRoot("type")),
+					stringvalidator.ConflictsWith(path.MatchRoot("filter_expression")),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description:         "Hardware component name. Conflicts with `id` and `filter_expression`.",
+				MarkdownDescription: "Hardware component name. Conflicts with `id` and `filter_expression`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("filter_expression")),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description:         "Type of the hardware components to be fetched, eg. Drive, SFP, DIMM, Power_Supply, Base_Enclosure or Expansion_Enclosure. Conflicts with `id`.",
+				MarkdownDescription: "Type of the hardware components to be fetched, eg. `Drive`, `SFP`, `DIMM`, `Power_Supply`, `Base_Enclosure` or `Expansion_Enclosure`. Conflicts with `id`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(hardwareTypes()...),
+				},
+			},
+			"filter_expression": schema.StringAttribute{
+				Description:         "PowerStore filter expression to filter hardware components by. Conflicts with `id` and `name`.",
+				MarkdownDescription: "PowerStore filter expression to filter hardware components by. Conflicts with `id` and `name`.",
+				Optional:            true,
+				CustomType:          models.FilterExpressionType{},
+			},
+			"hardware": schema.ListNestedAttribute{
+				Description:         "List of hardware components.",
+				MarkdownDescription: "List of hardware components.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: HardwareDatasourceSchema(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source
+func (d *hardwareDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c := req.ProviderData.(*client.Client)
+	d.client = c.GenClient
+}
+
+// Read updates the Terraform state with the latest hardware data
+func (d *hardwareDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state models.HardwareDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	queries := make(url.Values)
+	queries.Set("select", hardwareDatasourceSelect)
+	// Read the hardware based on id/name/type/filter and if nothing is mentioned, then it returns all the hardware components
+	dsreq := helper.DsReq[clientgen.HardwareInstance, clientgen.ApiGetHardwareByIdRequest, clientgen.ApiGetAllHardwaresRequest]{
+		Instance:   d.client.HardwareApi.GetHardwareById,
+		Collection: d.client.HardwareApi.GetAllHardwares,
+	}
+	if !state.Type.IsNull() {
+		queries.Set("type", "eq."+state.Type.ValueString())
+	}
+	if !state.Name.IsNull() {
+		queries.Set("name", "eq."+state.Name.ValueString())
+	} else if !state.Filters.IsNull() {
+		queries = helper.MergeValues(queries, state.Filters.ValueQueries())
+	}
+	hardware, err := dsreq.Execute(ctx, queries, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read PowerStore Hardware",
+			err.Error(),
+		)
+		return
+	}
+
+	// check that there is atleast one hardware component if name is provided
+	if state.Name.ValueString() != "" && len(hardware) == 0 {
+		resp.Diagnostics.AddError(
+			"Unable to Read PowerStore Hardware",
+			"There is no hardware component with name "+state.Name.ValueString(),
+		)
+		return
+	}
+
+	state.Hardware = updateHardwareState(hardware)
+	state.ID = types.StringValue("placeholder")
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,86 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// hardwareDatasourceSelect lists the hardware fields queried by the hardware datasource
+const hardwareDatasourceSelect = "id,name,type,lifecycle_state,parent_id,appliance_id,slot,part_number,serial_number,status_led_state," +
+	"is_marked,extra_details,stale_state,type_l10n,lifecycle_state_l10n,status_led_state_l10n"
+
+// hardwareTypes returns the list of hardware types supported by PowerStore
+func hardwareTypes() []string {
+	return helper.SliceTransform(clientgen.AllowedHardwareTypeEnumEnumValues, func(in clientgen.HardwareTypeEnum) string {
+		return string(in)
+	})
+}
+
+// updateHardwareState iterates over the hardware list and update the state
+func updateHardwareState(hardware []clientgen.HardwareInstance) []models.HardwareDataSource {
+	return helper.SliceTransform(hardware, func(in clientgen.HardwareInstance) models.HardwareDataSource {
+		return models.HardwareDataSource{
+			ID:                 helper.TfString(in.Id),
+			Name:               helper.TfString(in.Name),
+			Type:               helper.TfString(in.Type),
+			LifecycleState:     helper.TfString(in.LifecycleState),
+			ParentID:           helper.TfString(in.ParentId),
+			ApplianceID:        helper.TfString(in.ApplianceId),
+			Slot:               helper.TfInt64(in.Slot),
+			PartNumber:         helper.TfString(in.PartNumber),
+			SerialNumber:       helper.TfString(in.SerialNumber),
+			StatusLedState:     helper.TfString(in.StatusLedState),
+			IsMarked:           helper.TfBool(in.IsMarked),
+			StaleState:         helper.TfString(in.StaleState),
+			TypeL10n:           helper.TfString(in.TypeL10n),
+			LifecycleStateL10n: helper.TfString(in.LifecycleStateL10n),
+			StatusLedStateL10n: helper.TfString(in.StatusLedStateL10n),
+			ExtraDetails: helper.TfObject(in.ExtraDetails, func(in clientgen.HardwareExtraDetailsInstance) models.HardwareExtraDetailsDataSource {
+				return models.HardwareExtraDetailsDataSource{
+					CPUModel:             helper.TfString(in.CpuModel),
+					PhysicalMemorySizeGB: helper.TfInt64(in.PhysicalMemorySizeGb),
+					CPUCores:             helper.TfInt64(in.CpuCores),
+					CPUSockets:           helper.TfInt64(in.CpuSockets),
+					BusNumber:            helper.TfInt64(in.BusNumber),
+					EnclosureNumber:      helper.TfInt64(in.EnclosureNumber),
+					ModelName:            helper.TfString(in.ModelName),
+					FirmwareVersion:      helper.TfString(in.FirmwareVersion),
+					Mode:                 helper.TfString(in.Mode),
+					SupportedSpeeds: helper.SliceTransform(in.SupportedSpeeds, func(in clientgen.HardwareSFPSpeedEnum) types.String {
+						return types.StringValue(string(in))
+					}),
+					SupportedProtocol:         helper.TfString(in.SupportedProtocol),
+					ConnectorType:             helper.TfString(in.ConnectorType),
+					DriveType:                 helper.TfString(in.DriveType),
+					Size:                      helper.TfInt64(in.Size),
+					EncryptionStatus:          helper.TfString(in.EncryptionStatus),
+					FipsStatus:                helper.TfString(in.FipsStatus),
+					DellServiceTag:            helper.TfString(in.DellServiceTag),
+					ExpressServiceCode:        helper.TfString(in.ExpressServiceCode),
+					EnclosureModelDescription: helper.TfString(in.EnclosureModelDescription),
+					StorageClass:              helper.TfString(in.StorageClass),
+				}
+			}),
+		}
+	})
+}
@@ -0,0 +1,212 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// HardwareDatasourceSchema is a function that returns the schema for hardware datasource
+func HardwareDatasourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "Unique identifier of the hardware component.",
+			MarkdownDescription: "Unique identifier of the hardware component.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			Description:         "Name of the hardware component.",
+			MarkdownDescription: "Name of the hardware component.",
+			Computed:            true,
+		},
+		"type": schema.StringAttribute{
+			Description:         "Type of the hardware component.",
+			MarkdownDescription: "Type of the hardware component.",
+			Computed:            true,
+		},
+		"lifecycle_state": schema.StringAttribute{
+			Description:         "Lifecycle state of the hardware component.",
+			MarkdownDescription: "Lifecycle state of the hardware component.",
+			Computed:            true,
+		},
+		"parent_id": schema.StringAttribute{
+			Description:         "Unique identifier of the parent of the hardware component.",
+			MarkdownDescription: "Unique identifier of the parent of the hardware component.",
+			Computed:            true,
+		},
+		"appliance_id": schema.StringAttribute{
+			Description:         "Unique identifier of the appliance of the hardware component.",
+			MarkdownDescription: "Unique identifier of the appliance of the hardware component.",
+			Computed:            true,
+		},
+		"slot": schema.Int64Attribute{
+			Description:         "Slot or location of the hardware component.",
+			MarkdownDescription: "Slot or location of the hardware component.",
+			Computed:            true,
+		},
+		"part_number": schema.StringAttribute{
+			Description:         "Part number of the hardware component.",
+			MarkdownDescription: "Part number of the hardware component.",
+			Computed:            true,
+		},
+		"serial_number": schema.StringAttribute{
+			Description:         "Serial number of the hardware component.",
+			MarkdownDescription: "Serial number of the hardware component.",
+			Computed:            true,
+		},
+		"status_led_state": schema.StringAttribute{
+			Description:         "State of the status LED of the hardware component.",
+			MarkdownDescription: "State of the status LED of the hardware component.",
+			Computed:            true,
+		},
+		"is_marked": schema.BoolAttribute{
+			Description:         "Whether the hardware component is location marked.",
+			MarkdownDescription: "Whether the hardware component is location marked.",
+			Computed:            true,
+		},
+		"stale_state": schema.StringAttribute{
+			Description:         "Stale state of the hardware component.",
+			MarkdownDescription: "Stale state of the hardware component.",
+			Computed:            true,
+		},
+		"type_l10n": schema.StringAttribute{
+			Description:         "Localized message string corresponding to type.",
+			MarkdownDescription: "Localized message string corresponding to type.",
+			Computed:            true,
+		},
+		"lifecycle_state_l10n": schema.StringAttribute{
+			Description:         "Localized message string corresponding to lifecycle state.",
+			MarkdownDescription: "Localized message string corresponding to lifecycle state.",
+			Computed:            true,
+		},
+		"status_led_state_l10n": schema.StringAttribute{
+			Description:         "Localized message string corresponding to status LED state.",
+			MarkdownDescription: "Localized message string corresponding to status LED state.",
+			Computed:            true,
+		},
+		"extra_details": schema.SingleNestedAttribute{
+			Description:         "Details specific to the type of the hardware component.",
+			MarkdownDescription: "Details specific to the type of the hardware component.",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"cpu_model": schema.StringAttribute{
+					Description:         "CPU model name. Available on the Node hardware type.",
+					MarkdownDescription: "CPU model name. Available on the Node hardware type.",
+					Computed:            true,
+				},
+				"physical_memory_size_gb": schema.Int64Attribute{
+					Description:         "Total amount of physical memory in gigabytes. Available on the Node hardware type.",
+					MarkdownDescription: "Total amount of physical memory in gigabytes. Available on the Node hardware type.",
+					Computed:            true,
+				},
+				"cpu_cores": schema.Int64Attribute{
+					Description:         "Total number of physical cores. Available on the Node hardware type.",
+					MarkdownDescription: "Total number of physical cores. Available on the Node hardware type.",
+					Computed:            true,
+				},
+				"cpu_sockets": schema.Int64Attribute{
+					Description:         "Total number of physical sockets. Available on the Node hardware type.",
+					MarkdownDescription: "Total number of physical sockets. Available on the Node hardware type.",
+					Computed:            true,
+				},
+				"bus_number": schema.Int64Attribute{
+					Description:         "Bus number of the expansion shelf. Available on the Expansion_Enclosure hardware type.",
+					MarkdownDescription: "Bus number of the expansion shelf. Available on the Expansion_Enclosure hardware type.",
+					Computed:            true,
+				},
+				"enclosure_number": schema.Int64Attribute{
+					Description:         "Enclosure number of the expansion shelf. Available on the Expansion_Enclosure hardware type.",
+					MarkdownDescription: "Enclosure number of the expansion shelf. Available on the Expansion_Enclosure hardware type.",
+					Computed:            true,
+				},
+				"model_name": schema.StringAttribute{
+					Description:         "Model name of the hardware. Available on the IO_Module and M2_Drive hardware types.",
+					MarkdownDescription: "Model name of the hardware. Available on the IO_Module and M2_Drive hardware types.",
+					Computed:            true,
+				},
+				"firmware_version": schema.StringAttribute{
+					Description:         "Firmware version of the hardware. Available on the Drive hardware type.",
+					MarkdownDescription: "Firmware version of the hardware. Available on the Drive hardware type.",
+					Computed:            true,
+				},
+				"mode": schema.StringAttribute{
+					Description:         "Mode of the SFP. Available on the SFP hardware type.",
+					MarkdownDescription: "Mode of the SFP. Available on the SFP hardware type.",
+					Computed:            true,
+				},
+				"supported_speeds": schema.ListAttribute{
+					Description:         "Speeds supported by the SFP. Available on the SFP hardware type.",
+					MarkdownDescription: "Speeds supported by the SFP. Available on the SFP hardware type.",
+					Computed:            true,
+					ElementType:         types.StringType,
+				},
+				"supported_protocol": schema.StringAttribute{
+					Description:         "Protocol supported by the SFP. Available on the SFP hardware type.",
+					MarkdownDescription: "Protocol supported by the SFP. Available on the SFP hardware type.",
+					Computed:            true,
+				},
+				"connector_type": schema.StringAttribute{
+					Description:         "Connector type of the SFP. Available on the SFP hardware type.",
+					MarkdownDescription: "Connector type of the SFP. Available on the SFP hardware type.",
+					Computed:            true,
+				},
+				"drive_type": schema.StringAttribute{
+					Description:         "Type of the drive. Available on the Drive hardware type.",
+					MarkdownDescription: "Type of the drive. Available on the Drive hardware type.",
+					Computed:            true,
+				},
+				"size": schema.Int64Attribute{
+					Description:         "Size of the drive in bytes. Available on the Drive hardware type.",
+					MarkdownDescription: "Size of the drive in bytes. Available on the Drive hardware type.",
+					Computed:            true,
+				},
+				"encryption_status": schema.StringAttribute{
+					Description:         "Encryption status of the drive. Available on the Drive hardware type.",
+					MarkdownDescription: "Encryption status of the drive. Available on the Drive hardware type.",
+					Computed:            true,
+				},
+				"fips_status": schema.StringAttribute{
+					Description:         "FIPS compliance status of the drive. Available on the Drive hardware type.",
+					MarkdownDescription: "FIPS compliance status of the drive. Available on the Drive hardware type.",
+					Computed:            true,
+				},
+				"dell_service_tag": schema.StringAttribute{
+					Description:         "Dell service tag of the hardware. Available on the Base_Enclosure and Expansion_Enclosure hardware types.",
+					MarkdownDescription: "Dell service tag of the hardware. Available on the Base_Enclosure and Expansion_Enclosure hardware types.",
+					Computed:            true,
+				},
+				"express_service_code": schema.StringAttribute{
+					Description:         "Express service code of the hardware. Available on the Base_Enclosure and Expansion_Enclosure hardware types.",
+					MarkdownDescription: "Express service code of the hardware. Available on the Base_Enclosure and Expansion_Enclosure hardware types.",
+					Computed:            true,
+				},
+				"enclosure_model_description": schema.StringAttribute{
+					Description:         "Model description of the enclosure. Available on the Base_Enclosure and Expansion_Enclosure hardware types.",
+					MarkdownDescription: "Model description of the enclosure. Available on the Base_Enclosure and Expansion_Enclosure hardware types.",
+					Computed:            true,
+				},
+				"storage_class": schema.StringAttribute{
+					Description:         "Storage class of the drive. Available on the Drive hardware type.",
+					MarkdownDescription: "Storage class of the drive. Available on the Drive hardware type.",
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,145 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Test to Fetch Hardware
+func TestAccHardwareDs(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				// Get all Hardware
+				Config: ProviderConfigForTesting + HardwareDataSourceParamsAll,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerstore_hardware.test", "hardware.0.id"),
+					resource.TestCheckResourceAttrSet("data.powerstore_hardware.test", "hardware.0.type"),
+				),
+			},
+			{
+				// Get Hardware by ID
+				Config: ProviderConfigForTesting + HardwareDataSourceParamsAll + HardwareDataSourceParamsID,
+				Check:  resource.TestCheckResourceAttr("data.powerstore_hardware.test1", "hardware.#", "1"),
+			},
+			{
+				// Get Hardware by name
+				Config: ProviderConfigForTesting + HardwareDataSourceParamsAll + HardwareDataSourceParamsName,
+				Check:  resource.TestCheckResourceAttrPair("data.powerstore_hardware.test1", "hardware.0.name", "data.powerstore_hardware.test", "hardware.0.name"),
+			},
+			{
+				// Get Drives
+				Config: ProviderConfigForTesting + HardwareDataSourceParamsType,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerstore_hardware.test", "hardware.0.type", "Drive"),
+					resource.TestCheckResourceAttrSet("data.powerstore_hardware.test", "hardware.0.extra_details.encryption_status"),
+					resource.TestCheckResourceAttrSet("data.powerstore_hardware.test", "hardware.0.extra_details.fips_status"),
+				),
+			},
+			{
+				// Get Hardware by type and filter expression
+				Config: ProviderConfigForTesting + HardwareDataSourceParamsTypeAndFilter,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerstore_hardware.test", "hardware.0.type", "SFP"),
+					resource.TestCheckResourceAttr("data.powerstore_hardware.test", "hardware.0.lifecycle_state", "Healthy"),
+				),
+			},
+			{
+				Config:      ProviderConfigForTesting + HardwareDataSourceParamsIDNegative,
+				ExpectError: regexp.MustCompile("Unable to Read PowerStore Hardware"),
+			},
+			{
+				Config:      ProviderConfigForTesting + HardwareDataSourceParamsNameNegative,
+				ExpectError: regexp.MustCompile("Unable to Read PowerStore Hardware"),
+			},
+			{
+				Config:      ProviderConfigForTesting + HardwareDataSourceParamsFilterNegative,
+				ExpectError: regexp.MustCompile("Unable to Read PowerStore Hardware"),
+			},
+			{
+				Config:      ProviderConfigForTesting + HardwareDataSourceParamsTypeNegative,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+		},
+	})
+}
+
+var HardwareDataSourceParamsAll = `
+data "powerstore_hardware" "test" {
+}
+`
+
+var HardwareDataSourceParamsID = `
+data "powerstore_hardware" "test1" {
+	id = data.powerstore_hardware.test.hardware[0].id
+}
+`
+
+var HardwareDataSourceParamsName = `
+data "powerstore_hardware" "test1" {
+	name = data.powerstore_hardware.test.hardware[0].name
+}
+`
+
+var HardwareDataSourceParamsType = `
+data "powerstore_hardware" "test" {
+	type = "Drive"
+}
+`
+
+var HardwareDataSourceParamsTypeAndFilter = `
+data "powerstore_hardware" "test" {
+	type = "SFP"
+	filter_expression = "lifecycle_state=eq.Healthy"
+}
+`
+
+var HardwareDataSourceParamsIDNegative = `
+data "powerstore_hardware" "test" {
+	id = "invalid-id"
+}
+`
+
+var HardwareDataSourceParamsNameNegative = `
+data "powerstore_hardware" "test" {
+	name = "invalid-name"
+}
+`
+
+var HardwareDataSourceParamsFilterNegative = `
+data "powerstore_hardware" "test" {
+	filter_expression = "name=inv.invalid"
+}
+`
+
+var HardwareDataSourceParamsTypeNegative = `
+data "powerstore_hardware" "test" {
+	type = "Invalid"
+}
+`
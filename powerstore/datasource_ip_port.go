@@ -0,0 +1,144 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"context"
+	"net/url"
+	"terraform-provider-powerstore/client"
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &ipPortDataSource{}
+	_ datasource.DataSourceWithConfigure = &ipPortDataSource{}
+)
+
+// newIPPortDataSource returns the IP port data source object
+func newIPPortDataSource() datasource.DataSource {
+	return &ipPortDataSource{}
+}
+
+// ipPortDataSource is the data source implementation
+type ipPortDataSource struct {
+	client *clientgen.APIClient
+}
+
+// Metadata returns the data source type name
+func (d *ipPortDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ip_port"
+}
+
+// Schema defines the schema for the data source
+func (d *ipPortDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "This datasource is used to query the existing IP ports from PowerStore array. The information fetched from this datasource can be used for getting the details for further processing in resource block.",
+		MarkdownDescription: "This datasource is used to query the existing IP ports from PowerStore array. The information fetched from this datasource can be used for getting the details for further processing in resource block.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Unique identifier of the IP port. Conflicts with `eth_port_id` and `filter_expression`.",
+				MarkdownDescription: "Unique identifier of the IP port. Conflicts with `eth_port_id` and `filter_expression`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("eth_port_id")),
+					stringvalidator.ConflictsWith(path.MatchRoot("filter_expression")),
+				},
+			},
+			"eth_port_id": schema.StringAttribute{
+				Description:         "Unique identifier of the Ethernet port on which the IP port is configured. Conflicts with `id` and `filter_expression`.",
+				MarkdownDescription: "Unique identifier of the Ethernet port on which the IP port is configured. Conflicts with `id` and `filter_expression`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("filter_expression")),
+				},
+			},
+			"filter_expression": schema.StringAttribute{
+				Description:         "PowerStore filter expression to filter IP ports by. Conflicts with `id` and `eth_port_id`.",
+				MarkdownDescription: "PowerStore filter expression to filter IP ports by. Conflicts with `id` and `eth_port_id`.",
+				Optional:            true,
+				CustomType:          models.FilterExpressionType{},
+			},
+			"ip_ports": schema.ListNestedAttribute{
+				Description:         "List of IP ports.",
+				MarkdownDescription: "List of IP ports.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: IPPortDatasourceSchema(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source
+func (d *ipPortDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c := req.ProviderData.(*client.Client)
+	d.client = c.GenClient
+}
+
+// Read updates the Terraform state with the latest IP port data
+func (d *ipPortDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state models.IPPortDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	queries := make(url.Values)
+	queries.Set("select", ipPortDatasourceSelect)
+	// Read the IP ports based on id/eth port/filter and if nothing is mentioned, then it returns all the IP ports
+	dsreq := helper.DsReq[clientgen.IpPortInstance, clientgen.ApiGetIpPortByIdRequest, clientgen.ApiGetAllIpPortsRequest]{
+		Instance:   d.client.IpPortApi.GetIpPortById,
+		Collection: d.client.IpPortApi.GetAllIpPorts,
+	}
+	if !state.EthPortID.IsNull() {
+		queries.Set("eth_port_id", "eq."+state.EthPortID.ValueString())
+	} else if !state.Filters.IsNull() {
+		queries = helper.MergeValues(queries, state.Filters.ValueQueries())
+	}
+	items, err := dsreq.Execute(ctx, queries, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read PowerStore IP Ports",
+			err.Error(),
+		)
+		return
+	}
+
+	state.IPPorts = updateIPPortState(items)
+	state.ID = types.StringValue("placeholder")
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
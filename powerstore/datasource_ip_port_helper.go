@@ -0,0 +1,51 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ipPortDatasourceSelect lists the IP port fields queried by the IP port datasource
+const ipPortDatasourceSelect = "id,partner_id,target_iqn,available_usages,current_usages,fsn_id,bond_id,eth_port_id,veth_port_id,ip_pool_addresses(address,purposes)"
+
+// updateIPPortState iterates over the IP port list and update the state
+func updateIPPortState(in []clientgen.IpPortInstance) []models.IPPortDataSource {
+	return helper.SliceTransform(in, func(in clientgen.IpPortInstance) models.IPPortDataSource {
+		return models.IPPortDataSource{
+			ID:        helper.TfString(in.Id),
+			PartnerID: helper.TfString(in.PartnerId),
+			TargetIqn: helper.TfString(in.TargetIqn),
+			AvailableUsages: helper.SliceTransform(in.AvailableUsages, func(in clientgen.IpPortUsageEnum) types.String {
+				return types.StringValue(string(in))
+			}),
+			CurrentUsages: helper.SliceTransform(in.CurrentUsages, func(in clientgen.IpPortUsageEnum) types.String {
+				return types.StringValue(string(in))
+			}),
+			FsnID:       helper.TfString(in.FsnId),
+			BondID:      helper.TfString(in.BondId),
+			EthPortID:   helper.TfString(in.EthPortId),
+			VethPortID:  helper.TfString(in.VethPortId),
+			IPAddresses: ipPoolAddresses(in.IpPoolAddresses),
+		}
+	})
+}
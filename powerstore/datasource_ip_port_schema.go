@@ -0,0 +1,82 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// IPPortDatasourceSchema is a function that returns the schema for IP port datasource
+func IPPortDatasourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "Unique identifier of the IP port.",
+			MarkdownDescription: "Unique identifier of the IP port.",
+			Computed:            true,
+		},
+		"partner_id": schema.StringAttribute{
+			Description:         "Unique identifier of the IP port with the same physical location on the peer node.",
+			MarkdownDescription: "Unique identifier of the IP port with the same physical location on the peer node.",
+			Computed:            true,
+		},
+		"target_iqn": schema.StringAttribute{
+			Description:         "iSCSI qualified name used by the target configured on top of the IP port.",
+			MarkdownDescription: "iSCSI qualified name used by the target configured on top of the IP port.",
+			Computed:            true,
+		},
+		"available_usages": schema.ListAttribute{
+			Description:         "Usages available on the IP port.",
+			MarkdownDescription: "Usages available on the IP port.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"current_usages": schema.ListAttribute{
+			Description:         "Current usages of the IP port.",
+			MarkdownDescription: "Current usages of the IP port.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"fsn_id": schema.StringAttribute{
+			Description:         "Unique identifier of the fail-safe network on which the IP port is configured.",
+			MarkdownDescription: "Unique identifier of the fail-safe network on which the IP port is configured.",
+			Computed:            true,
+		},
+		"bond_id": schema.StringAttribute{
+			Description:         "Unique identifier of the bond on which the IP port is configured.",
+			MarkdownDescription: "Unique identifier of the bond on which the IP port is configured.",
+			Computed:            true,
+		},
+		"eth_port_id": schema.StringAttribute{
+			Description:         "Unique identifier of the Ethernet port on which the IP port is configured.",
+			MarkdownDescription: "Unique identifier of the Ethernet port on which the IP port is configured.",
+			Computed:            true,
+		},
+		"veth_port_id": schema.StringAttribute{
+			Description:         "Unique identifier of the virtual Ethernet port on which the IP port is configured.",
+			MarkdownDescription: "Unique identifier of the virtual Ethernet port on which the IP port is configured.",
+			Computed:            true,
+		},
+		"ip_addresses": schema.ListAttribute{
+			Description:         "IP addresses assigned to the IP port.",
+			MarkdownDescription: "IP addresses assigned to the IP port.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+	}
+}
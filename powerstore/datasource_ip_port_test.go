@@ -0,0 +1,116 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Test to Fetch IP Ports
+func TestAccIPPortDs(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				// Get all IP Ports
+				Config: ProviderConfigForTesting + IPPortDataSourceParamsAll,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerstore_ip_port.test", "ip_ports.0.id"),
+				),
+			},
+			{
+				// Get IP Port by ID
+				Config: ProviderConfigForTesting + IPPortDataSourceParamsAll + IPPortDataSourceParamsID,
+				Check:  resource.TestCheckResourceAttr("data.powerstore_ip_port.test1", "ip_ports.#", "1"),
+			},
+			{
+				// Get IP Ports by eth port
+				Config: ProviderConfigForTesting + IPPortDataSourceParamsAll + IPPortDataSourceParamsEthPortID,
+				Check:  resource.TestCheckResourceAttrPair("data.powerstore_ip_port.test1", "ip_ports.0.eth_port_id", "data.powerstore_ip_port.test", "ip_ports.0.eth_port_id"),
+			},
+			{
+				// Get IP Ports by filter expression
+				Config: ProviderConfigForTesting + IPPortDataSourceParamsFilter,
+				Check:  resource.TestCheckTypeSetElemAttr("data.powerstore_ip_port.test", "ip_ports.0.current_usages.*", "ISCSI"),
+			},
+			{
+				Config:      ProviderConfigForTesting + IPPortDataSourceParamsIDNegative,
+				ExpectError: regexp.MustCompile("Unable to Read PowerStore IP Ports"),
+			},
+			{
+				Config:      ProviderConfigForTesting + IPPortDataSourceParamsFilterNegative,
+				ExpectError: regexp.MustCompile("Unable to Read PowerStore IP Ports"),
+			},
+			{
+				Config:      ProviderConfigForTesting + IPPortDataSourceParamsIDAndEthPortIDNegative,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
+
+var IPPortDataSourceParamsAll = `
+data "powerstore_ip_port" "test" {
+}
+`
+
+var IPPortDataSourceParamsID = `
+data "powerstore_ip_port" "test1" {
+	id = data.powerstore_ip_port.test.ip_ports[0].id
+}
+`
+
+var IPPortDataSourceParamsEthPortID = `
+data "powerstore_ip_port" "test1" {
+	eth_port_id = data.powerstore_ip_port.test.ip_ports[0].eth_port_id
+}
+`
+
+var IPPortDataSourceParamsFilter = `
+data "powerstore_ip_port" "test" {
+	filter_expression = "current_usages=cs.{ISCSI}"
+}
+`
+
+var IPPortDataSourceParamsIDNegative = `
+data "powerstore_ip_port" "test" {
+	id = "invalid-id"
+}
+`
+
+var IPPortDataSourceParamsFilterNegative = `
+data "powerstore_ip_port" "test" {
+	filter_expression = "name=inv.invalid"
+}
+`
+
+var IPPortDataSourceParamsIDAndEthPortIDNegative = `
+data "powerstore_ip_port" "test" {
+	id = "invalid-id"
+	eth_port_id = "invalid"
+}
+`
@@ -0,0 +1,144 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"context"
+	"net/url"
+	"terraform-provider-powerstore/client"
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &jobDataSource{}
+	_ datasource.DataSourceWithConfigure = &jobDataSource{}
+)
+
+// newJobDataSource returns the job data source object
+func newJobDataSource() datasource.DataSource {
+	return &jobDataSource{}
+}
+
+// jobDataSource is the data source implementation
+type jobDataSource struct {
+	client *clientgen.APIClient
+}
+
+// Metadata returns the data source type name
+func (d *jobDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_job"
+}
+
+// Schema defines the schema for the data source
+func (d *jobDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "This datasource is used to query the existing jobs from PowerStore array, including their steps and error messages. The information fetched from this datasource can be used for troubleshooting failed operations.",
+		MarkdownDescription: "This datasource is used to query the existing jobs from PowerStore array, including their steps and error messages. The information fetched from this datasource can be used for troubleshooting failed operations.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Unique identifier of the job. Conflicts with `resource_id` and `filter_expression`.",
+				MarkdownDescription: "Unique identifier of the job. Conflicts with `resource_id` and `filter_expression`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("resource_id")),
+					stringvalidator.ConflictsWith(path.MatchRoot("filter_expression")),
+				},
+			},
+			"resource_id": schema.StringAttribute{
+				Description:         "Unique identifier of the resource the jobs operated on. Conflicts with `id` and `filter_expression`.",
+				MarkdownDescription: "Unique identifier of the resource the jobs operated on. Conflicts with `id` and `filter_expression`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("filter_expression")),
+				},
+			},
+			"filter_expression": schema.StringAttribute{
+				Description:         "PowerStore filter expression to filter jobs by. Conflicts with `id` and `resource_id`.",
+				MarkdownDescription: "PowerStore filter expression to filter jobs by. Conflicts with `id` and `resource_id`.",
+				Optional:            true,
+				CustomType:          models.FilterExpressionType{},
+			},
+			"jobs": schema.ListNestedAttribute{
+				Description:         "List of jobs.",
+				MarkdownDescription: "List of jobs.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: JobDatasourceSchema(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source
+func (d *jobDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c := req.ProviderData.(*client.Client)
+	d.client = c.GenClient
+}
+
+// Read updates the Terraform state with the latest job data
+func (d *jobDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state models.JobDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	queries := make(url.Values)
+	queries.Set("select", jobDatasourceSelect)
+	// Read the jobs based on id/resource id/filter and if nothing is mentioned, then it returns all the jobs
+	dsreq := helper.DsReq[clientgen.JobInstance, clientgen.ApiGetJobByIdRequest, clientgen.ApiGetAllJobsRequest]{
+		Instance:   d.client.JobApi.GetJobById,
+		Collection: d.client.JobApi.GetAllJobs,
+	}
+	if !state.ResourceID.IsNull() {
+		queries.Set("resource_id", "eq."+state.ResourceID.ValueString())
+	} else if !state.Filters.IsNull() {
+		queries = helper.MergeValues(queries, state.Filters.ValueQueries())
+	}
+	items, err := dsreq.Execute(ctx, queries, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read PowerStore Jobs",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Jobs = updateJobState(items)
+	state.ID = types.StringValue("placeholder")
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
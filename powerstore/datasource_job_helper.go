@@ -0,0 +1,73 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"terraform-provider-powerstore/client"
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// jobDatasourceSelect lists the job fields queried by the job datasource
+const jobDatasourceSelect = "id,description_l10n,resource_action,resource_type,resource_id,resource_name,state,phase,start_time,end_time,estimated_completion_time,progress_percentage,parent_id,root_id,user,response_status,response_body,step_order,children(id,description_l10n,state,step_order,progress_percentage,response_body)"
+
+// jobErrorMessagesState converts the error messages of a job to the state
+func jobErrorMessagesState(in clientgen.JobInstance) []types.String {
+	return helper.SliceTransform(client.JobErrorMessages(in), func(in string) types.String {
+		return types.StringValue(in)
+	})
+}
+
+// updateJobState iterates over the job list and update the state
+func updateJobState(in []clientgen.JobInstance) []models.JobDataSource {
+	return helper.SliceTransform(in, func(in clientgen.JobInstance) models.JobDataSource {
+		return models.JobDataSource{
+			ID:                      helper.TfString(in.Id),
+			Description:             helper.TfString(in.DescriptionL10n),
+			ResourceAction:          helper.TfString(in.ResourceAction),
+			ResourceType:            helper.TfString(in.ResourceType),
+			ResourceID:              helper.TfString(in.ResourceId),
+			ResourceName:            helper.TfString(in.ResourceName),
+			State:                   helper.TfString(in.State),
+			Phase:                   helper.TfString(in.Phase),
+			StartTime:               helper.TfStringFromPTime(in.StartTime),
+			EndTime:                 helper.TfStringFromPTime(in.EndTime),
+			EstimatedCompletionTime: helper.TfStringFromPTime(in.EstimatedCompletionTime),
+			ProgressPercentage:      helper.TfInt64(in.ProgressPercentage),
+			ParentID:                helper.TfString(in.ParentId),
+			RootID:                  helper.TfString(in.RootId),
+			User:                    helper.TfString(in.User),
+			ResponseStatus:          helper.TfString(in.ResponseStatus),
+			StepOrder:               helper.TfInt64(in.StepOrder),
+			ErrorMessages:           jobErrorMessagesState(in),
+			ChildJobs: helper.SliceTransform(in.Children, func(in clientgen.JobInstance) models.JobStepSource {
+				return models.JobStepSource{
+					ID:                 helper.TfString(in.Id),
+					Description:        helper.TfString(in.DescriptionL10n),
+					State:              helper.TfString(in.State),
+					StepOrder:          helper.TfInt64(in.StepOrder),
+					ProgressPercentage: helper.TfInt64(in.ProgressPercentage),
+					ErrorMessages:      jobErrorMessagesState(in),
+				}
+			}),
+		}
+	})
+}
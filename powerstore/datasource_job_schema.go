@@ -0,0 +1,165 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// JobDatasourceSchema is a function that returns the schema for job datasource
+func JobDatasourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "Unique identifier of the job.",
+			MarkdownDescription: "Unique identifier of the job.",
+			Computed:            true,
+		},
+		"description": schema.StringAttribute{
+			Description:         "Description of the job.",
+			MarkdownDescription: "Description of the job.",
+			Computed:            true,
+		},
+		"resource_action": schema.StringAttribute{
+			Description:         "Action performed by the job on the resource.",
+			MarkdownDescription: "Action performed by the job on the resource.",
+			Computed:            true,
+		},
+		"resource_type": schema.StringAttribute{
+			Description:         "Type of the resource the job is operating on.",
+			MarkdownDescription: "Type of the resource the job is operating on.",
+			Computed:            true,
+		},
+		"resource_id": schema.StringAttribute{
+			Description:         "Unique identifier of the resource the job is operating on.",
+			MarkdownDescription: "Unique identifier of the resource the job is operating on.",
+			Computed:            true,
+		},
+		"resource_name": schema.StringAttribute{
+			Description:         "Name of the resource the job is operating on.",
+			MarkdownDescription: "Name of the resource the job is operating on.",
+			Computed:            true,
+		},
+		"state": schema.StringAttribute{
+			Description:         "Current state of the job.",
+			MarkdownDescription: "Current state of the job.",
+			Computed:            true,
+		},
+		"phase": schema.StringAttribute{
+			Description:         "Current phase of the job.",
+			MarkdownDescription: "Current phase of the job.",
+			Computed:            true,
+		},
+		"start_time": schema.StringAttribute{
+			Description:         "Date and time when the job execution started.",
+			MarkdownDescription: "Date and time when the job execution started.",
+			Computed:            true,
+		},
+		"end_time": schema.StringAttribute{
+			Description:         "Date and time when the job execution ended.",
+			MarkdownDescription: "Date and time when the job execution ended.",
+			Computed:            true,
+		},
+		"estimated_completion_time": schema.StringAttribute{
+			Description:         "Estimated date and time when the job execution will be completed.",
+			MarkdownDescription: "Estimated date and time when the job execution will be completed.",
+			Computed:            true,
+		},
+		"progress_percentage": schema.Int64Attribute{
+			Description:         "Percent complete of the job.",
+			MarkdownDescription: "Percent complete of the job.",
+			Computed:            true,
+		},
+		"parent_id": schema.StringAttribute{
+			Description:         "Unique identifier of the parent job, if the job is a step of another job.",
+			MarkdownDescription: "Unique identifier of the parent job, if the job is a step of another job.",
+			Computed:            true,
+		},
+		"root_id": schema.StringAttribute{
+			Description:         "Unique identifier of the top level job, if the job is a step of another job.",
+			MarkdownDescription: "Unique identifier of the top level job, if the job is a step of another job.",
+			Computed:            true,
+		},
+		"user": schema.StringAttribute{
+			Description:         "Name of the user who started the job.",
+			MarkdownDescription: "Name of the user who started the job.",
+			Computed:            true,
+		},
+		"response_status": schema.StringAttribute{
+			Description:         "HTTP status of the operation run by the job.",
+			MarkdownDescription: "HTTP status of the operation run by the job.",
+			Computed:            true,
+		},
+		"step_order": schema.Int64Attribute{
+			Description:         "Order of the job among the steps of its parent job.",
+			MarkdownDescription: "Order of the job among the steps of its parent job.",
+			Computed:            true,
+		},
+		"error_messages": schema.ListAttribute{
+			Description:         "Error messages of the job, if it failed.",
+			MarkdownDescription: "Error messages of the job, if it failed.",
+			ElementType:         types.StringType,
+			Computed:            true,
+		},
+		"child_jobs": schema.ListNestedAttribute{
+			Description:         "Child jobs, i.e. the steps run by the job.",
+			MarkdownDescription: "Child jobs, i.e. the steps run by the job.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: JobStepDatasourceSchema(),
+			},
+		},
+	}
+}
+
+// JobStepDatasourceSchema is a function that returns the schema for the child jobs of job datasource
+func JobStepDatasourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "Unique identifier of the child job.",
+			MarkdownDescription: "Unique identifier of the child job.",
+			Computed:            true,
+		},
+		"description": schema.StringAttribute{
+			Description:         "Description of the child job.",
+			MarkdownDescription: "Description of the child job.",
+			Computed:            true,
+		},
+		"state": schema.StringAttribute{
+			Description:         "Current state of the child job.",
+			MarkdownDescription: "Current state of the child job.",
+			Computed:            true,
+		},
+		"step_order": schema.Int64Attribute{
+			Description:         "Order of the child job among the steps of the job.",
+			MarkdownDescription: "Order of the child job among the steps of the job.",
+			Computed:            true,
+		},
+		"progress_percentage": schema.Int64Attribute{
+			Description:         "Percent complete of the child job.",
+			MarkdownDescription: "Percent complete of the child job.",
+			Computed:            true,
+		},
+		"error_messages": schema.ListAttribute{
+			Description:         "Error messages of the child job, if it failed.",
+			MarkdownDescription: "Error messages of the child job, if it failed.",
+			ElementType:         types.StringType,
+			Computed:            true,
+		},
+	}
+}
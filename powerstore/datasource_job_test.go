@@ -0,0 +1,117 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Test to Fetch Jobs
+func TestAccJobDs(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				// Get all Jobs
+				Config: ProviderConfigForTesting + JobDataSourceParamsAll,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerstore_job.test", "jobs.0.id"),
+					resource.TestCheckResourceAttrSet("data.powerstore_job.test", "jobs.0.state"),
+				),
+			},
+			{
+				// Get Job by ID
+				Config: ProviderConfigForTesting + JobDataSourceParamsAll + JobDataSourceParamsID,
+				Check:  resource.TestCheckResourceAttr("data.powerstore_job.test1", "jobs.#", "1"),
+			},
+			{
+				// Get Jobs by resource id
+				Config: ProviderConfigForTesting + JobDataSourceParamsAll + JobDataSourceParamsResourceID,
+				Check:  resource.TestCheckResourceAttrPair("data.powerstore_job.test1", "jobs.0.resource_id", "data.powerstore_job.test", "jobs.0.resource_id"),
+			},
+			{
+				// Get Jobs by filter expression
+				Config: ProviderConfigForTesting + JobDataSourceParamsFilter,
+				Check:  resource.TestCheckResourceAttr("data.powerstore_job.test", "jobs.0.state", "COMPLETED"),
+			},
+			{
+				Config:      ProviderConfigForTesting + JobDataSourceParamsIDNegative,
+				ExpectError: regexp.MustCompile("Unable to Read PowerStore Jobs"),
+			},
+			{
+				Config:      ProviderConfigForTesting + JobDataSourceParamsFilterNegative,
+				ExpectError: regexp.MustCompile("Unable to Read PowerStore Jobs"),
+			},
+			{
+				Config:      ProviderConfigForTesting + JobDataSourceParamsIDAndResourceIDNegative,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
+
+var JobDataSourceParamsAll = `
+data "powerstore_job" "test" {
+}
+`
+
+var JobDataSourceParamsID = `
+data "powerstore_job" "test1" {
+	id = data.powerstore_job.test.jobs[0].id
+}
+`
+
+var JobDataSourceParamsResourceID = `
+data "powerstore_job" "test1" {
+	resource_id = data.powerstore_job.test.jobs[0].resource_id
+}
+`
+
+var JobDataSourceParamsFilter = `
+data "powerstore_job" "test" {
+	filter_expression = "state=eq.COMPLETED"
+}
+`
+
+var JobDataSourceParamsIDNegative = `
+data "powerstore_job" "test" {
+	id = "invalid-id"
+}
+`
+
+var JobDataSourceParamsFilterNegative = `
+data "powerstore_job" "test" {
+	filter_expression = "name=inv.invalid"
+}
+`
+
+var JobDataSourceParamsIDAndResourceIDNegative = `
+data "powerstore_job" "test" {
+	id = "invalid-id"
+	resource_id = "invalid"
+}
+`
@@ -0,0 +1,153 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerstore/client"
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+var (
+	_ datasource.DataSource              = &kmipServerDataSource{}
+	_ datasource.DataSourceWithConfigure = &kmipServerDataSource{}
+)
+
+// newKmipServerDataSource returns the kmip server data source object
+func newKmipServerDataSource() datasource.DataSource {
+	return &kmipServerDataSource{}
+}
+
+// kmipServerDataSource is the data source implementation
+type kmipServerDataSource struct {
+	client *clientgen.APIClient
+}
+
+// Metadata returns the data source type name
+func (d *kmipServerDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kmip_server"
+}
+
+// Schema defines the schema for the data source
+func (d *kmipServerDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "This datasource is used to query the external key manager (KMIP) configuration from PowerStore array along with the connectivity status of each KMIP server. The information fetched from this datasource can be used for getting the details for further processing in resource block.",
+		MarkdownDescription: "This datasource is used to query the external key manager (KMIP) configuration from PowerStore array along with the connectivity status of each KMIP server. The information fetched from this datasource can be used for getting the details for further processing in resource block.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Unique identifier of the KMIP configuration.",
+				MarkdownDescription: "Unique identifier of the KMIP configuration.",
+				Computed:            true,
+			},
+			"verify": schema.BoolAttribute{
+				Description:         "Whether to verify the connectivity to the KMIP servers before reading their status. Otherwise the status of the last verification is reported. A failed verification is reported as a warning.",
+				MarkdownDescription: "Whether to verify the connectivity to the KMIP servers before reading their status. Otherwise the status of the last verification is reported. A failed verification is reported as a warning.",
+				Optional:            true,
+			},
+			"is_enabled": schema.BoolAttribute{
+				Description:         "Whether the external key management is enabled.",
+				MarkdownDescription: "Whether the external key management is enabled.",
+				Computed:            true,
+			},
+			"port": schema.Int64Attribute{
+				Description:         "Port used to connect to the KMIP servers.",
+				MarkdownDescription: "Port used to connect to the KMIP servers.",
+				Computed:            true,
+			},
+			"server_timeout": schema.Int64Attribute{
+				Description:         "Timeout in seconds to connect to a KMIP server.",
+				MarkdownDescription: "Timeout in seconds to connect to a KMIP server.",
+				Computed:            true,
+			},
+			"username": schema.StringAttribute{
+				Description:         "User name used to access the KMIP servers.",
+				MarkdownDescription: "User name used to access the KMIP servers.",
+				Computed:            true,
+			},
+			"servers": schema.ListNestedAttribute{
+				Description:         "List of KMIP servers.",
+				MarkdownDescription: "List of KMIP servers.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: KmipServerDatasourceSchema(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source
+func (d *kmipServerDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c := req.ProviderData.(*client.Client)
+	d.client = c.GenClient
+}
+
+// Read updates the Terraform state with the latest KMIP configuration and connectivity
+func (d *kmipServerDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state models.KmipServerDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configs, _, err := d.client.KmipConfigApi.GetAllKmipConfigs(ctx).Execute()
+	if err == nil && len(configs) == 0 {
+		err = fmt.Errorf("no KMIP configuration found")
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read PowerStore KMIP Servers",
+			err.Error(),
+		)
+		return
+	}
+
+	id := *configs[0].Id
+	if state.Verify.ValueBool() {
+		// verification updates the status of every server, even when some of them are unreachable
+		if _, err := d.client.KmipConfigApi.KmipConfigVerify(ctx, id).Execute(); err != nil {
+			resp.Diagnostics.AddWarning(
+				"KMIP Server Verification Failed",
+				"Could not connect to all the KMIP servers: "+err.Error(),
+			)
+		}
+	}
+
+	config, _, err := d.client.KmipConfigApi.GetKmipConfigById(ctx, id).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read PowerStore KMIP Servers",
+			err.Error(),
+		)
+		return
+	}
+
+	updateKmipServerState(*config, &state)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,39 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+)
+
+// updateKmipServerState updates the state with the kmip config and the connectivity of its servers
+func updateKmipServerState(in clientgen.KmipConfigInstance, state *models.KmipServerDataSourceModel) {
+	state.ID = helper.TfString(in.Id)
+	state.IsEnabled = helper.TfBool(in.IsEnabled)
+	state.Port = helper.TfInt64(in.Port)
+	state.ServerTimeout = helper.TfInt64(in.ServerTimeout)
+	state.Username = helper.TfString(in.Username)
+	state.Servers = helper.SliceTransform(in.Servers, func(in clientgen.KmipConfigMemberInstance) models.KmipServerConnected {
+		return models.KmipServerConnected{
+			Address: helper.TfString(in.Address),
+			Status:  helper.TfString(in.Status),
+		}
+	})
+}
@@ -0,0 +1,38 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+// KmipServerDatasourceSchema is a function that returns the schema for the KMIP servers of the kmip server datasource
+func KmipServerDatasourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"address": schema.StringAttribute{
+			Description:         "IPv4, IPv6 address or host name of the KMIP server.",
+			MarkdownDescription: "IPv4, IPv6 address or host name of the KMIP server.",
+			Computed:            true,
+		},
+		"status": schema.StringAttribute{
+			Description:         "Connectivity status of the KMIP server as of the last verification, Up or Down.",
+			MarkdownDescription: "Connectivity status of the KMIP server as of the last verification, `Up` or `Down`.",
+			Computed:            true,
+		},
+	}
+}
@@ -0,0 +1,55 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Test to fetch KMIP Servers
+func TestAccKmipServerDs(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfigForTesting + KmipServerDataSourceParams,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.powerstore_kmip_server.test", "id", "powerstore_kmip_server.test", "id"),
+					resource.TestCheckResourceAttr("data.powerstore_kmip_server.test", "servers.#", "1"),
+					resource.TestCheckResourceAttr("data.powerstore_kmip_server.test", "servers.0.address", kmipServerAddress),
+					resource.TestCheckResourceAttrSet("data.powerstore_kmip_server.test", "servers.0.status"),
+				),
+			},
+		},
+	})
+}
+
+var KmipServerDataSourceParams = KmipServerParamsCreate + `
+data "powerstore_kmip_server" "test" {
+	verify = true
+	depends_on = [powerstore_kmip_server.test]
+}
+`
@@ -0,0 +1,220 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"terraform-provider-powerstore/client"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &metricsDataSource{}
+	_ datasource.DataSourceWithConfigure = &metricsDataSource{}
+)
+
+// newMetricsDataSource returns the metrics data source object
+func newMetricsDataSource() datasource.DataSource {
+	return &metricsDataSource{}
+}
+
+// metricsDataSource is the data source implementation
+type metricsDataSource struct {
+	client *client.Client
+}
+
+// Metadata returns the data source type name
+func (d *metricsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_metrics"
+}
+
+// Schema defines the schema for the data source
+func (d *metricsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "This datasource is used to query the performance metrics of an entity from PowerStore array. The information fetched from this datasource can be used to gate changes on the current load of the array.",
+		MarkdownDescription: "This datasource is used to query the performance metrics of an entity from PowerStore array. The information fetched from this datasource can be used to gate changes on the current load of the array.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Placeholder identifier of the metrics datasource.",
+				MarkdownDescription: "Placeholder identifier of the metrics datasource.",
+				Computed:            true,
+			},
+			"entity_type": schema.StringAttribute{
+				Description:         "Type of the entity whose performance metrics are fetched. Accepted values are `appliance`, `cluster`, `node`, `volume`, `volume_group`, `host`, `file_system` and `fc_port`.",
+				MarkdownDescription: "Type of the entity whose performance metrics are fetched. Accepted values are `appliance`, `cluster`, `node`, `volume`, `volume_group`, `host`, `file_system` and `fc_port`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(slices.Sorted(maps.Keys(metricsEntities))...),
+				},
+			},
+			"entity_id": schema.StringAttribute{
+				Description:         "Unique identifier of the entity whose performance metrics are fetched.",
+				MarkdownDescription: "Unique identifier of the entity whose performance metrics are fetched.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"interval": schema.StringAttribute{
+				Description: "Interval of the performance metrics samples. Accepted values are `Best_Available`, `Twenty_Sec`, `Five_Mins`, `One_Hour` and `One_Day`." +
+					" Defaults to `Five_Mins`. The array only retains the samples of each interval for a limited period.",
+				MarkdownDescription: "Interval of the performance metrics samples. Accepted values are `Best_Available`, `Twenty_Sec`, `Five_Mins`, `One_Hour` and `One_Day`." +
+					" Defaults to `Five_Mins`. The array only retains the samples of each interval for a limited period.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(metricsIntervals...),
+				},
+			},
+			"metrics": schema.ListNestedAttribute{
+				Description:         "List of performance metrics samples, ordered from the oldest to the most recent one.",
+				MarkdownDescription: "List of performance metrics samples, ordered from the oldest to the most recent one.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: PerformanceMetricsDatasourceSchema(),
+				},
+			},
+		},
+	}
+}
+
+// PerformanceMetricsDatasourceSchema is a function that returns the schema for a performance metrics sample
+func PerformanceMetricsDatasourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"timestamp": schema.StringAttribute{
+			Description:         "End of the period covered by the sample.",
+			MarkdownDescription: "End of the period covered by the sample.",
+			Computed:            true,
+		},
+		"repeat_count": schema.Int64Attribute{
+			Description:         "Number of times the sample was repeated because the values did not change.",
+			MarkdownDescription: "Number of times the sample was repeated because the values did not change.",
+			Computed:            true,
+		},
+		"read_iops": schema.Float64Attribute{
+			Description:         "Read operations per second.",
+			MarkdownDescription: "Read operations per second.",
+			Computed:            true,
+		},
+		"write_iops": schema.Float64Attribute{
+			Description:         "Write operations per second.",
+			MarkdownDescription: "Write operations per second.",
+			Computed:            true,
+		},
+		"total_iops": schema.Float64Attribute{
+			Description:         "Total read and write operations per second.",
+			MarkdownDescription: "Total read and write operations per second.",
+			Computed:            true,
+		},
+		"read_bandwidth": schema.Float64Attribute{
+			Description:         "Read rate in bytes per second.",
+			MarkdownDescription: "Read rate in bytes per second.",
+			Computed:            true,
+		},
+		"write_bandwidth": schema.Float64Attribute{
+			Description:         "Write rate in bytes per second.",
+			MarkdownDescription: "Write rate in bytes per second.",
+			Computed:            true,
+		},
+		"total_bandwidth": schema.Float64Attribute{
+			Description:         "Total read and write rate in bytes per second.",
+			MarkdownDescription: "Total read and write rate in bytes per second.",
+			Computed:            true,
+		},
+		"avg_latency": schema.Float64Attribute{
+			Description:         "Average read and write latency in microseconds.",
+			MarkdownDescription: "Average read and write latency in microseconds.",
+			Computed:            true,
+		},
+		"avg_read_latency": schema.Float64Attribute{
+			Description:         "Average read latency in microseconds.",
+			MarkdownDescription: "Average read latency in microseconds.",
+			Computed:            true,
+		},
+		"avg_write_latency": schema.Float64Attribute{
+			Description:         "Average write latency in microseconds.",
+			MarkdownDescription: "Average write latency in microseconds.",
+			Computed:            true,
+		},
+		"avg_io_size": schema.Float64Attribute{
+			Description:         "Average size of read and write operations in bytes.",
+			MarkdownDescription: "Average size of read and write operations in bytes.",
+			Computed:            true,
+		},
+		"max_total_iops": schema.Float64Attribute{
+			Description:         "Maximum total operations per second over the sample period. Only reported for rolled up intervals.",
+			MarkdownDescription: "Maximum total operations per second over the sample period. Only reported for rolled up intervals.",
+			Computed:            true,
+		},
+		"max_total_bandwidth": schema.Float64Attribute{
+			Description:         "Maximum total rate in bytes per second over the sample period. Only reported for rolled up intervals.",
+			MarkdownDescription: "Maximum total rate in bytes per second over the sample period. Only reported for rolled up intervals.",
+			Computed:            true,
+		},
+		"max_avg_latency": schema.Float64Attribute{
+			Description:         "Maximum average latency in microseconds over the sample period. Only reported for rolled up intervals.",
+			MarkdownDescription: "Maximum average latency in microseconds over the sample period. Only reported for rolled up intervals.",
+			Computed:            true,
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source
+func (d *metricsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*client.Client)
+}
+
+// Read updates the Terraform state with the latest performance metrics
+func (d *metricsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state models.MetricsDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.Interval.IsNull() || state.Interval.IsUnknown() {
+		state.Interval = types.StringValue("Five_Mins")
+	}
+	metrics, err := d.client.GetPerformanceMetrics(ctx, metricsEntities[state.EntityType.ValueString()], state.EntityID.ValueString(), state.Interval.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read PowerStore Metrics",
+			"Could not read performance metrics of "+state.EntityType.ValueString()+" "+state.EntityID.ValueString()+" with error "+err.Error(),
+		)
+		return
+	}
+
+	state.Metrics = helper.SliceTransform(metrics, newPerformanceMetricsState)
+	state.ID = types.StringValue("placeholder")
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
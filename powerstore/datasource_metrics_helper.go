@@ -0,0 +1,78 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"terraform-provider-powerstore/client"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// metricsEntities maps the entity types of the metrics datasource to PowerStore performance metrics entities
+var metricsEntities = map[string]string{
+	"appliance":    "performance_metrics_by_appliance",
+	"cluster":      "performance_metrics_by_cluster",
+	"node":         "performance_metrics_by_node",
+	"volume":       "performance_metrics_by_volume",
+	"volume_group": "performance_metrics_by_vg",
+	"host":         "performance_metrics_by_host",
+	"file_system":  "performance_metrics_by_file_system",
+	"fc_port":      "performance_metrics_by_fe_fc_port",
+}
+
+// metricsIntervals lists the intervals at which performance metrics can be fetched
+var metricsIntervals = []string{"Best_Available", "Twenty_Sec", "Five_Mins", "One_Hour", "One_Day"}
+
+// newPerformanceMetricsState converts a performance metrics sample fetched from PowerStore to its terraform state
+// Rolled up samples report averages under the avg_ prefix, they are exposed under the same attributes as raw samples
+func newPerformanceMetricsState(in client.PerformanceMetrics) models.PerformanceMetricsDataSource {
+	return models.PerformanceMetricsDataSource{
+		Timestamp:         helper.TfStringFromPTime(in.Timestamp),
+		RepeatCount:       helper.TfInt64(in.RepeatCount),
+		ReadIops:          firstFloat64(in.ReadIops, in.AvgReadIops),
+		WriteIops:         firstFloat64(in.WriteIops, in.AvgWriteIops),
+		TotalIops:         firstFloat64(in.TotalIops, in.AvgTotalIops),
+		ReadBandwidth:     firstFloat64(in.ReadBandwidth, in.AvgReadBandwidth),
+		WriteBandwidth:    firstFloat64(in.WriteBandwidth, in.AvgWriteBandwidth),
+		TotalBandwidth:    firstFloat64(in.TotalBandwidth, in.AvgTotalBandwidth),
+		AvgLatency:        helper.TfFloat64(in.AvgLatency),
+		AvgReadLatency:    helper.TfFloat64(in.AvgReadLatency),
+		AvgWriteLatency:   helper.TfFloat64(in.AvgWriteLatency),
+		AvgIoSize:         firstFloat64(in.AvgIoSize, in.AvgSize),
+		MaxTotalIops:      firstFloat64(in.MaxTotalIops, in.MaxIops),
+		MaxTotalBandwidth: helper.TfFloat64(in.MaxTotalBandwidth),
+		MaxAvgLatency:     helper.TfFloat64(in.MaxAvgLatency),
+	}
+}
+
+// firstFloat64 returns the first of the given values that is reported by PowerStore
+func firstFloat64(in ...*float64) types.Float64 {
+	return helper.TfFloat64(firstReported(in...))
+}
+
+// firstReported returns the first of the given values that is not nil
+func firstReported[T any](in ...*T) *T {
+	for _, v := range in {
+		if v != nil {
+			return v
+		}
+	}
+	return nil
+}
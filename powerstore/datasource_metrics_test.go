@@ -0,0 +1,101 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Test to Fetch Performance Metrics
+func TestAccMetricsDs(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				// Get appliance metrics at the default interval
+				Config: ProviderConfigForTesting + MetricsDataSourceParamsAppliance,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerstore_metrics.test", "interval", "Five_Mins"),
+					resource.TestCheckResourceAttrSet("data.powerstore_metrics.test", "metrics.0.timestamp"),
+					resource.TestCheckResourceAttrSet("data.powerstore_metrics.test", "metrics.0.total_iops"),
+					resource.TestCheckResourceAttrSet("data.powerstore_metrics.test", "metrics.0.avg_latency"),
+				),
+			},
+			{
+				// Get appliance metrics at twenty seconds interval
+				Config: ProviderConfigForTesting + MetricsDataSourceParamsTwentySec,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerstore_metrics.test", "metrics.0.total_bandwidth"),
+					resource.TestCheckNoResourceAttr("data.powerstore_metrics.test", "metrics.0.max_total_iops"),
+				),
+			},
+			{
+				Config:      ProviderConfigForTesting + MetricsDataSourceParamsEntityTypeNegative,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+			{
+				Config:      ProviderConfigForTesting + MetricsDataSourceParamsEntityIDNegative,
+				ExpectError: regexp.MustCompile("Unable to Read PowerStore Metrics"),
+			},
+		},
+	})
+}
+
+var MetricsDataSourceParamsAppliance = `
+data "powerstore_appliance" "test" {
+}
+
+data "powerstore_metrics" "test" {
+	entity_type = "appliance"
+	entity_id = data.powerstore_appliance.test.appliances[0].id
+}
+`
+
+var MetricsDataSourceParamsTwentySec = `
+data "powerstore_appliance" "test" {
+}
+
+data "powerstore_metrics" "test" {
+	entity_type = "appliance"
+	entity_id = data.powerstore_appliance.test.appliances[0].id
+	interval = "Twenty_Sec"
+}
+`
+
+var MetricsDataSourceParamsEntityTypeNegative = `
+data "powerstore_metrics" "test" {
+	entity_type = "invalid"
+	entity_id = "A1"
+}
+`
+
+var MetricsDataSourceParamsEntityIDNegative = `
+data "powerstore_metrics" "test" {
+	entity_type = "volume"
+	entity_id = "invalid-id"
+}
+`
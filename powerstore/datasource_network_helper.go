@@ -0,0 +1,59 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ipPoolAddresses returns the addresses of the given IP pool address list
+func ipPoolAddresses(in []clientgen.IpPoolAddressInstance) []types.String {
+	return helper.SliceTransform(in, func(in clientgen.IpPoolAddressInstance) types.String {
+		return helper.TfString(in.Address)
+	})
+}
+
+// networkDatasourceSelect lists the network fields queried by the network datasource
+const networkDatasourceSelect = "id,name,type,ip_version,purposes,vlan_id,prefix_length,gateway,mtu,nvme_discovery_mode,nvme_cdc_address,nvme_cdc_port,ip_pool_addresses(address,purposes)"
+
+// updateNetworkState iterates over the network list and update the state
+func updateNetworkState(in []clientgen.NetworkInstance) []models.NetworkDataSource {
+	return helper.SliceTransform(in, func(in clientgen.NetworkInstance) models.NetworkDataSource {
+		return models.NetworkDataSource{
+			ID:        helper.TfString(in.Id),
+			Name:      helper.TfString(in.Name),
+			Type:      helper.TfString(in.Type),
+			IPVersion: helper.TfString(in.IpVersion),
+			Purposes: helper.SliceTransform(in.Purposes, func(in clientgen.NetworkPurposeEnum) types.String {
+				return types.StringValue(string(in))
+			}),
+			VLANID:            helper.TfInt64(in.VlanId),
+			PrefixLength:      helper.TfInt64(in.PrefixLength),
+			Gateway:           helper.TfString(in.Gateway),
+			MTU:               helper.TfInt64(in.Mtu),
+			NvmeDiscoveryMode: helper.TfString(in.NvmeDiscoveryMode),
+			NvmeCdcAddress:    helper.TfString(in.NvmeCdcAddress),
+			NvmeCdcPort:       helper.TfInt64(in.NvmeCdcPort),
+			Addresses:         ipPoolAddresses(in.IpPoolAddresses),
+		}
+	})
+}
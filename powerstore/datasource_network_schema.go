@@ -0,0 +1,96 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NetworkDatasourceSchema is a function that returns the schema for network datasource
+func NetworkDatasourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "Unique identifier of the network.",
+			MarkdownDescription: "Unique identifier of the network.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			Description:         "Name of the network.",
+			MarkdownDescription: "Name of the network.",
+			Computed:            true,
+		},
+		"type": schema.StringAttribute{
+			Description:         "Type of the network.",
+			MarkdownDescription: "Type of the network.",
+			Computed:            true,
+		},
+		"ip_version": schema.StringAttribute{
+			Description:         "IP version of the network.",
+			MarkdownDescription: "IP version of the network.",
+			Computed:            true,
+		},
+		"purposes": schema.ListAttribute{
+			Description:         "Purposes of the network. Empty for single purposed networks like management.",
+			MarkdownDescription: "Purposes of the network. Empty for single purposed networks like management.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"vlan_id": schema.Int64Attribute{
+			Description:         "VLAN identifier of the network.",
+			MarkdownDescription: "VLAN identifier of the network.",
+			Computed:            true,
+		},
+		"prefix_length": schema.Int64Attribute{
+			Description:         "Network prefix length.",
+			MarkdownDescription: "Network prefix length.",
+			Computed:            true,
+		},
+		"gateway": schema.StringAttribute{
+			Description:         "Network gateway.",
+			MarkdownDescription: "Network gateway.",
+			Computed:            true,
+		},
+		"mtu": schema.Int64Attribute{
+			Description:         "Maximum Transmission Unit (MTU) packet size set on network interfaces, in bytes.",
+			MarkdownDescription: "Maximum Transmission Unit (MTU) packet size set on network interfaces, in bytes.",
+			Computed:            true,
+		},
+		"nvme_discovery_mode": schema.StringAttribute{
+			Description:         "NVMe discovery mode of the network.",
+			MarkdownDescription: "NVMe discovery mode of the network.",
+			Computed:            true,
+		},
+		"nvme_cdc_address": schema.StringAttribute{
+			Description:         "IP address of the NVMe Centralized Discovery Controller (CDC).",
+			MarkdownDescription: "IP address of the NVMe Centralized Discovery Controller (CDC).",
+			Computed:            true,
+		},
+		"nvme_cdc_port": schema.Int64Attribute{
+			Description:         "TCP port of the NVMe Centralized Discovery Controller (CDC).",
+			MarkdownDescription: "TCP port of the NVMe Centralized Discovery Controller (CDC).",
+			Computed:            true,
+		},
+		"addresses": schema.ListAttribute{
+			Description:         "IP addresses in the IP pool of the network.",
+			MarkdownDescription: "IP addresses in the IP pool of the network.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+	}
+}
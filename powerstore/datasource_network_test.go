@@ -0,0 +1,118 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Test to Fetch Networks
+func TestAccNetworkDs(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				// Get all Networks
+				Config: ProviderConfigForTesting + NetworkDataSourceParamsAll,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerstore_network.test", "networks.0.id"),
+					resource.TestCheckResourceAttrSet("data.powerstore_network.test", "networks.0.name"),
+					resource.TestCheckResourceAttrSet("data.powerstore_network.test", "networks.0.type"),
+				),
+			},
+			{
+				// Get Network by ID
+				Config: ProviderConfigForTesting + NetworkDataSourceParamsAll + NetworkDataSourceParamsID,
+				Check:  resource.TestCheckResourceAttr("data.powerstore_network.test1", "networks.#", "1"),
+			},
+			{
+				// Get Networks by name
+				Config: ProviderConfigForTesting + NetworkDataSourceParamsAll + NetworkDataSourceParamsName,
+				Check:  resource.TestCheckResourceAttrPair("data.powerstore_network.test1", "networks.0.name", "data.powerstore_network.test", "networks.0.name"),
+			},
+			{
+				// Get Networks by filter expression
+				Config: ProviderConfigForTesting + NetworkDataSourceParamsFilter,
+				Check:  resource.TestCheckResourceAttr("data.powerstore_network.test", "networks.0.type", "Storage"),
+			},
+			{
+				Config:      ProviderConfigForTesting + NetworkDataSourceParamsIDNegative,
+				ExpectError: regexp.MustCompile("Unable to Read PowerStore Networks"),
+			},
+			{
+				Config:      ProviderConfigForTesting + NetworkDataSourceParamsFilterNegative,
+				ExpectError: regexp.MustCompile("Unable to Read PowerStore Networks"),
+			},
+			{
+				Config:      ProviderConfigForTesting + NetworkDataSourceParamsIDAndNameNegative,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
+
+var NetworkDataSourceParamsAll = `
+data "powerstore_network" "test" {
+}
+`
+
+var NetworkDataSourceParamsID = `
+data "powerstore_network" "test1" {
+	id = data.powerstore_network.test.networks[0].id
+}
+`
+
+var NetworkDataSourceParamsName = `
+data "powerstore_network" "test1" {
+	name = data.powerstore_network.test.networks[0].name
+}
+`
+
+var NetworkDataSourceParamsFilter = `
+data "powerstore_network" "test" {
+	filter_expression = "type=eq.Storage"
+}
+`
+
+var NetworkDataSourceParamsIDNegative = `
+data "powerstore_network" "test" {
+	id = "invalid-id"
+}
+`
+
+var NetworkDataSourceParamsFilterNegative = `
+data "powerstore_network" "test" {
+	filter_expression = "name=inv.invalid"
+}
+`
+
+var NetworkDataSourceParamsIDAndNameNegative = `
+data "powerstore_network" "test" {
+	id = "invalid-id"
+	name = "invalid"
+}
+`
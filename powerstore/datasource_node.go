@@ -0,0 +1,152 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"context"
+	"net/url"
+	"terraform-provider-powerstore/client"
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &nodeDataSource{}
+	_ datasource.DataSourceWithConfigure = &nodeDataSource{}
+)
+
+// newNodeDataSource returns the node data source object
+func newNodeDataSource() datasource.DataSource {
+	return &nodeDataSource{}
+}
+
+// nodeDataSource is the data source implementation
+type nodeDataSource struct {
+	client *client.Client
+}
+
+// Metadata returns the data source type name
+func (d *nodeDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node"
+}
+
+// Schema defines the schema for the data source
+func (d *nodeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "This datasource is used to query the existing nodes from PowerStore array. The information fetched from this datasource can be used for getting the details for further processing in resource block.",
+		MarkdownDescription: "This datasource is used to query the existing nodes from PowerStore array. The information fetched from this datasource can be used for getting the details for further processing in resource block.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Unique identifier of the node. Conflicts with `appliance_id` and `filter_expression`.",
+				MarkdownDescription: "Unique identifier of the node. Conflicts with `appliance_id` and `filter_expression`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("appliance_id")),
+					stringvalidator.ConflictsWith(path.MatchRoot("filter_expression")),
+				},
+			},
+			"appliance_id": schema.StringAttribute{
+				Description:         "Unique identifier of the appliance whose nodes are to be fetched. Conflicts with `id` and `filter_expression`.",
+				MarkdownDescription: "Unique identifier of the appliance whose nodes are to be fetched. Conflicts with `id` and `filter_expression`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("filter_expression")),
+				},
+			},
+			"filter_expression": schema.StringAttribute{
+				Description:         "PowerStore filter expression to filter nodes by. Conflicts with `id` and `appliance_id`.",
+				MarkdownDescription: "PowerStore filter expression to filter nodes by. Conflicts with `id` and `appliance_id`.",
+				Optional:            true,
+				CustomType:          models.FilterExpressionType{},
+			},
+			"nodes": schema.ListNestedAttribute{
+				Description:         "List of nodes.",
+				MarkdownDescription: "List of nodes.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: NodeDatasourceSchema(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source
+func (d *nodeDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*client.Client)
+}
+
+// Read updates the Terraform state with the latest node data
+func (d *nodeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state models.NodeDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	queries := make(url.Values)
+	queries.Set("select", nodeDatasourceSelect)
+	// Read the nodes based on id/appliance/filter and if nothing is mentioned, then it returns all the nodes
+	dsreq := helper.DsReq[clientgen.NodeInstance, clientgen.ApiGetNodeByIdRequest, clientgen.ApiGetAllNodesRequest]{
+		Instance:   d.client.GenClient.NodeApi.GetNodeById,
+		Collection: d.client.GenClient.NodeApi.GetAllNodes,
+	}
+	if !state.ApplianceID.IsNull() {
+		queries.Set("appliance_id", "eq."+state.ApplianceID.ValueString())
+	} else if !state.Filters.IsNull() {
+		queries = helper.MergeValues(queries, state.Filters.ValueQueries())
+	}
+	nodes, err := dsreq.Execute(ctx, queries, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read PowerStore Nodes",
+			err.Error(),
+		)
+		return
+	}
+
+	// check that there is atleast one node if appliance is provided
+	if state.ApplianceID.ValueString() != "" && len(nodes) == 0 {
+		resp.Diagnostics.AddError(
+			"Unable to Read PowerStore Nodes",
+			"There is no node in appliance "+state.ApplianceID.ValueString(),
+		)
+		return
+	}
+
+	state.Nodes = updateNodeState(nodes)
+	state.ID = types.StringValue("placeholder")
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
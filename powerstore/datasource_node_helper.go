@@ -0,0 +1,43 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// nodeDatasourceSelect lists the node fields queried by the node datasource
+const nodeDatasourceSelect = "id,slot,appliance_id,appliance(name)"
+
+// updateNodeState iterates over the node list and update the state
+func updateNodeState(in []clientgen.NodeInstance) []models.NodeDataSource {
+	return helper.SliceTransform(in, func(in clientgen.NodeInstance) models.NodeDataSource {
+		return models.NodeDataSource{
+			ID:          helper.TfString(in.Id),
+			Slot:        helper.TfInt64(in.Slot),
+			ApplianceID: helper.TfString(in.ApplianceId),
+			ApplianceName: helper.TfObject(in.Appliance, func(in clientgen.ApplianceInstance) types.String {
+				return helper.TfString(in.Name)
+			}),
+		}
+	})
+}
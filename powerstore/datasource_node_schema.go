@@ -0,0 +1,48 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+// NodeDatasourceSchema is a function that returns the schema for node datasource
+func NodeDatasourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "Unique identifier of the node.",
+			MarkdownDescription: "Unique identifier of the node.",
+			Computed:            true,
+		},
+		"slot": schema.Int64Attribute{
+			Description:         "Slot number of the node.",
+			MarkdownDescription: "Slot number of the node.",
+			Computed:            true,
+		},
+		"appliance_id": schema.StringAttribute{
+			Description:         "Unique identifier of the appliance to which the node belongs.",
+			MarkdownDescription: "Unique identifier of the appliance to which the node belongs.",
+			Computed:            true,
+		},
+		"appliance_name": schema.StringAttribute{
+			Description:         "Name of the appliance to which the node belongs.",
+			MarkdownDescription: "Name of the appliance to which the node belongs.",
+			Computed:            true,
+		},
+	}
+}
@@ -0,0 +1,127 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Test to Fetch Nodes
+func TestAccNodeDs(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				// Get all Nodes
+				Config: ProviderConfigForTesting + NodeDataSourceParamsAll,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerstore_node.test", "nodes.0.id"),
+					resource.TestCheckResourceAttrSet("data.powerstore_node.test", "nodes.0.appliance_id"),
+				),
+			},
+			{
+				// Get Node by ID
+				Config: ProviderConfigForTesting + NodeDataSourceParamsAll + NodeDataSourceParamsID,
+				Check:  resource.TestCheckResourceAttr("data.powerstore_node.test1", "nodes.#", "1"),
+			},
+			{
+				// Get Nodes by appliance
+				Config: ProviderConfigForTesting + NodeDataSourceParamsAll + NodeDataSourceParamsApplianceID,
+				Check:  resource.TestCheckResourceAttrPair("data.powerstore_node.test1", "nodes.0.appliance_id", "data.powerstore_node.test", "nodes.0.appliance_id"),
+			},
+			{
+				// Get Nodes by filter expression
+				Config: ProviderConfigForTesting + NodeDataSourceParamsFilter,
+				Check:  resource.TestMatchResourceAttr("data.powerstore_node.test", "nodes.0.slot", regexp.MustCompile(`^[0-9]+$`)),
+			},
+			{
+				Config:      ProviderConfigForTesting + NodeDataSourceParamsIDNegative,
+				ExpectError: regexp.MustCompile("Unable to Read PowerStore Nodes"),
+			},
+			{
+				Config:      ProviderConfigForTesting + NodeDataSourceParamsFilterNegative,
+				ExpectError: regexp.MustCompile("Unable to Read PowerStore Nodes"),
+			},
+			{
+				Config:      ProviderConfigForTesting + NodeDataSourceParamsApplianceNegative,
+				ExpectError: regexp.MustCompile("There is no node in appliance"),
+			},
+			{
+				Config:      ProviderConfigForTesting + NodeDataSourceParamsIDAndApplianceNegative,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
+
+var NodeDataSourceParamsAll = `
+data "powerstore_node" "test" {
+}
+`
+
+var NodeDataSourceParamsID = `
+data "powerstore_node" "test1" {
+	id = data.powerstore_node.test.nodes[0].id
+}
+`
+
+var NodeDataSourceParamsApplianceID = `
+data "powerstore_node" "test1" {
+	appliance_id = data.powerstore_node.test.nodes[0].appliance_id
+}
+`
+
+var NodeDataSourceParamsFilter = `
+data "powerstore_node" "test" {
+	filter_expression = "slot=gte.0"
+}
+`
+
+var NodeDataSourceParamsIDNegative = `
+data "powerstore_node" "test" {
+	id = "invalid-id"
+}
+`
+
+var NodeDataSourceParamsFilterNegative = `
+data "powerstore_node" "test" {
+	filter_expression = "slot=inv.0"
+}
+`
+
+var NodeDataSourceParamsApplianceNegative = `
+data "powerstore_node" "test" {
+	appliance_id = "invalid-appliance"
+}
+`
+
+var NodeDataSourceParamsIDAndApplianceNegative = `
+data "powerstore_node" "test" {
+	id = "N1"
+	appliance_id = "A1"
+}
+`
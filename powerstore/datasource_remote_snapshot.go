@@ -0,0 +1,144 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"context"
+	"net/url"
+	"terraform-provider-powerstore/client"
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &remoteSnapshotDataSource{}
+	_ datasource.DataSourceWithConfigure = &remoteSnapshotDataSource{}
+)
+
+// newRemoteSnapshotDataSource returns the remote snapshot data source object
+func newRemoteSnapshotDataSource() datasource.DataSource {
+	return &remoteSnapshotDataSource{}
+}
+
+// remoteSnapshotDataSource is the data source implementation
+type remoteSnapshotDataSource struct {
+	client *clientgen.APIClient
+}
+
+// Metadata returns the data source type name
+func (d *remoteSnapshotDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_remote_snapshot"
+}
+
+// Schema defines the schema for the data source
+func (d *remoteSnapshotDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "This datasource is used to query the remote snapshots of a volume or a volume group from PowerStore array, such as the backups taken on a PowerProtect DD remote system. The information fetched from this datasource can be used for getting the details for further processing in resource block.",
+		MarkdownDescription: "This datasource is used to query the remote snapshots of a volume or a volume group from PowerStore array, such as the backups taken on a PowerProtect DD remote system. The information fetched from this datasource can be used for getting the details for further processing in resource block.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Placeholder identifier of the datasource.",
+				MarkdownDescription: "Placeholder identifier of the datasource.",
+				Computed:            true,
+			},
+			"volume_id": schema.StringAttribute{
+				Description:         "Unique identifier of the volume to fetch the remote snapshots of. Conflicts with `volume_group_id`.",
+				MarkdownDescription: "Unique identifier of the volume to fetch the remote snapshots of. Conflicts with `volume_group_id`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("volume_group_id")),
+				},
+			},
+			"volume_group_id": schema.StringAttribute{
+				Description:         "Unique identifier of the volume group to fetch the remote snapshots of. Conflicts with `volume_id`.",
+				MarkdownDescription: "Unique identifier of the volume group to fetch the remote snapshots of. Conflicts with `volume_id`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"remote_snapshots": schema.ListNestedAttribute{
+				Description:         "List of remote snapshots.",
+				MarkdownDescription: "List of remote snapshots.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: RemoteSnapshotDatasourceSchema(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source
+func (d *remoteSnapshotDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c := req.ProviderData.(*client.Client)
+	d.client = c.GenClient
+}
+
+// Read updates the Terraform state with the latest remote snapshot data
+func (d *remoteSnapshotDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state models.RemoteSnapshotDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// remote snapshots are only exposed through the volumes and volume groups they belong to
+	queries := make(url.Values)
+	queries.Set("select", remoteSnapshotDatasourceSelect)
+	var items []clientgen.RemoteSnapshotInstance
+	var err error
+	if !state.VolumeID.IsNull() {
+		var volume *clientgen.VolumeInstance
+		volume, _, err = d.client.VolumeApi.GetVolumeById(ctx, state.VolumeID.ValueString()).Queries(queries).Execute()
+		if err == nil {
+			items = volume.RemoteSnapshots
+		}
+	} else {
+		var volumeGroup *clientgen.VolumeGroupInstance
+		volumeGroup, _, err = d.client.VolumeGroupApi.GetVolumeGroupById(ctx, state.VolumeGroupID.ValueString()).Queries(queries).Execute()
+		if err == nil {
+			items = volumeGroup.RemoteSnapshots
+		}
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read PowerStore Remote Snapshots",
+			err.Error(),
+		)
+		return
+	}
+
+	state.RemoteSnapshots = updateRemoteSnapshotState(items)
+	state.ID = types.StringValue("placeholder")
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
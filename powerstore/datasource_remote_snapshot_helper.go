@@ -0,0 +1,58 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+)
+
+// remoteSnapshotDatasourceSelect lists the remote snapshot fields queried through the volume or volume group
+const remoteSnapshotDatasourceSelect = "id,remote_snapshots(id,name,remote_backup_identifier,volume_id,volume_group_id,is_source_in_recycle_bin,state,resource_type,remote_member_details,creation_timestamp,expiration_timestamp,remote_system_id,source_id,source_name,creator_type)"
+
+// updateRemoteSnapshotState iterates over the remote snapshot list and update the state
+func updateRemoteSnapshotState(in []clientgen.RemoteSnapshotInstance) []models.RemoteSnapshotDataSource {
+	return helper.SliceTransform(in, func(in clientgen.RemoteSnapshotInstance) models.RemoteSnapshotDataSource {
+		return models.RemoteSnapshotDataSource{
+			ID:                     helper.TfString(in.Id),
+			Name:                   helper.TfString(in.Name),
+			RemoteBackupIdentifier: helper.TfString(in.RemoteBackupIdentifier),
+			VolumeID:               helper.TfString(in.VolumeId),
+			VolumeGroupID:          helper.TfString(in.VolumeGroupId),
+			IsSourceInRecycleBin:   helper.TfBool(in.IsSourceInRecycleBin),
+			State:                  helper.TfString(in.State),
+			ResourceType:           helper.TfString(in.ResourceType),
+			RemoteMemberDetails: helper.SliceTransform(in.RemoteMemberDetails, func(in clientgen.RemoteMemberDetailsInstance) models.RemoteMemberDetails {
+				return models.RemoteMemberDetails{
+					VolumeID:             helper.TfString(in.VolumeId),
+					IsSourceInRecycleBin: helper.TfBool(in.IsSourceInRecycleBin),
+					RemoteIdentifier:     helper.TfString(in.RemoteIdentifier),
+					SnapshotName:         helper.TfString(in.SnapshotName),
+					SnapshotSize:         helper.TfInt64(in.SnapshotSize),
+				}
+			}),
+			CreationTimestamp:   helper.TfStringFromPTime(in.CreationTimestamp),
+			ExpirationTimestamp: helper.TfStringFromPTime(in.ExpirationTimestamp),
+			RemoteSystemID:      helper.TfString(in.RemoteSystemId),
+			SourceID:            helper.TfString(in.SourceId),
+			SourceName:          helper.TfString(in.SourceName),
+			CreatorType:         helper.TfString(in.CreatorType),
+		}
+	})
+}
@@ -0,0 +1,132 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+// RemoteSnapshotDatasourceSchema is a function that returns the schema for remote snapshot datasource
+func RemoteSnapshotDatasourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "Unique identifier of the remote snapshot.",
+			MarkdownDescription: "Unique identifier of the remote snapshot.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			Description:         "Name of the remote snapshot.",
+			MarkdownDescription: "Name of the remote snapshot.",
+			Computed:            true,
+		},
+		"remote_backup_identifier": schema.StringAttribute{
+			Description:         "Unique identifier of the snapshot generated by the remote system.",
+			MarkdownDescription: "Unique identifier of the snapshot generated by the remote system.",
+			Computed:            true,
+		},
+		"volume_id": schema.StringAttribute{
+			Description:         "Unique identifier of the volume of the snapshot, empty for a volume group snapshot or when the volume is deleted.",
+			MarkdownDescription: "Unique identifier of the volume of the snapshot, empty for a volume group snapshot or when the volume is deleted.",
+			Computed:            true,
+		},
+		"volume_group_id": schema.StringAttribute{
+			Description:         "Unique identifier of the volume group of the snapshot, empty for a volume snapshot or when the volume group is deleted.",
+			MarkdownDescription: "Unique identifier of the volume group of the snapshot, empty for a volume snapshot or when the volume group is deleted.",
+			Computed:            true,
+		},
+		"is_source_in_recycle_bin": schema.BoolAttribute{
+			Description:         "Whether the volume or volume group of the snapshot is in the recycle bin.",
+			MarkdownDescription: "Whether the volume or volume group of the snapshot is in the recycle bin.",
+			Computed:            true,
+		},
+		"state": schema.StringAttribute{
+			Description:         "State of the remote snapshot.",
+			MarkdownDescription: "State of the remote snapshot.",
+			Computed:            true,
+		},
+		"resource_type": schema.StringAttribute{
+			Description:         "Type of the resource of the snapshot, volume or volume_group.",
+			MarkdownDescription: "Type of the resource of the snapshot, volume or volume_group.",
+			Computed:            true,
+		},
+		"remote_member_details": schema.ListNestedAttribute{
+			Description:         "Member volume snapshots of a volume group snapshot.",
+			MarkdownDescription: "Member volume snapshots of a volume group snapshot.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"volume_id": schema.StringAttribute{
+						Description:         "Unique identifier of the member volume.",
+						MarkdownDescription: "Unique identifier of the member volume.",
+						Computed:            true,
+					},
+					"is_source_in_recycle_bin": schema.BoolAttribute{
+						Description:         "Whether the member volume is in the recycle bin.",
+						MarkdownDescription: "Whether the member volume is in the recycle bin.",
+						Computed:            true,
+					},
+					"remote_identifier": schema.StringAttribute{
+						Description:         "Remote backup identifier of the member snapshot.",
+						MarkdownDescription: "Remote backup identifier of the member snapshot.",
+						Computed:            true,
+					},
+					"snapshot_name": schema.StringAttribute{
+						Description:         "Name of the member snapshot.",
+						MarkdownDescription: "Name of the member snapshot.",
+						Computed:            true,
+					},
+					"snapshot_size": schema.Int64Attribute{
+						Description:         "Size of the member snapshot in bytes.",
+						MarkdownDescription: "Size of the member snapshot in bytes.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+		"creation_timestamp": schema.StringAttribute{
+			Description:         "Time the remote snapshot was taken.",
+			MarkdownDescription: "Time the remote snapshot was taken.",
+			Computed:            true,
+		},
+		"expiration_timestamp": schema.StringAttribute{
+			Description:         "Time the remote snapshot expires.",
+			MarkdownDescription: "Time the remote snapshot expires.",
+			Computed:            true,
+		},
+		"remote_system_id": schema.StringAttribute{
+			Description:         "Unique identifier of the remote system storing the snapshot.",
+			MarkdownDescription: "Unique identifier of the remote system storing the snapshot.",
+			Computed:            true,
+		},
+		"source_id": schema.StringAttribute{
+			Description:         "Unique identifier of the volume or volume group of the snapshot, kept after it is deleted.",
+			MarkdownDescription: "Unique identifier of the volume or volume group of the snapshot, kept after it is deleted.",
+			Computed:            true,
+		},
+		"source_name": schema.StringAttribute{
+			Description:         "Name of the volume or volume group of the snapshot, kept after it is deleted.",
+			MarkdownDescription: "Name of the volume or volume group of the snapshot, kept after it is deleted.",
+			Computed:            true,
+		},
+		"creator_type": schema.StringAttribute{
+			Description:         "Type of the system that created the snapshot.",
+			MarkdownDescription: "Type of the system that created the snapshot.",
+			Computed:            true,
+		},
+	}
+}
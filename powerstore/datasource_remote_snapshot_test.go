@@ -0,0 +1,85 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Test to Fetch Remote Snapshots
+func TestAccRemoteSnapshotDs(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				// Get Remote Snapshots of a volume
+				Config: ProviderConfigForTesting + VolumeParams + RemoteSnapshotDataSourceParamsVolume,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerstore_remote_snapshot.test", "remote_snapshots.#", "0"),
+				),
+			},
+			{
+				// Get Remote Snapshots of a volume group
+				Config: ProviderConfigForTesting + VolumeGroupParamsCreate + RemoteSnapshotDataSourceParamsVolumeGroup,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerstore_remote_snapshot.test", "remote_snapshots.#", "0"),
+				),
+			},
+			{
+				Config:      ProviderConfigForTesting + RemoteSnapshotDataSourceParamsVolumeNegative,
+				ExpectError: regexp.MustCompile("Unable to Read PowerStore Remote Snapshots"),
+			},
+			{
+				Config:      ProviderConfigForTesting + RemoteSnapshotDataSourceParamsNoSourceNegative,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
+
+var RemoteSnapshotDataSourceParamsVolume = `
+data "powerstore_remote_snapshot" "test" {
+	volume_id = powerstore_volume.volume_create_test.id
+}
+`
+
+var RemoteSnapshotDataSourceParamsVolumeGroup = `
+data "powerstore_remote_snapshot" "test" {
+	volume_group_id = powerstore_volumegroup.test.id
+}
+`
+
+var RemoteSnapshotDataSourceParamsVolumeNegative = `
+data "powerstore_remote_snapshot" "test" {
+	volume_id = "invalid-id"
+}
+`
+
+var RemoteSnapshotDataSourceParamsNoSourceNegative = `
+data "powerstore_remote_snapshot" "test" {
+}
+`
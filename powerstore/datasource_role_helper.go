@@ -0,0 +1,39 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+)
+
+// roleDatasourceSelect lists the role fields queried by the role datasource
+const roleDatasourceSelect = "id,name,is_built_in,description"
+
+// updateRoleState iterates over the role list and update the state
+func updateRoleState(in []clientgen.RoleInstance) []models.RoleDataSource {
+	return helper.SliceTransform(in, func(in clientgen.RoleInstance) models.RoleDataSource {
+		return models.RoleDataSource{
+			ID:          helper.TfString(in.Id),
+			Name:        helper.TfString(in.Name),
+			IsBuiltIn:   helper.TfBool(in.IsBuiltIn),
+			Description: helper.TfString(in.Description),
+		}
+	})
+}
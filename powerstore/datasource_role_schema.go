@@ -0,0 +1,48 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+// RoleDatasourceSchema is a function that returns the schema for role datasource
+func RoleDatasourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "Unique identifier of the role.",
+			MarkdownDescription: "Unique identifier of the role.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			Description:         "Name of the role.",
+			MarkdownDescription: "Name of the role.",
+			Computed:            true,
+		},
+		"is_built_in": schema.BoolAttribute{
+			Description:         "Indicates whether the role is built-in.",
+			MarkdownDescription: "Indicates whether the role is built-in.",
+			Computed:            true,
+		},
+		"description": schema.StringAttribute{
+			Description:         "Description of the role.",
+			MarkdownDescription: "Description of the role.",
+			Computed:            true,
+		},
+	}
+}
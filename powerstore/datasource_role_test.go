@@ -0,0 +1,117 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Test to Fetch Roles
+func TestAccRoleDs(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				// Get all Roles
+				Config: ProviderConfigForTesting + RoleDataSourceParamsAll,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerstore_role.test", "roles.0.id"),
+					resource.TestCheckResourceAttrSet("data.powerstore_role.test", "roles.0.name"),
+				),
+			},
+			{
+				// Get Role by ID
+				Config: ProviderConfigForTesting + RoleDataSourceParamsAll + RoleDataSourceParamsID,
+				Check:  resource.TestCheckResourceAttr("data.powerstore_role.test1", "roles.#", "1"),
+			},
+			{
+				// Get Roles by name
+				Config: ProviderConfigForTesting + RoleDataSourceParamsAll + RoleDataSourceParamsName,
+				Check:  resource.TestCheckResourceAttrPair("data.powerstore_role.test1", "roles.0.name", "data.powerstore_role.test", "roles.0.name"),
+			},
+			{
+				// Get Roles by filter expression
+				Config: ProviderConfigForTesting + RoleDataSourceParamsFilter,
+				Check:  resource.TestCheckResourceAttr("data.powerstore_role.test", "roles.0.is_built_in", "true"),
+			},
+			{
+				Config:      ProviderConfigForTesting + RoleDataSourceParamsIDNegative,
+				ExpectError: regexp.MustCompile("Unable to Read PowerStore Roles"),
+			},
+			{
+				Config:      ProviderConfigForTesting + RoleDataSourceParamsFilterNegative,
+				ExpectError: regexp.MustCompile("Unable to Read PowerStore Roles"),
+			},
+			{
+				Config:      ProviderConfigForTesting + RoleDataSourceParamsIDAndNameNegative,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
+
+var RoleDataSourceParamsAll = `
+data "powerstore_role" "test" {
+}
+`
+
+var RoleDataSourceParamsID = `
+data "powerstore_role" "test1" {
+	id = data.powerstore_role.test.roles[0].id
+}
+`
+
+var RoleDataSourceParamsName = `
+data "powerstore_role" "test1" {
+	name = data.powerstore_role.test.roles[0].name
+}
+`
+
+var RoleDataSourceParamsFilter = `
+data "powerstore_role" "test" {
+	filter_expression = "is_built_in=eq.true"
+}
+`
+
+var RoleDataSourceParamsIDNegative = `
+data "powerstore_role" "test" {
+	id = "invalid-id"
+}
+`
+
+var RoleDataSourceParamsFilterNegative = `
+data "powerstore_role" "test" {
+	filter_expression = "name=inv.invalid"
+}
+`
+
+var RoleDataSourceParamsIDAndNameNegative = `
+data "powerstore_role" "test" {
+	id = "invalid-id"
+	name = "invalid"
+}
+`
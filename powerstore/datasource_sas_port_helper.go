@@ -0,0 +1,48 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+)
+
+// sasPortDatasourceSelect lists the SAS port fields queried by the SAS port datasource
+const sasPortDatasourceSelect = "id,name,appliance_id,node_id,is_link_up,is_in_use,speed,sfp_id,io_module_id,hardware_parent_id,port_index,partner_id,stale_state"
+
+// updateSasPortState iterates over the SAS port list and update the state
+func updateSasPortState(in []clientgen.SasPortInstance) []models.SasPortDataSource {
+	return helper.SliceTransform(in, func(in clientgen.SasPortInstance) models.SasPortDataSource {
+		return models.SasPortDataSource{
+			ID:               helper.TfString(in.Id),
+			Name:             helper.TfString(in.Name),
+			ApplianceID:      helper.TfString(in.ApplianceId),
+			NodeID:           helper.TfString(in.NodeId),
+			IsLinkUp:         helper.TfBool(in.IsLinkUp),
+			IsInUse:          helper.TfBool(in.IsInUse),
+			Speed:            helper.TfString(in.Speed),
+			SFPID:            helper.TfString(in.SfpId),
+			IoModuleID:       helper.TfString(in.IoModuleId),
+			HardwareParentID: helper.TfString(in.HardwareParentId),
+			PortIndex:        helper.TfInt64(in.PortIndex),
+			PartnerID:        helper.TfString(in.PartnerId),
+			StaleState:       helper.TfString(in.StaleState),
+		}
+	})
+}
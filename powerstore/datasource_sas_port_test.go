@@ -0,0 +1,117 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Test to Fetch SAS Ports
+func TestAccSasPortDs(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				// Get all SAS Ports
+				Config: ProviderConfigForTesting + SasPortDataSourceParamsAll,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerstore_sas_port.test", "sas_ports.0.id"),
+					resource.TestCheckResourceAttrSet("data.powerstore_sas_port.test", "sas_ports.0.name"),
+				),
+			},
+			{
+				// Get SAS Port by ID
+				Config: ProviderConfigForTesting + SasPortDataSourceParamsAll + SasPortDataSourceParamsID,
+				Check:  resource.TestCheckResourceAttr("data.powerstore_sas_port.test1", "sas_ports.#", "1"),
+			},
+			{
+				// Get SAS Ports by name
+				Config: ProviderConfigForTesting + SasPortDataSourceParamsAll + SasPortDataSourceParamsName,
+				Check:  resource.TestCheckResourceAttrPair("data.powerstore_sas_port.test1", "sas_ports.0.name", "data.powerstore_sas_port.test", "sas_ports.0.name"),
+			},
+			{
+				// Get SAS Ports by filter expression
+				Config: ProviderConfigForTesting + SasPortDataSourceParamsFilter,
+				Check:  resource.TestCheckResourceAttr("data.powerstore_sas_port.test", "sas_ports.0.is_link_up", "true"),
+			},
+			{
+				Config:      ProviderConfigForTesting + SasPortDataSourceParamsIDNegative,
+				ExpectError: regexp.MustCompile("Unable to Read PowerStore SAS Ports"),
+			},
+			{
+				Config:      ProviderConfigForTesting + SasPortDataSourceParamsFilterNegative,
+				ExpectError: regexp.MustCompile("Unable to Read PowerStore SAS Ports"),
+			},
+			{
+				Config:      ProviderConfigForTesting + SasPortDataSourceParamsIDAndNameNegative,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
+
+var SasPortDataSourceParamsAll = `
+data "powerstore_sas_port" "test" {
+}
+`
+
+var SasPortDataSourceParamsID = `
+data "powerstore_sas_port" "test1" {
+	id = data.powerstore_sas_port.test.sas_ports[0].id
+}
+`
+
+var SasPortDataSourceParamsName = `
+data "powerstore_sas_port" "test1" {
+	name = data.powerstore_sas_port.test.sas_ports[0].name
+}
+`
+
+var SasPortDataSourceParamsFilter = `
+data "powerstore_sas_port" "test" {
+	filter_expression = "is_link_up=eq.true"
+}
+`
+
+var SasPortDataSourceParamsIDNegative = `
+data "powerstore_sas_port" "test" {
+	id = "invalid-id"
+}
+`
+
+var SasPortDataSourceParamsFilterNegative = `
+data "powerstore_sas_port" "test" {
+	filter_expression = "name=inv.invalid"
+}
+`
+
+var SasPortDataSourceParamsIDAndNameNegative = `
+data "powerstore_sas_port" "test" {
+	id = "invalid-id"
+	name = "invalid"
+}
+`
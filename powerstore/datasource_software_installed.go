@@ -0,0 +1,144 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"context"
+	"net/url"
+	"terraform-provider-powerstore/client"
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &softwareInstalledDataSource{}
+	_ datasource.DataSourceWithConfigure = &softwareInstalledDataSource{}
+)
+
+// newSoftwareInstalledDataSource returns the installed software data source object
+func newSoftwareInstalledDataSource() datasource.DataSource {
+	return &softwareInstalledDataSource{}
+}
+
+// softwareInstalledDataSource is the data source implementation
+type softwareInstalledDataSource struct {
+	client *clientgen.APIClient
+}
+
+// Metadata returns the data source type name
+func (d *softwareInstalledDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_software_installed"
+}
+
+// Schema defines the schema for the data source
+func (d *softwareInstalledDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "This datasource is used to query the existing installed software from PowerStore array. The information fetched from this datasource can be used for getting the details for further processing in resource block.",
+		MarkdownDescription: "This datasource is used to query the existing installed software from PowerStore array. The information fetched from this datasource can be used for getting the details for further processing in resource block.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Unique identifier of the installed software. Conflicts with `release_version` and `filter_expression`.",
+				MarkdownDescription: "Unique identifier of the installed software. Conflicts with `release_version` and `filter_expression`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("release_version")),
+					stringvalidator.ConflictsWith(path.MatchRoot("filter_expression")),
+				},
+			},
+			"release_version": schema.StringAttribute{
+				Description:         "Release version of the installed software. Conflicts with `id` and `filter_expression`.",
+				MarkdownDescription: "Release version of the installed software. Conflicts with `id` and `filter_expression`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("filter_expression")),
+				},
+			},
+			"filter_expression": schema.StringAttribute{
+				Description:         "PowerStore filter expression to filter installed software by. Conflicts with `id` and `release_version`.",
+				MarkdownDescription: "PowerStore filter expression to filter installed software by. Conflicts with `id` and `release_version`.",
+				Optional:            true,
+				CustomType:          models.FilterExpressionType{},
+			},
+			"software_installed": schema.ListNestedAttribute{
+				Description:         "List of installed software.",
+				MarkdownDescription: "List of installed software.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: SoftwareInstalledDatasourceSchema(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source
+func (d *softwareInstalledDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c := req.ProviderData.(*client.Client)
+	d.client = c.GenClient
+}
+
+// Read updates the Terraform state with the latest installed software data
+func (d *softwareInstalledDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state models.SoftwareInstalledDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	queries := make(url.Values)
+	queries.Set("select", softwareInstalledDatasourceSelect)
+	// Read the installed software based on id/release version/filter and if nothing is mentioned, then it returns all the installed software
+	dsreq := helper.DsReq[clientgen.SoftwareInstalledInstance, clientgen.ApiGetSoftwareInstalledByIdRequest, clientgen.ApiGetAllSoftwareInstalledsRequest]{
+		Instance:   d.client.SoftwareInstalledApi.GetSoftwareInstalledById,
+		Collection: d.client.SoftwareInstalledApi.GetAllSoftwareInstalleds,
+	}
+	if !state.ReleaseVersion.IsNull() {
+		queries.Set("release_version", "eq."+state.ReleaseVersion.ValueString())
+	} else if !state.Filters.IsNull() {
+		queries = helper.MergeValues(queries, state.Filters.ValueQueries())
+	}
+	items, err := dsreq.Execute(ctx, queries, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read PowerStore Software Installed",
+			err.Error(),
+		)
+		return
+	}
+
+	state.SoftwareInstalled = updateSoftwareInstalledState(items)
+	state.ID = types.StringValue("placeholder")
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
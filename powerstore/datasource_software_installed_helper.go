@@ -0,0 +1,56 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// softwareInstalledAppliance returns the given attribute of the appliance the software is installed on
+func softwareInstalledAppliance(in *clientgen.ApplianceInstance, attr func(clientgen.ApplianceInstance) *string) types.String {
+	if in == nil {
+		return types.StringNull()
+	}
+	return helper.TfString(attr(*in))
+}
+
+// softwareInstalledDatasourceSelect lists the installed software fields queried by the installed software datasource
+const softwareInstalledDatasourceSelect = "id,is_cluster,release_version,build_version,release_timestamp,installed_date,build_flavor,build_type,build_id,appliance(id,name)"
+
+// updateSoftwareInstalledState iterates over the installed software list and update the state
+func updateSoftwareInstalledState(in []clientgen.SoftwareInstalledInstance) []models.SoftwareInstalledDataSource {
+	return helper.SliceTransform(in, func(in clientgen.SoftwareInstalledInstance) models.SoftwareInstalledDataSource {
+		return models.SoftwareInstalledDataSource{
+			ID:               helper.TfString(in.Id),
+			IsCluster:        helper.TfBool(in.IsCluster),
+			ReleaseVersion:   helper.TfString(in.ReleaseVersion),
+			BuildVersion:     helper.TfString(in.BuildVersion),
+			ReleaseTimestamp: helper.TfStringFromPTime(in.ReleaseTimestamp),
+			InstalledDate:    helper.TfStringFromPTime(in.InstalledDate),
+			BuildFlavor:      helper.TfString(in.BuildFlavor),
+			BuildType:        helper.TfString(in.BuildType),
+			BuildID:          helper.TfString(in.BuildId),
+			ApplianceID:      softwareInstalledAppliance(in.Appliance, func(a clientgen.ApplianceInstance) *string { return a.Id }),
+			ApplianceName:    softwareInstalledAppliance(in.Appliance, func(a clientgen.ApplianceInstance) *string { return a.Name }),
+		}
+	})
+}
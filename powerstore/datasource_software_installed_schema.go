@@ -0,0 +1,83 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+// SoftwareInstalledDatasourceSchema is a function that returns the schema for installed software datasource
+func SoftwareInstalledDatasourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "Unique identifier of the installed software instance.",
+			MarkdownDescription: "Unique identifier of the installed software instance.",
+			Computed:            true,
+		},
+		"is_cluster": schema.BoolAttribute{
+			Description:         "Whether this entry represents the common software release version supported on all appliances in the cluster, rather than the software of a single appliance.",
+			MarkdownDescription: "Whether this entry represents the common software release version supported on all appliances in the cluster, rather than the software of a single appliance.",
+			Computed:            true,
+		},
+		"release_version": schema.StringAttribute{
+			Description:         "Version of the installed software package release.",
+			MarkdownDescription: "Version of the installed software package release.",
+			Computed:            true,
+		},
+		"build_version": schema.StringAttribute{
+			Description:         "Build version of the installed software package release.",
+			MarkdownDescription: "Build version of the installed software package release.",
+			Computed:            true,
+		},
+		"release_timestamp": schema.StringAttribute{
+			Description:         "Date and time when the software package was produced.",
+			MarkdownDescription: "Date and time when the software package was produced.",
+			Computed:            true,
+		},
+		"installed_date": schema.StringAttribute{
+			Description:         "Date and time when the software was successfully installed and committed on the cluster.",
+			MarkdownDescription: "Date and time when the software was successfully installed and committed on the cluster.",
+			Computed:            true,
+		},
+		"build_flavor": schema.StringAttribute{
+			Description:         "Flavor of the installed build.",
+			MarkdownDescription: "Flavor of the installed build.",
+			Computed:            true,
+		},
+		"build_type": schema.StringAttribute{
+			Description:         "Type of the installed build.",
+			MarkdownDescription: "Type of the installed build.",
+			Computed:            true,
+		},
+		"build_id": schema.StringAttribute{
+			Description:         "Unique identifier of the installed build.",
+			MarkdownDescription: "Unique identifier of the installed build.",
+			Computed:            true,
+		},
+		"appliance_id": schema.StringAttribute{
+			Description:         "Unique identifier of the appliance the software is installed on. Empty for the cluster entry.",
+			MarkdownDescription: "Unique identifier of the appliance the software is installed on. Empty for the cluster entry.",
+			Computed:            true,
+		},
+		"appliance_name": schema.StringAttribute{
+			Description:         "Name of the appliance the software is installed on. Empty for the cluster entry.",
+			MarkdownDescription: "Name of the appliance the software is installed on. Empty for the cluster entry.",
+			Computed:            true,
+		},
+	}
+}
@@ -0,0 +1,117 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Test to Fetch Software Installed
+func TestAccSoftwareInstalledDs(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				// Get all Software Installed
+				Config: ProviderConfigForTesting + SoftwareInstalledDataSourceParamsAll,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerstore_software_installed.test", "software_installed.0.id"),
+					resource.TestCheckResourceAttrSet("data.powerstore_software_installed.test", "software_installed.0.release_version"),
+				),
+			},
+			{
+				// Get Software Installed by ID
+				Config: ProviderConfigForTesting + SoftwareInstalledDataSourceParamsAll + SoftwareInstalledDataSourceParamsID,
+				Check:  resource.TestCheckResourceAttr("data.powerstore_software_installed.test1", "software_installed.#", "1"),
+			},
+			{
+				// Get Software Installed by release version
+				Config: ProviderConfigForTesting + SoftwareInstalledDataSourceParamsAll + SoftwareInstalledDataSourceParamsReleaseVersion,
+				Check:  resource.TestCheckResourceAttrPair("data.powerstore_software_installed.test1", "software_installed.0.release_version", "data.powerstore_software_installed.test", "software_installed.0.release_version"),
+			},
+			{
+				// Get Software Installed by filter expression
+				Config: ProviderConfigForTesting + SoftwareInstalledDataSourceParamsFilter,
+				Check:  resource.TestCheckResourceAttr("data.powerstore_software_installed.test", "software_installed.0.is_cluster", "true"),
+			},
+			{
+				Config:      ProviderConfigForTesting + SoftwareInstalledDataSourceParamsIDNegative,
+				ExpectError: regexp.MustCompile("Unable to Read PowerStore Software Installed"),
+			},
+			{
+				Config:      ProviderConfigForTesting + SoftwareInstalledDataSourceParamsFilterNegative,
+				ExpectError: regexp.MustCompile("Unable to Read PowerStore Software Installed"),
+			},
+			{
+				Config:      ProviderConfigForTesting + SoftwareInstalledDataSourceParamsIDAndReleaseVersionNegative,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
+
+var SoftwareInstalledDataSourceParamsAll = `
+data "powerstore_software_installed" "test" {
+}
+`
+
+var SoftwareInstalledDataSourceParamsID = `
+data "powerstore_software_installed" "test1" {
+	id = data.powerstore_software_installed.test.software_installed[0].id
+}
+`
+
+var SoftwareInstalledDataSourceParamsReleaseVersion = `
+data "powerstore_software_installed" "test1" {
+	release_version = data.powerstore_software_installed.test.software_installed[0].release_version
+}
+`
+
+var SoftwareInstalledDataSourceParamsFilter = `
+data "powerstore_software_installed" "test" {
+	filter_expression = "is_cluster=eq.true"
+}
+`
+
+var SoftwareInstalledDataSourceParamsIDNegative = `
+data "powerstore_software_installed" "test" {
+	id = "invalid-id"
+}
+`
+
+var SoftwareInstalledDataSourceParamsFilterNegative = `
+data "powerstore_software_installed" "test" {
+	filter_expression = "name=inv.invalid"
+}
+`
+
+var SoftwareInstalledDataSourceParamsIDAndReleaseVersionNegative = `
+data "powerstore_software_installed" "test" {
+	id = "invalid-id"
+	release_version = "invalid"
+}
+`
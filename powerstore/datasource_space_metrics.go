@@ -0,0 +1,198 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"terraform-provider-powerstore/client"
+	"terraform-provider-powerstore/models"
+	"time"
+
+	"github.com/dell/gopowerstore"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &spaceMetricsDataSource{}
+	_ datasource.DataSourceWithConfigure = &spaceMetricsDataSource{}
+)
+
+// newSpaceMetricsDataSource returns the space metrics data source object
+func newSpaceMetricsDataSource() datasource.DataSource {
+	return &spaceMetricsDataSource{}
+}
+
+// spaceMetricsDataSource is the data source implementation
+type spaceMetricsDataSource struct {
+	client *client.Client
+}
+
+// Metadata returns the data source type name
+func (d *spaceMetricsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_space_metrics"
+}
+
+// Schema defines the schema for the data source
+func (d *spaceMetricsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "This datasource is used to query the space and capacity metrics of an entity from PowerStore array. The information fetched from this datasource can be used for capacity planning.",
+		MarkdownDescription: "This datasource is used to query the space and capacity metrics of an entity from PowerStore array. The information fetched from this datasource can be used for capacity planning.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Placeholder identifier of the space metrics datasource.",
+				MarkdownDescription: "Placeholder identifier of the space metrics datasource.",
+				Computed:            true,
+			},
+			"entity_type": schema.StringAttribute{
+				Description:         "Type of the entity whose space metrics are fetched. Accepted values are `cluster`, `appliance`, `volume`, `volume_group` and `file_system`.",
+				MarkdownDescription: "Type of the entity whose space metrics are fetched. Accepted values are `cluster`, `appliance`, `volume`, `volume_group` and `file_system`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(slices.Sorted(maps.Keys(spaceMetricsEntities))...),
+				},
+			},
+			"entity_id": schema.StringAttribute{
+				Description:         "Unique identifier of the entity whose space metrics are fetched.",
+				MarkdownDescription: "Unique identifier of the entity whose space metrics are fetched.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"timestamp": schema.StringAttribute{
+				Description:         "Time of the latest space metrics sample.",
+				MarkdownDescription: "Time of the latest space metrics sample.",
+				Computed:            true,
+			},
+			"logical_provisioned": schema.Int64Attribute{
+				Description:         "Logical space provisioned in bytes.",
+				MarkdownDescription: "Logical space provisioned in bytes.",
+				Computed:            true,
+			},
+			"logical_used": schema.Int64Attribute{
+				Description:         "Logical space used in bytes.",
+				MarkdownDescription: "Logical space used in bytes.",
+				Computed:            true,
+			},
+			"physical_total": schema.Int64Attribute{
+				Description:         "Total physical space in bytes. Only reported for clusters and appliances.",
+				MarkdownDescription: "Total physical space in bytes. Only reported for clusters and appliances.",
+				Computed:            true,
+			},
+			"physical_used": schema.Int64Attribute{
+				Description:         "Physical space used in bytes. Only reported for clusters, appliances and volume groups, for volume groups it is the physical space unique to the group.",
+				MarkdownDescription: "Physical space used in bytes. Only reported for clusters, appliances and volume groups, for volume groups it is the physical space unique to the group.",
+				Computed:            true,
+			},
+			"data_reduction": schema.Float64Attribute{
+				Description:         "Data reduction ratio. Not reported for volumes.",
+				MarkdownDescription: "Data reduction ratio. Not reported for volumes.",
+				Computed:            true,
+			},
+			"efficiency_ratio": schema.Float64Attribute{
+				Description:         "Efficiency ratio. Only reported for clusters and appliances.",
+				MarkdownDescription: "Efficiency ratio. Only reported for clusters and appliances.",
+				Computed:            true,
+			},
+			"snapshot_savings": schema.Float64Attribute{
+				Description:         "Snapshot savings ratio. Only reported for clusters, appliances and volume groups.",
+				MarkdownDescription: "Snapshot savings ratio. Only reported for clusters, appliances and volume groups.",
+				Computed:            true,
+			},
+			"thin_savings": schema.Float64Attribute{
+				Description:         "Thin provisioning savings ratio.",
+				MarkdownDescription: "Thin provisioning savings ratio.",
+				Computed:            true,
+			},
+			"logical_used_growth_last_day": schema.Int64Attribute{
+				Description:         "Growth in bytes of the logical space used over the last day. Null if the entity has no space metrics that old.",
+				MarkdownDescription: "Growth in bytes of the logical space used over the last day. Null if the entity has no space metrics that old.",
+				Computed:            true,
+			},
+			"logical_used_growth_last_week": schema.Int64Attribute{
+				Description:         "Growth in bytes of the logical space used over the last week. Null if the entity has no space metrics that old.",
+				MarkdownDescription: "Growth in bytes of the logical space used over the last week. Null if the entity has no space metrics that old.",
+				Computed:            true,
+			},
+			"physical_used_growth_last_day": schema.Int64Attribute{
+				Description:         "Growth in bytes of the physical space used over the last day. Null if the entity does not report physical space used or has no space metrics that old.",
+				MarkdownDescription: "Growth in bytes of the physical space used over the last day. Null if the entity does not report physical space used or has no space metrics that old.",
+				Computed:            true,
+			},
+			"physical_used_growth_last_week": schema.Int64Attribute{
+				Description:         "Growth in bytes of the physical space used over the last week. Null if the entity does not report physical space used or has no space metrics that old.",
+				MarkdownDescription: "Growth in bytes of the physical space used over the last week. Null if the entity does not report physical space used or has no space metrics that old.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source
+func (d *spaceMetricsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*client.Client)
+}
+
+// Read updates the Terraform state with the latest space metrics
+func (d *spaceMetricsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state models.SpaceMetricsDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entity, entityID := spaceMetricsEntities[state.EntityType.ValueString()], state.EntityID.ValueString()
+	// the latest raw sample gives the current values, hourly and daily rollups give the values to compute growth from
+	samples := make(map[gopowerstore.MetricsIntervalEnum][]client.SpaceMetrics)
+	for _, interval := range []gopowerstore.MetricsIntervalEnum{gopowerstore.FiveMins, gopowerstore.OneHour, gopowerstore.OneDay} {
+		metrics, err := d.client.GetSpaceMetrics(ctx, entity, entityID, string(interval))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read PowerStore Space Metrics",
+				"Could not read "+string(interval)+" space metrics of "+state.EntityType.ValueString()+" "+entityID+" with error "+err.Error(),
+			)
+			return
+		}
+		samples[interval] = metrics
+	}
+
+	// the space metrics are left null if the entity has not reported any sample yet
+	if latest := samples[gopowerstore.FiveMins]; len(latest) > 0 && latest[len(latest)-1].Timestamp != nil {
+		current := latest[len(latest)-1]
+		updateSpaceMetricsState(&state, current)
+		dayAgo, weekAgo := current.Timestamp.Add(-24*time.Hour), current.Timestamp.Add(-7*24*time.Hour)
+		state.LogicalUsedGrowthLastDay = spaceGrowth(logicalUsed(current), samples[gopowerstore.OneHour], dayAgo, logicalUsed)
+		state.LogicalUsedGrowthLastWeek = spaceGrowth(logicalUsed(current), samples[gopowerstore.OneDay], weekAgo, logicalUsed)
+		state.PhysicalUsedGrowthLastDay = spaceGrowth(physicalUsed(current), samples[gopowerstore.OneHour], dayAgo, physicalUsed)
+		state.PhysicalUsedGrowthLastWeek = spaceGrowth(physicalUsed(current), samples[gopowerstore.OneDay], weekAgo, physicalUsed)
+	}
+	state.ID = types.StringValue("placeholder")
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,77 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"terraform-provider-powerstore/client"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// spaceMetricsEntities maps the entity types of the space metrics datasource to PowerStore space metrics entities
+var spaceMetricsEntities = map[string]string{
+	"cluster":      "space_metrics_by_cluster",
+	"appliance":    "space_metrics_by_appliance",
+	"volume":       "space_metrics_by_volume",
+	"volume_group": "space_metrics_by_vg",
+	"file_system":  "space_metrics_by_file_system",
+}
+
+// logicalUsed returns the logical space used reported by a space metrics sample
+func logicalUsed(in client.SpaceMetrics) *int64 {
+	return firstReported(in.LastLogicalUsed, in.LogicalUsed)
+}
+
+// physicalUsed returns the physical space used reported by a space metrics sample
+// Volume groups only report the physical space that is unique to them
+func physicalUsed(in client.SpaceMetrics) *int64 {
+	return firstReported(in.LastPhysicalUsed, in.PhysicalUsed, in.LastUniquePhysicalUsed, in.UniquePhysicalUsed)
+}
+
+// updateSpaceMetricsState fills the terraform state from the latest space metrics sample
+func updateSpaceMetricsState(state *models.SpaceMetricsDataSourceModel, in client.SpaceMetrics) {
+	state.Timestamp = helper.TfStringFromPTime(in.Timestamp)
+	state.LogicalProvisioned = helper.TfInt64(in.LogicalProvisioned)
+	state.LogicalUsed = helper.TfInt64(logicalUsed(in))
+	state.PhysicalTotal = helper.TfInt64(in.PhysicalTotal)
+	state.PhysicalUsed = helper.TfInt64(physicalUsed(in))
+	state.DataReduction = helper.TfFloat64(in.DataReduction)
+	state.EfficiencyRatio = helper.TfFloat64(in.EfficiencyRatio)
+	state.SnapshotSavings = helper.TfFloat64(in.SnapshotSavings)
+	state.ThinSavings = helper.TfFloat64(in.ThinSavings)
+}
+
+// spaceGrowth returns how much a space counter grew since the given time
+// The base value is taken from the most recent rollup sample at or before that time, samples are ordered by timestamp
+// It returns null if the entity has no sample that old
+func spaceGrowth(current *int64, samples []client.SpaceMetrics, since time.Time, value func(client.SpaceMetrics) *int64) types.Int64 {
+	var base *int64
+	for _, sample := range samples {
+		if sample.Timestamp == nil || sample.Timestamp.After(since) {
+			break
+		}
+		base = value(sample)
+	}
+	if current == nil || base == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(*current - *base)
+}
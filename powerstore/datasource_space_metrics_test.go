@@ -0,0 +1,101 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Test to Fetch Space Metrics
+func TestAccSpaceMetricsDs(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				// Get cluster space metrics
+				Config: ProviderConfigForTesting + SpaceMetricsDataSourceParamsCluster,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerstore_space_metrics.test", "timestamp"),
+					resource.TestCheckResourceAttrSet("data.powerstore_space_metrics.test", "logical_used"),
+					resource.TestCheckResourceAttrSet("data.powerstore_space_metrics.test", "physical_used"),
+					resource.TestCheckResourceAttrSet("data.powerstore_space_metrics.test", "data_reduction"),
+					resource.TestCheckResourceAttrSet("data.powerstore_space_metrics.test", "efficiency_ratio"),
+				),
+			},
+			{
+				// Get appliance space metrics
+				Config: ProviderConfigForTesting + SpaceMetricsDataSourceParamsAppliance,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerstore_space_metrics.test", "logical_provisioned"),
+					resource.TestCheckResourceAttrSet("data.powerstore_space_metrics.test", "physical_total"),
+				),
+			},
+			{
+				Config:      ProviderConfigForTesting + SpaceMetricsDataSourceParamsEntityTypeNegative,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+			{
+				Config:      ProviderConfigForTesting + SpaceMetricsDataSourceParamsEntityIDNegative,
+				ExpectError: regexp.MustCompile("Unable to Read PowerStore Space Metrics"),
+			},
+		},
+	})
+}
+
+var SpaceMetricsDataSourceParamsCluster = `
+data "powerstore_cluster" "test" {
+}
+
+data "powerstore_space_metrics" "test" {
+	entity_type = "cluster"
+	entity_id = data.powerstore_cluster.test.clusters[0].id
+}
+`
+
+var SpaceMetricsDataSourceParamsAppliance = `
+data "powerstore_appliance" "test" {
+}
+
+data "powerstore_space_metrics" "test" {
+	entity_type = "appliance"
+	entity_id = data.powerstore_appliance.test.appliances[0].id
+}
+`
+
+var SpaceMetricsDataSourceParamsEntityTypeNegative = `
+data "powerstore_space_metrics" "test" {
+	entity_type = "node"
+	entity_id = "N1"
+}
+`
+
+var SpaceMetricsDataSourceParamsEntityIDNegative = `
+data "powerstore_space_metrics" "test" {
+	entity_type = "volume"
+	entity_id = "invalid-id"
+}
+`
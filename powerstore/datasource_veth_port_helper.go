@@ -0,0 +1,48 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+)
+
+// vethPortDatasourceSelect lists the virtual Ethernet port fields queried by the virtual Ethernet port datasource
+const vethPortDatasourceSelect = "id,name,partner_id,appliance_id,node_id,mac_address,is_link_up,current_speed,current_mtu,vswitch_name,vswitch_port_group_name,vswitch_port_id,vswitch_port_name"
+
+// updateVethPortState iterates over the virtual Ethernet port list and update the state
+func updateVethPortState(in []clientgen.VethPortInstance) []models.VethPortDataSource {
+	return helper.SliceTransform(in, func(in clientgen.VethPortInstance) models.VethPortDataSource {
+		return models.VethPortDataSource{
+			ID:                   helper.TfString(in.Id),
+			Name:                 helper.TfString(in.Name),
+			ApplianceID:          helper.TfString(in.ApplianceId),
+			NodeID:               helper.TfString(in.NodeId),
+			PartnerID:            helper.TfString(in.PartnerId),
+			MACAddress:           helper.TfString(in.MacAddress),
+			IsLinkUp:             helper.TfBool(in.IsLinkUp),
+			CurrentSpeed:         helper.TfInt64(in.CurrentSpeed),
+			CurrentMTU:           helper.TfInt64(in.CurrentMtu),
+			VswitchName:          helper.TfString(in.VswitchName),
+			VswitchPortGroupName: helper.TfString(in.VswitchPortGroupName),
+			VswitchPortID:        helper.TfInt64(in.VswitchPortId),
+			VswitchPortName:      helper.TfString(in.VswitchPortName),
+		}
+	})
+}
@@ -0,0 +1,93 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+// VethPortDatasourceSchema is a function that returns the schema for virtual Ethernet port datasource
+func VethPortDatasourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "Unique identifier of the virtual Ethernet port.",
+			MarkdownDescription: "Unique identifier of the virtual Ethernet port.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			Description:         "Name of the virtual Ethernet port.",
+			MarkdownDescription: "Name of the virtual Ethernet port.",
+			Computed:            true,
+		},
+		"appliance_id": schema.StringAttribute{
+			Description:         "Unique identifier of the appliance containing the port.",
+			MarkdownDescription: "Unique identifier of the appliance containing the port.",
+			Computed:            true,
+		},
+		"node_id": schema.StringAttribute{
+			Description:         "Unique identifier of the node containing the port.",
+			MarkdownDescription: "Unique identifier of the node containing the port.",
+			Computed:            true,
+		},
+		"partner_id": schema.StringAttribute{
+			Description:         "Unique identifier of the partner port on the peer node.",
+			MarkdownDescription: "Unique identifier of the partner port on the peer node.",
+			Computed:            true,
+		},
+		"mac_address": schema.StringAttribute{
+			Description:         "MAC address of the port.",
+			MarkdownDescription: "MAC address of the port.",
+			Computed:            true,
+		},
+		"is_link_up": schema.BoolAttribute{
+			Description:         "Indicates whether the port link is up.",
+			MarkdownDescription: "Indicates whether the port link is up.",
+			Computed:            true,
+		},
+		"current_speed": schema.Int64Attribute{
+			Description:         "Current speed of the port in bits per second.",
+			MarkdownDescription: "Current speed of the port in bits per second.",
+			Computed:            true,
+		},
+		"current_mtu": schema.Int64Attribute{
+			Description:         "Current MTU of the port.",
+			MarkdownDescription: "Current MTU of the port.",
+			Computed:            true,
+		},
+		"vswitch_name": schema.StringAttribute{
+			Description:         "Name of the virtual switch the port is connected to.",
+			MarkdownDescription: "Name of the virtual switch the port is connected to.",
+			Computed:            true,
+		},
+		"vswitch_port_group_name": schema.StringAttribute{
+			Description:         "Name of the virtual switch port group.",
+			MarkdownDescription: "Name of the virtual switch port group.",
+			Computed:            true,
+		},
+		"vswitch_port_id": schema.Int64Attribute{
+			Description:         "Identifier of the virtual switch port.",
+			MarkdownDescription: "Identifier of the virtual switch port.",
+			Computed:            true,
+		},
+		"vswitch_port_name": schema.StringAttribute{
+			Description:         "Name of the virtual switch port.",
+			MarkdownDescription: "Name of the virtual switch port.",
+			Computed:            true,
+		},
+	}
+}
@@ -0,0 +1,117 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Test to Fetch Virtual Ethernet Ports
+func TestAccVethPortDs(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				// Get all Virtual Ethernet Ports
+				Config: ProviderConfigForTesting + VethPortDataSourceParamsAll,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerstore_veth_port.test", "veth_ports.0.id"),
+					resource.TestCheckResourceAttrSet("data.powerstore_veth_port.test", "veth_ports.0.name"),
+				),
+			},
+			{
+				// Get Virtual Ethernet Port by ID
+				Config: ProviderConfigForTesting + VethPortDataSourceParamsAll + VethPortDataSourceParamsID,
+				Check:  resource.TestCheckResourceAttr("data.powerstore_veth_port.test1", "veth_ports.#", "1"),
+			},
+			{
+				// Get Virtual Ethernet Ports by name
+				Config: ProviderConfigForTesting + VethPortDataSourceParamsAll + VethPortDataSourceParamsName,
+				Check:  resource.TestCheckResourceAttrPair("data.powerstore_veth_port.test1", "veth_ports.0.name", "data.powerstore_veth_port.test", "veth_ports.0.name"),
+			},
+			{
+				// Get Virtual Ethernet Ports by filter expression
+				Config: ProviderConfigForTesting + VethPortDataSourceParamsFilter,
+				Check:  resource.TestCheckResourceAttr("data.powerstore_veth_port.test", "veth_ports.0.is_link_up", "true"),
+			},
+			{
+				Config:      ProviderConfigForTesting + VethPortDataSourceParamsIDNegative,
+				ExpectError: regexp.MustCompile("Unable to Read PowerStore Virtual Ethernet Ports"),
+			},
+			{
+				Config:      ProviderConfigForTesting + VethPortDataSourceParamsFilterNegative,
+				ExpectError: regexp.MustCompile("Unable to Read PowerStore Virtual Ethernet Ports"),
+			},
+			{
+				Config:      ProviderConfigForTesting + VethPortDataSourceParamsIDAndNameNegative,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
+
+var VethPortDataSourceParamsAll = `
+data "powerstore_veth_port" "test" {
+}
+`
+
+var VethPortDataSourceParamsID = `
+data "powerstore_veth_port" "test1" {
+	id = data.powerstore_veth_port.test.veth_ports[0].id
+}
+`
+
+var VethPortDataSourceParamsName = `
+data "powerstore_veth_port" "test1" {
+	name = data.powerstore_veth_port.test.veth_ports[0].name
+}
+`
+
+var VethPortDataSourceParamsFilter = `
+data "powerstore_veth_port" "test" {
+	filter_expression = "is_link_up=eq.true"
+}
+`
+
+var VethPortDataSourceParamsIDNegative = `
+data "powerstore_veth_port" "test" {
+	id = "invalid-id"
+}
+`
+
+var VethPortDataSourceParamsFilterNegative = `
+data "powerstore_veth_port" "test" {
+	filter_expression = "name=inv.invalid"
+}
+`
+
+var VethPortDataSourceParamsIDAndNameNegative = `
+data "powerstore_veth_port" "test" {
+	id = "invalid-id"
+	name = "invalid"
+}
+`
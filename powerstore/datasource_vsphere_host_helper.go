@@ -0,0 +1,62 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// vsphereHostDatasourceSelect lists the vSphere host fields queried by the vSphere host datasource
+const vsphereHostDatasourceSelect = "id,name,vsphere_object_id,vcenter_id,version,build,hosts(id),datastores(id),virtual_machines(id)," +
+	"license_assignments(id,name,license_key,edition_key,expiration_date)"
+
+// updateVsphereHostState iterates over the vSphere host list and update the state
+func updateVsphereHostState(in []clientgen.VsphereHostInstance) []models.VsphereHostDataSource {
+	return helper.SliceTransform(in, func(in clientgen.VsphereHostInstance) models.VsphereHostDataSource {
+		return models.VsphereHostDataSource{
+			ID:              helper.TfString(in.Id),
+			Name:            helper.TfString(in.Name),
+			VsphereObjectID: helper.TfString(in.VsphereObjectId),
+			VcenterID:       helper.TfString(in.VcenterId),
+			Version:         helper.TfString(in.Version),
+			Build:           helper.TfString(in.Build),
+			HostIDs: helper.SliceTransform(in.Hosts, func(in clientgen.HostInstance) types.String {
+				return helper.TfString(in.Id)
+			}),
+			DatastoreIDs: helper.SliceTransform(in.Datastores, func(in clientgen.DatastoreInstance) types.String {
+				return helper.TfString(in.Id)
+			}),
+			VirtualMachineIDs: helper.SliceTransform(in.VirtualMachines, func(in clientgen.VirtualMachineInstance) types.String {
+				return helper.TfString(in.Id)
+			}),
+			LicenseAssignments: helper.SliceTransform(in.LicenseAssignments, func(in clientgen.VsphereHostLicenseAssignmentInstance) models.VsphereHostLicenseAssignmentDataSource {
+				return models.VsphereHostLicenseAssignmentDataSource{
+					ID:             helper.TfString(in.Id),
+					Name:           helper.TfString(in.Name),
+					LicenseKey:     helper.TfString(in.LicenseKey),
+					EditionKey:     helper.TfString(in.EditionKey),
+					ExpirationDate: helper.TfStringFromPTime(in.ExpirationDate),
+				}
+			}),
+		}
+	})
+}
@@ -0,0 +1,116 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// VsphereHostDatasourceSchema is a function that returns the schema for vSphere host datasource
+func VsphereHostDatasourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "Unique identifier of the vSphere host.",
+			MarkdownDescription: "Unique identifier of the vSphere host.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			Description:         "Name of the ESXi host in vCenter.",
+			MarkdownDescription: "Name of the ESXi host in vCenter.",
+			Computed:            true,
+		},
+		"vsphere_object_id": schema.StringAttribute{
+			Description:         "Identifier of the ESXi host in vCenter.",
+			MarkdownDescription: "Identifier of the ESXi host in vCenter.",
+			Computed:            true,
+		},
+		"vcenter_id": schema.StringAttribute{
+			Description:         "Unique identifier of the vCenter the ESXi host belongs to.",
+			MarkdownDescription: "Unique identifier of the vCenter the ESXi host belongs to.",
+			Computed:            true,
+		},
+		"version": schema.StringAttribute{
+			Description:         "ESXi version of the host.",
+			MarkdownDescription: "ESXi version of the host.",
+			Computed:            true,
+		},
+		"build": schema.StringAttribute{
+			Description:         "ESXi build number of the host.",
+			MarkdownDescription: "ESXi build number of the host.",
+			Computed:            true,
+		},
+		"host_ids": schema.ListAttribute{
+			Description:         "Unique identifiers of the PowerStore hosts associated with the ESXi host.",
+			MarkdownDescription: "Unique identifiers of the PowerStore hosts associated with the ESXi host.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"datastore_ids": schema.ListAttribute{
+			Description:         "Unique identifiers of the datastores mounted on the ESXi host.",
+			MarkdownDescription: "Unique identifiers of the datastores mounted on the ESXi host.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"virtual_machine_ids": schema.ListAttribute{
+			Description:         "Unique identifiers of the virtual machines running on the ESXi host.",
+			MarkdownDescription: "Unique identifiers of the virtual machines running on the ESXi host.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"license_assignments": schema.ListNestedAttribute{
+			Description:         "Licenses assigned to the ESXi host.",
+			MarkdownDescription: "Licenses assigned to the ESXi host.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: VsphereHostLicenseAssignmentDatasourceSchema(),
+			},
+		},
+	}
+}
+
+// VsphereHostLicenseAssignmentDatasourceSchema is a function that returns the schema for vSphere host license assignment
+func VsphereHostLicenseAssignmentDatasourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "Unique identifier of the license assignment.",
+			MarkdownDescription: "Unique identifier of the license assignment.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			Description:         "Full name of the license.",
+			MarkdownDescription: "Full name of the license.",
+			Computed:            true,
+		},
+		"license_key": schema.StringAttribute{
+			Description:         "Obfuscated key of the license.",
+			MarkdownDescription: "Obfuscated key of the license.",
+			Computed:            true,
+		},
+		"edition_key": schema.StringAttribute{
+			Description:         "Edition key (license type) of the license.",
+			MarkdownDescription: "Edition key (license type) of the license.",
+			Computed:            true,
+		},
+		"expiration_date": schema.StringAttribute{
+			Description:         "Expiration date of the license.",
+			MarkdownDescription: "Expiration date of the license.",
+			Computed:            true,
+		},
+	}
+}
@@ -0,0 +1,117 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Test to Fetch vSphere Hosts
+func TestAccVsphereHostDs(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				// Get all vSphere Hosts
+				Config: ProviderConfigForTesting + VsphereHostDataSourceParamsAll,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerstore_vsphere_host.test", "vsphere_hosts.0.id"),
+					resource.TestCheckResourceAttrSet("data.powerstore_vsphere_host.test", "vsphere_hosts.0.name"),
+				),
+			},
+			{
+				// Get vSphere Host by ID
+				Config: ProviderConfigForTesting + VsphereHostDataSourceParamsAll + VsphereHostDataSourceParamsID,
+				Check:  resource.TestCheckResourceAttr("data.powerstore_vsphere_host.test1", "vsphere_hosts.#", "1"),
+			},
+			{
+				// Get vSphere Hosts by name
+				Config: ProviderConfigForTesting + VsphereHostDataSourceParamsAll + VsphereHostDataSourceParamsName,
+				Check:  resource.TestCheckResourceAttrPair("data.powerstore_vsphere_host.test1", "vsphere_hosts.0.name", "data.powerstore_vsphere_host.test", "vsphere_hosts.0.name"),
+			},
+			{
+				// Get vSphere Hosts by filter expression
+				Config: ProviderConfigForTesting + VsphereHostDataSourceParamsAll + VsphereHostDataSourceParamsFilter,
+				Check:  resource.TestCheckResourceAttrPair("data.powerstore_vsphere_host.test1", "vsphere_hosts.0.vcenter_id", "data.powerstore_vsphere_host.test", "vsphere_hosts.0.vcenter_id"),
+			},
+			{
+				Config:      ProviderConfigForTesting + VsphereHostDataSourceParamsIDNegative,
+				ExpectError: regexp.MustCompile("Unable to Read PowerStore vSphere Hosts"),
+			},
+			{
+				Config:      ProviderConfigForTesting + VsphereHostDataSourceParamsFilterNegative,
+				ExpectError: regexp.MustCompile("Unable to Read PowerStore vSphere Hosts"),
+			},
+			{
+				Config:      ProviderConfigForTesting + VsphereHostDataSourceParamsIDAndNameNegative,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
+
+var VsphereHostDataSourceParamsAll = `
+data "powerstore_vsphere_host" "test" {
+}
+`
+
+var VsphereHostDataSourceParamsID = `
+data "powerstore_vsphere_host" "test1" {
+	id = data.powerstore_vsphere_host.test.vsphere_hosts[0].id
+}
+`
+
+var VsphereHostDataSourceParamsName = `
+data "powerstore_vsphere_host" "test1" {
+	name = data.powerstore_vsphere_host.test.vsphere_hosts[0].name
+}
+`
+
+var VsphereHostDataSourceParamsFilter = `
+data "powerstore_vsphere_host" "test1" {
+	filter_expression = "vcenter_id=eq.${data.powerstore_vsphere_host.test.vsphere_hosts[0].vcenter_id}"
+}
+`
+
+var VsphereHostDataSourceParamsIDNegative = `
+data "powerstore_vsphere_host" "test" {
+	id = "invalid-id"
+}
+`
+
+var VsphereHostDataSourceParamsFilterNegative = `
+data "powerstore_vsphere_host" "test" {
+	filter_expression = "name=inv.invalid"
+}
+`
+
+var VsphereHostDataSourceParamsIDAndNameNegative = `
+data "powerstore_vsphere_host" "test" {
+	id = "invalid-id"
+	name = "invalid"
+}
+`
@@ -20,6 +20,7 @@ package powerstore
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -85,3 +86,35 @@ func (m DefaultAttributePlanModifier) Description(ctx context.Context) string {
 func (m DefaultAttributePlanModifier) MarkdownDescription(ctx context.Context) string {
 	return m.Description(ctx)
 }
+
+// certificateIDModifier keeps the id of an uploaded certificate while the certificate does not change, so a new certificate leaves the id unknown
+type certificateIDModifier struct {
+	certificate string
+}
+
+// Description returns a plain text description of the modifier's behavior
+func (m certificateIDModifier) Description(ctx context.Context) string {
+	return "Keeps the identifier of the uploaded certificate unless " + m.certificate + " changes."
+}
+
+// MarkdownDescription returns a markdown formatted description of the modifier's behavior
+func (m certificateIDModifier) MarkdownDescription(ctx context.Context) string {
+	return "Keeps the identifier of the uploaded certificate unless `" + m.certificate + "` changes."
+}
+
+// PlanModifyString keeps the state value if the certificate is unchanged
+func (m certificateIDModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.State.Raw.IsNull() || !req.PlanValue.IsUnknown() {
+		return
+	}
+	var planned, current types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(m.certificate), &planned)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(m.certificate), &current)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// certificates cannot be deleted, so removing the certificate from the configuration keeps the last one
+	if planned.Equal(current) || planned.IsNull() {
+		resp.PlanValue = req.StateValue
+	}
+}
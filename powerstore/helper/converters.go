@@ -18,6 +18,7 @@ limitations under the License.
 package helper
 
 import (
+	"slices"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -48,6 +49,22 @@ func TfBool(in *bool) types.Bool {
 	return types.BoolValue(*in)
 }
 
+// TfInt64 - Converts *int32 or *int64 to types.Int64, returns types.Int64Null if input is nil
+func TfInt64[T ~int32 | ~int64](in *T) types.Int64 {
+	if in == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*in))
+}
+
+// TfFloat64 - Converts *float32 or *float64 to types.Float64, returns types.Float64Null if input is nil
+func TfFloat64[T ~float32 | ~float64](in *T) types.Float64 {
+	if in == nil {
+		return types.Float64Null()
+	}
+	return types.Float64Value(float64(*in))
+}
+
 // TfObject - Converts input using the transform transform function, returns empty output if input is nil
 func TfObject[tfT any, jT any](in *jT, transform func(jT) tfT) tfT {
 	if in == nil {
@@ -80,6 +97,58 @@ func ValueToPointer[T bool | string, VT attr.Value](in VT) *T {
 	return nil
 }
 
+// KnownInt32 - Returns a pointer to the int32 value of a known types.Int64, nil otherwise
+func KnownInt32(in types.Int64) *int32 {
+	if !IsKnownValue(in) {
+		return nil
+	}
+	return GetPointer(int32(in.ValueInt64()))
+}
+
+// KnownInt64 - Returns a pointer to the value of a known types.Int64, nil otherwise
+func KnownInt64(in types.Int64) *int64 {
+	if !IsKnownValue(in) {
+		return nil
+	}
+	return GetPointer(in.ValueInt64())
+}
+
+// SetStrings - Returns the string elements of a known set, nil otherwise
+func SetStrings(in types.Set) []string {
+	if !IsKnownValue(in) {
+		return nil
+	}
+	return SliceTransform(in.Elements(), func(in attr.Value) string {
+		return in.(types.String).ValueString()
+	})
+}
+
+// ListStrings - Returns the string elements of a known list, nil otherwise
+func ListStrings(in types.List) []string {
+	if !IsKnownValue(in) {
+		return nil
+	}
+	return SliceTransform(in.Elements(), func(in attr.Value) string {
+		return in.(types.String).ValueString()
+	})
+}
+
+// SetDifference - Returns the elements to be added and removed to turn the state set into the plan set
+func SetDifference(plan, state types.Set) ([]string, []string) {
+	var add, remove []string
+	for _, v := range plan.Elements() {
+		if !slices.ContainsFunc(state.Elements(), v.Equal) {
+			add = append(add, v.(types.String).ValueString())
+		}
+	}
+	for _, v := range state.Elements() {
+		if !slices.ContainsFunc(plan.Elements(), v.Equal) {
+			remove = append(remove, v.(types.String).ValueString())
+		}
+	}
+	return add, remove
+}
+
 // SliceTransform - Applies the transform function to each element in a slice
 func SliceTransform[tfT any, jT any](in []jT, transform func(jT) tfT) []tfT {
 	ret := make([]tfT, len(in))
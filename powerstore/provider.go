@@ -23,6 +23,7 @@ import (
 	"strconv"
 	client "terraform-provider-powerstore/client"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
@@ -56,6 +57,9 @@ type ProviderData struct {
 	Password types.String `tfsdk:"password"`
 	Username types.String `tfsdk:"username"`
 	Timeout  types.Int64  `tfsdk:"timeout"`
+
+	AutoMaintenanceWindow     types.Bool  `tfsdk:"auto_maintenance_window"`
+	MaintenanceWindowDuration types.Int64 `tfsdk:"maintenance_window_duration"`
 }
 
 // Metadata defines provider interface Metadata method
@@ -106,6 +110,19 @@ func (p *Pstoreprovider) Schema(ctx context.Context, req provider.SchemaRequest,
 				// This should remain optional so user can use environment variables if they choose.
 				Optional: true,
 			},
+			"auto_maintenance_window": schema.BoolAttribute{
+				MarkdownDescription: "Boolean variable to specify whether to open a maintenance window on all appliances around disruptive operations like port, bond and network changes, so that call-home alerts are suppressed. Only the windows opened by the provider are closed once the operations are done. This can also be set using the environment variable POWERSTORE_AUTO_MAINTENANCE_WINDOW",
+				Description:         "Boolean variable to specify whether to open a maintenance window on all appliances around disruptive operations like port, bond and network changes, so that call-home alerts are suppressed. Only the windows opened by the provider are closed once the operations are done. This can also be set using the environment variable POWERSTORE_AUTO_MAINTENANCE_WINDOW",
+				Optional:            true,
+			},
+			"maintenance_window_duration": schema.Int64Attribute{
+				MarkdownDescription: "Duration in seconds of the maintenance windows opened when `auto_maintenance_window` is enabled. The windows are closed as soon as the operations are done, the duration only bounds them in case the provider is interrupted. Defaults to 3600.",
+				Description:         "Duration in seconds of the maintenance windows opened when auto_maintenance_window is enabled. The windows are closed as soon as the operations are done, the duration only bounds them in case the provider is interrupted. Defaults to 3600.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(60, 432000),
+				},
+			},
 		},
 	}
 }
@@ -149,6 +166,15 @@ func (p *Pstoreprovider) Configure(ctx context.Context, req provider.ConfigureRe
 		config.Timeout = types.Int64Value(120)
 	}
 
+	autoMaintenanceWindowEnv, errAutoMaintenanceWindow := strconv.ParseBool(os.Getenv("POWERSTORE_AUTO_MAINTENANCE_WINDOW"))
+	if errAutoMaintenanceWindow == nil {
+		config.AutoMaintenanceWindow = types.BoolValue(autoMaintenanceWindowEnv)
+	}
+
+	if config.MaintenanceWindowDuration.IsNull() || config.MaintenanceWindowDuration.IsUnknown() {
+		config.MaintenanceWindowDuration = types.Int64Value(3600)
+	}
+
 	// initializing powerstore client
 	pstoreClient, err := client.NewClient(
 		config.Endpoint.ValueString(),
@@ -178,6 +204,10 @@ func (p *Pstoreprovider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
+	if config.AutoMaintenanceWindow.ValueBool() {
+		pstoreClient.SetAutoMaintenanceWindow(int32(config.MaintenanceWindowDuration.ValueInt64()))
+	}
+
 	p.client = pstoreClient
 	resp.ResourceData = pstoreClient
 	resp.DataSourceData = pstoreClient
@@ -200,6 +230,33 @@ func (p *Pstoreprovider) Resources(ctx context.Context) []func() resource.Resour
 		newReplicationRuleResource,
 		newNFSExportResource,
 		newSMBShareResource,
+		newEthPortResource,
+		newFcPortResource,
+		newBondResource,
+		newNetworkResource,
+		newIPPortResource,
+		newMaintenanceWindowResource,
+		newSoftwareUpgradeResource,
+		newLocalUserResource,
+		newManagementLdapResource,
+		newLdapAccountResource,
+		newX509CertificateResource,
+		newNtpResource,
+		newDNSResource,
+		newSMTPConfigResource,
+		newEmailNotifyDestinationResource,
+		newSnmpServerResource,
+		newRemoteSyslogServerResource,
+		newAlertResource,
+		newFileVirusCheckerResource,
+		newFileFtpResource,
+		newFileNdmpResource,
+		newFileEventsPoolResource,
+		newFileEventsPublisherResource,
+		newFileDhsmConfigResource,
+		newRemoteBackupRuleResource,
+		newKmipServerResource,
+		newSecurityConfigResource,
 	}
 }
 
@@ -221,6 +278,30 @@ func (p *Pstoreprovider) DataSources(ctx context.Context) []func() datasource.Da
 		newNFSExportDatasource,
 		newSmbShareDatasource,
 		newRemoteSystemDatasource,
+		newClusterDataSource,
+		newApplianceDataSource,
+		newNodeDataSource,
+		newHardwareDataSource,
+		newEthPortDataSource,
+		newFcPortDataSource,
+		newEthBePortDataSource,
+		newSasPortDataSource,
+		newVethPortDataSource,
+		newNetworkDataSource,
+		newIPPortDataSource,
+		newSoftwareInstalledDataSource,
+		newRoleDataSource,
+		newAlertDataSource,
+		newEventDataSource,
+		newMetricsDataSource,
+		newSpaceMetricsDataSource,
+		newFileDhsmConfigDataSource,
+		newRemoteSnapshotDataSource,
+		newKmipServerDataSource,
+		newAuditEventDataSource,
+		newJobDataSource,
+		newDatastoreDataSource,
+		newVsphereHostDataSource,
 	}
 }
 
@@ -20,6 +20,7 @@ package powerstore
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/bytedance/mockey"
@@ -38,6 +39,32 @@ var password = setDefault(os.Getenv("POWERSTORE_PASSWORD"), "test")
 var nasServerID = setDefault(os.Getenv("NAS_SERVER_ID"), "tfacc_nas_server_id")
 var nasServerName = setDefault(os.Getenv("NAS_SERVER_NAME"), "tfacc_nas")
 var remoteSystemID = setDefault(os.Getenv("REMOTE_SYSTEM_ID"), "db11abb3-789e-47f9-96b5-84b5374cbcd2")
+var ethPortName = setDefault(os.Getenv("ETH_PORT_NAME"), "BaseEnclosure-NodeA-EmbeddedModule-MezzCard-FEPort0")
+var fcPortName = setDefault(os.Getenv("FC_PORT_NAME"), "BaseEnclosure-NodeA-IoModule0-FEPort0")
+var bondPortIDs = setDefault(os.Getenv("BOND_PORT_IDS"), `"tfacc_bond_port_1", "tfacc_bond_port_2"`)
+var bondAddPortID = setDefault(os.Getenv("BOND_ADD_PORT_ID"), "tfacc_bond_port_3")
+var networkAddresses = setDefault(os.Getenv("NETWORK_ADDRESSES"), `"10.230.42.11", "10.230.42.12"`)
+var ipPortID = setDefault(os.Getenv("IP_PORT_ID"), "tfacc_ip_port_id")
+var applianceID = setDefault(os.Getenv("APPLIANCE_ID"), "A1")
+var softwarePackageFile = setDefault(os.Getenv("SOFTWARE_PACKAGE_FILE"), filepath.Join(os.TempDir(), "tfacc_software_package.tgz.bin"))
+var ldapDomainName = setDefault(os.Getenv("LDAP_DOMAIN_NAME"), "tfacc.example.com")
+var ldapServers = setDefault(os.Getenv("LDAP_SERVERS"), `"10.230.24.10"`)
+var ldapBindUser = setDefault(os.Getenv("LDAP_BIND_USER"), "cn=tfacc,cn=Users,dc=tfacc,dc=example,dc=com")
+var ldapBindPassword = setDefault(os.Getenv("LDAP_BIND_PASSWORD"), "Password123!")
+var ldapSearchPath = setDefault(os.Getenv("LDAP_SEARCH_PATH"), "dc=tfacc,dc=example,dc=com")
+var ldapGroupName = setDefault(os.Getenv("LDAP_GROUP_NAME"), "tfacc.admins")
+var x509CACertificate = setDefault(os.Getenv("X509_CA_CERTIFICATE"), "-----BEGIN CERTIFICATE-----\nMIIBtfaccCertificate\n-----END CERTIFICATE-----")
+var x509CACertificateUpdate = setDefault(os.Getenv("X509_CA_CERTIFICATE_UPDATE"), "-----BEGIN CERTIFICATE-----\nMIIBtfaccCertificateUpdate\n-----END CERTIFICATE-----")
+var ntpAddresses = setDefault(os.Getenv("NTP_ADDRESSES"), `"10.230.24.20", "clock.tfacc.example.com"`)
+var dnsAddresses = setDefault(os.Getenv("DNS_ADDRESSES"), `"10.230.24.30", "10.230.24.31"`)
+var smtpAddress = setDefault(os.Getenv("SMTP_ADDRESS"), "10.230.24.40")
+var notifyEmailAddress = setDefault(os.Getenv("NOTIFY_EMAIL_ADDRESS"), "storage.admins@tfacc.example.com")
+var snmpServerAddress = setDefault(os.Getenv("SNMP_SERVER_ADDRESS"), "10.230.24.50")
+var syslogServerAddress = setDefault(os.Getenv("SYSLOG_SERVER_ADDRESS"), "10.230.24.60")
+var virusCheckerAddress = setDefault(os.Getenv("VIRUS_CHECKER_ADDRESS"), "10.230.24.70")
+var fileEventsServer = setDefault(os.Getenv("FILE_EVENTS_SERVER"), "10.230.24.71")
+var ppddRemoteSystemID = setDefault(os.Getenv("PPDD_REMOTE_SYSTEM_ID"), "tfacc_ppdd_remote_system_id")
+var kmipServerAddress = setDefault(os.Getenv("KMIP_SERVER_ADDRESS"), "10.230.24.72")
 var FunctionMocker *mockey.Mocker
 
 var ProviderConfigForTesting = ``
@@ -0,0 +1,298 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-powerstore/client"
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+)
+
+// alertSelect lists the alert fields read by the alert resource
+const alertSelect = "id,is_acknowledged,severity,state,event_code,resource_type,resource_id,resource_name,description_l10n,acknowledged_timestamp"
+
+// newAlertResource returns alert new resource instance
+func newAlertResource() resource.Resource {
+	return &resourceAlert{}
+}
+
+type resourceAlert struct {
+	client *clientgen.APIClient
+}
+
+// Metadata defines resource interface Metadata method
+func (r *resourceAlert) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert"
+}
+
+// Schema defines resource interface Schema method
+func (r *resourceAlert) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to acknowledge an existing alert of PowerStore Array. Alerts are raised and cleared by the array itself, so creating this resource adopts the alert and destroying it only removes it from the Terraform state, leaving the acknowledgement untouched. We can also import an existing alert from PowerStore array.",
+		Description:         "This resource is used to acknowledge an existing alert of PowerStore Array. Alerts are raised and cleared by the array itself, so creating this resource adopts the alert and destroying it only removes it from the Terraform state, leaving the acknowledgement untouched. We can also import an existing alert from PowerStore array.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Unique identifier of the alert. Cannot be updated.",
+				MarkdownDescription: "Unique identifier of the alert. Cannot be updated.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"is_acknowledged": schema.BoolAttribute{
+				Description:         "Whether the alert is acknowledged. Set to false to unacknowledge the alert. Defaults to true.",
+				MarkdownDescription: "Whether the alert is acknowledged. Set to `false` to unacknowledge the alert. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"acknowledged_severity": schema.StringAttribute{
+				Description:         "Highest severity the alert is acknowledged for. If set, acknowledging fails when the severity of the alert is higher. Valid values are None, Info, Minor, Major and Critical.",
+				MarkdownDescription: "Highest severity the alert is acknowledged for. If set, acknowledging fails when the severity of the alert is higher. Valid values are `None`, `Info`, `Minor`, `Major` and `Critical`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(helper.SliceTransform(clientgen.AllowedSeverityEnumEnumValues, func(in clientgen.SeverityEnum) string {
+						return string(in)
+					})...),
+				},
+			},
+			"severity": schema.StringAttribute{
+				Description:         "Severity of the latest event of the alert.",
+				MarkdownDescription: "Severity of the latest event of the alert.",
+				Computed:            true,
+			},
+			"state": schema.StringAttribute{
+				Description:         "State of the alert, either ACTIVE or CLEARED.",
+				MarkdownDescription: "State of the alert, either ACTIVE or CLEARED.",
+				Computed:            true,
+			},
+			"event_code": schema.StringAttribute{
+				Description:         "Event code of the latest event of the alert.",
+				MarkdownDescription: "Event code of the latest event of the alert.",
+				Computed:            true,
+			},
+			"resource_type": schema.StringAttribute{
+				Description:         "Type of the resource instance which generated the alert.",
+				MarkdownDescription: "Type of the resource instance which generated the alert.",
+				Computed:            true,
+			},
+			"resource_id": schema.StringAttribute{
+				Description:         "Unique identifier of the resource instance which generated the alert.",
+				MarkdownDescription: "Unique identifier of the resource instance which generated the alert.",
+				Computed:            true,
+			},
+			"resource_name": schema.StringAttribute{
+				Description:         "Name of the resource instance which generated the alert.",
+				MarkdownDescription: "Name of the resource instance which generated the alert.",
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				Description:         "Description of the latest event of the alert.",
+				MarkdownDescription: "Description of the latest event of the alert.",
+				Computed:            true,
+			},
+			"acknowledged_timestamp": schema.StringAttribute{
+				Description:         "Timestamp when the alert was acknowledged, if any.",
+				MarkdownDescription: "Timestamp when the alert was acknowledged, if any.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure - defines configuration for alert resource
+func (r *resourceAlert) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client.GenClient
+}
+
+// Create - adopts the alert and applies the planned acknowledgement
+func (r *resourceAlert) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.Alert
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := plan.ID.ValueString()
+	err := r.acknowledge(ctx, id, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating alert",
+			"Could not acknowledge alert "+id+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	alert, err := r.ReadAPI(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting alert after creation",
+			"Could not get alert, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state := r.updateState(alert, plan.AcknowledgedSeverity)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Create")
+}
+
+// Read - reads alert resource information
+func (r *resourceAlert) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.Alert
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	alert, err := r.ReadAPI(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading alert",
+			"Could not read alert with error "+id+": "+err.Error(),
+		)
+		return
+	}
+
+	state = r.updateState(alert, state.AcknowledgedSeverity)
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Read")
+}
+
+// Update - updates the acknowledgement of the alert
+func (r *resourceAlert) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	log.Printf("Started Update")
+
+	var plan models.Alert
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := plan.ID.ValueString()
+	err := r.acknowledge(ctx, id, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating alert",
+			"Could not update alert "+id+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	alert, err := r.ReadAPI(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting alert after update",
+			"Could not get alert, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state := r.updateState(alert, plan.AcknowledgedSeverity)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Successfully done with Update")
+}
+
+// Delete - removes the alert from the state, the acknowledgement is left untouched
+func (r *resourceAlert) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	log.Printf("Started with Delete")
+	resp.State.RemoveResource(ctx)
+	log.Printf("Done with Delete")
+}
+
+// ImportState - imports state for existing alert
+func (r *resourceAlert) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// ReadAPI - fetches the alert by id
+func (r *resourceAlert) ReadAPI(ctx context.Context, id string) (*clientgen.AlertInstance, error) {
+	queries := make(url.Values)
+	queries.Set("select", alertSelect)
+	alert, _, err := r.client.AlertApi.GetAlertById(ctx, id).Queries(queries).Execute()
+	return alert, err
+}
+
+// acknowledge - sets the acknowledgement of the alert
+func (r *resourceAlert) acknowledge(ctx context.Context, id string, plan models.Alert) error {
+	_, err := r.client.AlertApi.PatchAlertById(ctx, id).AlertModify(clientgen.AlertModify{
+		IsAcknowledged:       plan.IsAcknowledged.ValueBool(),
+		AcknowledgedSeverity: (*clientgen.SeverityEnum)(helper.ValueToPointer[string](plan.AcknowledgedSeverity)),
+	}).Execute()
+	return err
+}
+
+// updateState - converts the alert response to the resource state
+func (r *resourceAlert) updateState(alert *clientgen.AlertInstance, acknowledgedSeverity types.String) models.Alert {
+	return models.Alert{
+		ID:                    helper.TfString(alert.Id),
+		IsAcknowledged:        helper.TfBool(alert.IsAcknowledged),
+		AcknowledgedSeverity:  acknowledgedSeverity,
+		Severity:              helper.TfString(alert.Severity),
+		State:                 helper.TfString(alert.State),
+		EventCode:             helper.TfString(alert.EventCode),
+		ResourceType:          helper.TfString(alert.ResourceType),
+		ResourceID:            helper.TfString(alert.ResourceId),
+		ResourceName:          helper.TfString(alert.ResourceName),
+		Description:           helper.TfString(alert.DescriptionL10n),
+		AcknowledgedTimestamp: helper.TfStringFromPTime(alert.AcknowledgedTimestamp),
+	}
+}
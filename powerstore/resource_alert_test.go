@@ -0,0 +1,89 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Test to Acknowledge, Unacknowledge and Import Alert Resource
+func TestAccAlert(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfigForTesting + AlertParamsInvalidID,
+				ExpectError: regexp.MustCompile("Error creating alert"),
+			},
+			{
+				Config: ProviderConfigForTesting + AlertParamsAcknowledge,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerstore_alert.test", "is_acknowledged", "true"),
+					resource.TestCheckResourceAttrSet("powerstore_alert.test", "acknowledged_timestamp"),
+				),
+			},
+			// Import Testing
+			{
+				Config:            ProviderConfigForTesting + AlertParamsAcknowledge,
+				ResourceName:      "powerstore_alert.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: ProviderConfigForTesting + AlertParamsUnacknowledge,
+				Check:  resource.TestCheckResourceAttr("powerstore_alert.test", "is_acknowledged", "false"),
+			},
+		},
+	})
+}
+
+var AlertParamsInvalidID = `
+resource "powerstore_alert" "test" {
+	id = "invalid-id"
+}
+`
+
+var AlertParamsAcknowledge = `
+data "powerstore_alert" "active" {
+	filter_expression = "state=eq.ACTIVE"
+}
+
+resource "powerstore_alert" "test" {
+	id = data.powerstore_alert.active.alerts[0].id
+}
+`
+
+var AlertParamsUnacknowledge = `
+data "powerstore_alert" "active" {
+	filter_expression = "state=eq.ACTIVE"
+}
+
+resource "powerstore_alert" "test" {
+	id = data.powerstore_alert.active.alerts[0].id
+	is_acknowledged = false
+}
+`
@@ -0,0 +1,399 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-powerstore/client"
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+)
+
+// bondSelect lists the bond fields read by the bond resource
+const bondSelect = "id,name,description,mode,mtu,status,is_link_up,type,partner_id,fsn_id,eth_ports(id),ip_ports(id)"
+
+// newBondResource returns bond new resource instance
+func newBondResource() resource.Resource {
+	return &resourceBond{}
+}
+
+type resourceBond struct {
+	client    *clientgen.APIClient
+	allclient *client.Client
+}
+
+// Metadata defines resource interface Metadata method
+func (r *resourceBond) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bond"
+}
+
+// Schema defines resource interface Schema method
+func (r *resourceBond) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage the link aggregation (bond) entity of PowerStore Array. We can Create, Update and Delete the bond using this resource. We can also import an existing bond from PowerStore array.",
+		Description:         "This resource is used to manage the link aggregation (bond) entity of PowerStore Array. We can Create, Update and Delete the bond using this resource. We can also import an existing bond from PowerStore array.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Unique identifier of the bond.",
+				MarkdownDescription: "Unique identifier of the bond.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description:         "Name of the bond. The name is generated by PowerStore.",
+				MarkdownDescription: "Name of the bond. The name is generated by PowerStore.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"port_ids": schema.SetAttribute{
+				Description:         "Unique identifiers of the unused front-end Ethernet ports of one node to be included in the bond. Ports can be added to an existing bond, but removing a port recreates the bond.",
+				MarkdownDescription: "Unique identifiers of the unused front-end Ethernet ports of one node to be included in the bond. Ports can be added to an existing bond, but removing a port recreates the bond.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeBetween(2, 4),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplaceIf(bondPortRemoved,
+						"Ports cannot be removed from an existing bond.",
+						"Ports cannot be removed from an existing bond.",
+					),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description:         "User supplied description of the bond.",
+				MarkdownDescription: "User supplied description of the bond.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"mode": schema.StringAttribute{
+				Description:         "Bonding mode of the bond. PowerStore only supports LACP.",
+				MarkdownDescription: "Bonding mode of the bond. PowerStore only supports `LACP`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"mtu": schema.Int64Attribute{
+				Description:         "Maximum Transmission Unit (MTU) packet size of the bond, in bytes. The MTU follows the cluster-wide physical MTU and cannot be set per bond.",
+				MarkdownDescription: "Maximum Transmission Unit (MTU) packet size of the bond, in bytes. The MTU follows the cluster-wide physical MTU and cannot be set per bond.",
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				Description:         "Current operational status of the bond.",
+				MarkdownDescription: "Current operational status of the bond.",
+				Computed:            true,
+			},
+			"is_link_up": schema.BoolAttribute{
+				Description:         "Indicates whether the bond's link is up.",
+				MarkdownDescription: "Indicates whether the bond's link is up.",
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				Description:         "Type of the bond.",
+				MarkdownDescription: "Type of the bond.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"partner_id": schema.StringAttribute{
+				Description:         "Unique identifier of the bond with the same physical location on the other node of the appliance.",
+				MarkdownDescription: "Unique identifier of the bond with the same physical location on the other node of the appliance.",
+				Computed:            true,
+			},
+			"fsn_id": schema.StringAttribute{
+				Description:         "Unique identifier of the fail-safe network the bond belongs to, if any.",
+				MarkdownDescription: "Unique identifier of the fail-safe network the bond belongs to, if any.",
+				Computed:            true,
+			},
+			"ip_port_ids": schema.ListAttribute{
+				Description:         "Unique identifiers of the IP ports on the bond. These can be used to place storage networks and file interfaces on the bond.",
+				MarkdownDescription: "Unique identifiers of the IP ports on the bond. These can be used to place storage networks and file interfaces on the bond.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+// bondPortRemoved requires the bond to be replaced when a port is removed, since the API only supports adding ports
+func bondPortRemoved(ctx context.Context, req planmodifier.SetRequest, resp *setplanmodifier.RequiresReplaceIfFuncResponse) {
+	if req.State.Raw.IsNull() || !helper.IsKnownValue(req.PlanValue) {
+		return
+	}
+	_, removed := helper.SetDifference(req.PlanValue, req.StateValue)
+	resp.RequiresReplace = len(removed) != 0
+}
+
+// Configure - defines configuration for bond resource
+func (r *resourceBond) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client.GenClient
+	r.allclient = client
+}
+
+// Create - method to create bond resource
+func (r *resourceBond) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.Bond
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var portIDs []string
+	resp.Diagnostics.Append(plan.PortIDs.ElementsAs(ctx, &portIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var createResp *clientgen.CreateResponse
+	err := r.allclient.WithMaintenanceWindow(ctx, func() (err error) {
+		createResp, _, err = r.client.BondApi.PostAllBonds(ctx).Body(clientgen.BondCreate{
+			PortIds:     portIDs,
+			Description: helper.ValueToPointer[string](plan.Description),
+		}).Execute()
+		return err
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating bond",
+			"Could not create bond, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	bond, err := r.ReadAPI(ctx, helper.TfString(createResp.Id).ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting bond after creation",
+			"Could not get bond, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state, dgs := r.updateState(ctx, bond)
+	resp.Diagnostics.Append(dgs...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Create")
+}
+
+// Read - reads bond resource information
+func (r *resourceBond) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.Bond
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	bond, err := r.ReadAPI(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading bond",
+			"Could not read bond with error "+id+": "+err.Error(),
+		)
+		return
+	}
+
+	state, dgs := r.updateState(ctx, bond)
+	resp.Diagnostics.Append(dgs...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Read")
+}
+
+// Update - adds ports to the bond and updates its description
+func (r *resourceBond) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	log.Printf("Started Update")
+
+	var plan models.Bond
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.Bond
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	addPortIDs, _ := helper.SetDifference(plan.PortIDs, state.PortIDs)
+	bondModify := clientgen.BondModify{
+		AddPortIds: addPortIDs,
+	}
+	if helper.IsKnownValue(plan.Description) && !plan.Description.Equal(state.Description) {
+		bondModify.Description = plan.Description.ValueStringPointer()
+	}
+
+	id := state.ID.ValueString()
+	if len(bondModify.AddPortIds) != 0 || bondModify.Description != nil {
+		started := time.Now()
+		err := r.allclient.WithMaintenanceWindow(ctx, func() error {
+			_, err := r.client.BondApi.PatchBondById(ctx, id).Body(bondModify).Execute()
+			return err
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating bond",
+				"Could not update bond "+id+": "+err.Error()+r.allclient.FailedJobDetails(ctx, started, "bond", id, ""),
+			)
+			return
+		}
+	}
+
+	bond, err := r.ReadAPI(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting bond after update",
+			"Could not get bond, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state, dgs := r.updateState(ctx, bond)
+	resp.Diagnostics.Append(dgs...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Successfully done with Update")
+}
+
+// Delete - method to delete bond resource
+func (r *resourceBond) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	log.Printf("Started with Delete")
+
+	var state models.Bond
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	started := time.Now()
+	err := r.allclient.WithMaintenanceWindow(ctx, func() error {
+		_, err := r.client.BondApi.DeleteBondById(ctx, id).Execute()
+		return err
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting bond",
+			"Could not delete bond "+id+": "+err.Error()+r.allclient.FailedJobDetails(ctx, started, "bond", id, ""),
+		)
+		return
+	}
+
+	log.Printf("Done with Delete")
+}
+
+// ImportState - imports state for existing bond
+func (r *resourceBond) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// ReadAPI - fetches the bond by id
+func (r *resourceBond) ReadAPI(ctx context.Context, id string) (*clientgen.BondInstance, error) {
+	queries := make(url.Values)
+	queries.Set("select", bondSelect)
+	bond, _, err := r.client.BondApi.GetBondById(ctx, id).Queries(queries).Execute()
+	return bond, err
+}
+
+// updateState - converts the bond response to the resource state
+func (r *resourceBond) updateState(ctx context.Context, bond *clientgen.BondInstance) (models.Bond, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	portIDs, dgs := types.SetValueFrom(ctx, types.StringType, helper.SliceTransform(bond.EthPorts, func(in clientgen.EthPortInstance) string {
+		return helper.TfString(in.Id).ValueString()
+	}))
+	diags.Append(dgs...)
+	ipPortIDs, dgs := types.ListValueFrom(ctx, types.StringType, helper.SliceTransform(bond.IpPorts, func(in clientgen.IpPortInstance) string {
+		return helper.TfString(in.Id).ValueString()
+	}))
+	diags.Append(dgs...)
+	return models.Bond{
+		ID:          helper.TfString(bond.Id),
+		Name:        helper.TfString(bond.Name),
+		PortIDs:     portIDs,
+		Description: helper.TfString(bond.Description),
+		Mode:        helper.TfString(bond.Mode),
+		MTU:         helper.TfInt64(bond.Mtu),
+		Status:      helper.TfString(bond.Status),
+		IsLinkUp:    helper.TfBool(bond.IsLinkUp),
+		Type:        helper.TfString(bond.Type),
+		PartnerID:   helper.TfString(bond.PartnerId),
+		FsnID:       helper.TfString(bond.FsnId),
+		IPPortIDs:   ipPortIDs,
+	}, diags
+}
@@ -0,0 +1,106 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Test to Create, Update and Import Bond Resource
+func TestAccBond(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfigForTesting + BondParamsSinglePort,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value"),
+			},
+			{
+				Config:      ProviderConfigForTesting + BondParamsInvalidPorts,
+				ExpectError: regexp.MustCompile("Error creating bond"),
+			},
+			{
+				Config: ProviderConfigForTesting + BondParamsCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerstore_bond.test", "port_ids.#", "2"),
+					resource.TestCheckResourceAttr("powerstore_bond.test", "description", "Terraform bond"),
+					resource.TestCheckResourceAttr("powerstore_bond.test", "mode", "LACP"),
+					resource.TestCheckResourceAttrSet("powerstore_bond.test", "status"),
+					resource.TestCheckResourceAttrSet("powerstore_bond.test", "mtu"),
+				),
+			},
+			// Import Testing
+			{
+				Config:            ProviderConfigForTesting + BondParamsCreate,
+				ResourceName:      "powerstore_bond.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Import Negative Testing
+			{
+				Config:        ProviderConfigForTesting + BondParamsCreate,
+				ResourceName:  "powerstore_bond.test",
+				ImportState:   true,
+				ExpectError:   regexp.MustCompile("Error reading bond"),
+				ImportStateId: "invalid-id",
+			},
+			{
+				Config: ProviderConfigForTesting + BondParamsUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerstore_bond.test", "port_ids.#", "3"),
+					resource.TestCheckResourceAttr("powerstore_bond.test", "description", "Terraform bond updated"),
+				),
+			},
+		},
+	})
+}
+
+var BondParamsSinglePort = `
+resource "powerstore_bond" "test" {
+	port_ids = ["tfacc_bond_port_1"]
+}
+`
+
+var BondParamsInvalidPorts = `
+resource "powerstore_bond" "test" {
+	port_ids = ["invalid-id-1", "invalid-id-2"]
+}
+`
+
+var BondParamsCreate = `
+resource "powerstore_bond" "test" {
+	port_ids = [` + bondPortIDs + `]
+	description = "Terraform bond"
+}
+`
+
+var BondParamsUpdate = `
+resource "powerstore_bond" "test" {
+	port_ids = [` + bondPortIDs + `, "` + bondAddPortID + `"]
+	description = "Terraform bond updated"
+}
+`
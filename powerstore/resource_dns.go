@@ -0,0 +1,309 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-powerstore/client"
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+)
+
+// newDNSResource returns dns new resource instance
+func newDNSResource() resource.Resource {
+	return &resourceDNS{}
+}
+
+type resourceDNS struct {
+	client *clientgen.APIClient
+}
+
+// Metadata defines resource interface Metadata method
+func (r *resourceDNS) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns"
+}
+
+// Schema defines resource interface Schema method
+func (r *resourceDNS) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage the DNS servers of PowerStore Array. The cluster has exactly one DNS setting, so creating this resource adopts the existing setting and destroying it restores the baseline servers. We can also import the existing DNS setting from PowerStore array.",
+		Description:         "This resource is used to manage the DNS servers of PowerStore Array. The cluster has exactly one DNS setting, so creating this resource adopts the existing setting and destroying it restores the baseline servers. We can also import the existing DNS setting from PowerStore array.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Unique identifier of the DNS setting.",
+				MarkdownDescription: "Unique identifier of the DNS setting.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"addresses": schema.ListAttribute{
+				Description:         "DNS server addresses in IPv4 or IPv6 format, in order of preference.",
+				MarkdownDescription: "DNS server addresses in IPv4 or IPv6 format, in order of preference.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 3),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"baseline_addresses": schema.ListAttribute{
+				Description:         "DNS server addresses restored when the resource is destroyed. Defaults to the servers configured on the array when the resource is created. If the array had no servers configured, the servers are left unchanged on destroy.",
+				MarkdownDescription: "DNS server addresses restored when the resource is destroyed. Defaults to the servers configured on the array when the resource is created. If the array had no servers configured, the servers are left unchanged on destroy.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 3),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+		},
+	}
+}
+
+// Configure - defines configuration for dns resource
+func (r *resourceDNS) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client.GenClient
+}
+
+// Create - adopts the DNS setting of the cluster and applies the planned servers
+func (r *resourceDNS) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.DNS
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, _, err := r.client.DnsApi.GetAllDnss(ctx).Execute()
+	if err == nil && len(settings) == 0 {
+		err = fmt.Errorf("no DNS setting found")
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating dns",
+			"Could not find dns setting, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	current := settings[0]
+	id := helper.TfString(current.Id).ValueString()
+
+	// the servers configured before terraform took over are restored on destroy
+	if plan.BaselineAddresses.IsUnknown() {
+		plan.BaselineAddresses, diags = types.ListValueFrom(ctx, types.StringType, current.Addresses)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	_, err = r.client.DnsApi.PatchDnsById(ctx, id).Body(clientgen.DnsModify{
+		Addresses: helper.ListStrings(plan.Addresses),
+	}).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating dns",
+			"Could not update dns setting "+id+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	dns, _, err := r.client.DnsApi.GetDnsById(ctx, id).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting dns after creation",
+			"Could not get dns setting, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state, dgs := r.updateState(ctx, dns, plan.BaselineAddresses)
+	resp.Diagnostics.Append(dgs...)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Create")
+}
+
+// Read - reads dns resource information
+func (r *resourceDNS) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.DNS
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	dns, _, err := r.client.DnsApi.GetDnsById(ctx, id).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading dns",
+			"Could not read dns setting with error "+id+": "+err.Error(),
+		)
+		return
+	}
+
+	state, diags = r.updateState(ctx, dns, state.BaselineAddresses)
+	resp.Diagnostics.Append(diags...)
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Read")
+}
+
+// Update - updates dns resource
+func (r *resourceDNS) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	log.Printf("Started Update")
+
+	var plan models.DNS
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.DNS
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	if !plan.Addresses.Equal(state.Addresses) {
+		_, err := r.client.DnsApi.PatchDnsById(ctx, id).Body(clientgen.DnsModify{
+			Addresses: helper.ListStrings(plan.Addresses),
+		}).Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating dns",
+				"Could not update dns setting "+id+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	dns, _, err := r.client.DnsApi.GetDnsById(ctx, id).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting dns after update",
+			"Could not get dns setting, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state, diags = r.updateState(ctx, dns, plan.BaselineAddresses)
+	resp.Diagnostics.Append(diags...)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Successfully done with Update")
+}
+
+// Delete - restores the baseline DNS servers
+func (r *resourceDNS) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	log.Printf("Started with Delete")
+
+	var state models.DNS
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	// the API requires at least one server, so an empty baseline leaves the servers unchanged
+	if baseline := helper.ListStrings(state.BaselineAddresses); len(baseline) > 0 {
+		_, err := r.client.DnsApi.PatchDnsById(ctx, id).Body(clientgen.DnsModify{
+			Addresses: baseline,
+		}).Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error deleting dns",
+				"Could not restore dns setting "+id+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+	log.Printf("Done with Delete")
+}
+
+// ImportState - imports state for existing dns setting
+func (r *resourceDNS) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+
+	// the servers configured at import become the baseline
+	dns, _, err := r.client.DnsApi.GetDnsById(ctx, req.ID).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing dns",
+			"Could not read dns setting with error "+req.ID+": "+err.Error(),
+		)
+		return
+	}
+	baseline, diags := types.ListValueFrom(ctx, types.StringType, dns.Addresses)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("baseline_addresses"), baseline)...)
+}
+
+// updateState - converts the dns response to the resource state
+func (r *resourceDNS) updateState(ctx context.Context, dns *clientgen.DnsInstance, baseline types.List) (models.DNS, diag.Diagnostics) {
+	addresses, diags := types.ListValueFrom(ctx, types.StringType, dns.Addresses)
+	return models.DNS{
+		ID:                helper.TfString(dns.Id),
+		Addresses:         addresses,
+		BaselineAddresses: baseline,
+	}, diags
+}
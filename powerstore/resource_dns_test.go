@@ -0,0 +1,81 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Test to Create, Update, Import and Delete DNS Resource
+func TestAccDNS(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfigForTesting + DNSParamsTooManyAddresses,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value"),
+			},
+			{
+				Config: ProviderConfigForTesting + DNSParamsCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerstore_dns.test", "addresses.#", "2"),
+					resource.TestCheckResourceAttrSet("powerstore_dns.test", "baseline_addresses.#"),
+				),
+			},
+			// Import Testing
+			{
+				Config:                  ProviderConfigForTesting + DNSParamsCreate,
+				ResourceName:            "powerstore_dns.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"baseline_addresses"},
+			},
+			{
+				Config: ProviderConfigForTesting + DNSParamsUpdate,
+				Check:  resource.TestCheckResourceAttr("powerstore_dns.test", "addresses.#", "1"),
+			},
+		},
+	})
+}
+
+var DNSParamsTooManyAddresses = `
+resource "powerstore_dns" "test" {
+	addresses = ["10.230.24.1", "10.230.24.2", "10.230.24.3", "10.230.24.4"]
+}
+`
+
+var DNSParamsCreate = `
+resource "powerstore_dns" "test" {
+	addresses = [` + dnsAddresses + `]
+}
+`
+
+var DNSParamsUpdate = `
+resource "powerstore_dns" "test" {
+	addresses = ["10.230.24.1"]
+}
+`
@@ -0,0 +1,302 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"terraform-provider-powerstore/client"
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+)
+
+// emailNotifyDestinationSelect lists the email notification destination fields read by the resource
+const emailNotifyDestinationSelect = "id,email_address,notify_critical,notify_major,notify_minor,notify_info"
+
+// newEmailNotifyDestinationResource returns email notify destination new resource instance
+func newEmailNotifyDestinationResource() resource.Resource {
+	return &resourceEmailNotifyDestination{}
+}
+
+type resourceEmailNotifyDestination struct {
+	client *clientgen.APIClient
+}
+
+// Metadata defines resource interface Metadata method
+func (r *resourceEmailNotifyDestination) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_email_notify_destination"
+}
+
+// Schema defines resource interface Schema method
+func (r *resourceEmailNotifyDestination) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage the email addresses receiving alert notifications from PowerStore Array, along with the severities of the alerts sent to each address. We can Create, Update and Delete the email notification destination using this resource. We can also import an existing email notification destination from PowerStore array.",
+		Description:         "This resource is used to manage the email addresses receiving alert notifications from PowerStore Array, along with the severities of the alerts sent to each address. We can Create, Update and Delete the email notification destination using this resource. We can also import an existing email notification destination from PowerStore array.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Unique identifier of the email notification destination.",
+				MarkdownDescription: "Unique identifier of the email notification destination.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"email_address": schema.StringAttribute{
+				Description:         "Email address to receive notifications.",
+				MarkdownDescription: "Email address to receive notifications.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"notify_critical": schema.BoolAttribute{
+				Description:         "Whether to send notifications for critical alerts.",
+				MarkdownDescription: "Whether to send notifications for critical alerts.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"notify_major": schema.BoolAttribute{
+				Description:         "Whether to send notifications for major alerts.",
+				MarkdownDescription: "Whether to send notifications for major alerts.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"notify_minor": schema.BoolAttribute{
+				Description:         "Whether to send notifications for minor alerts.",
+				MarkdownDescription: "Whether to send notifications for minor alerts.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"notify_info": schema.BoolAttribute{
+				Description:         "Whether to send notifications for informational alerts.",
+				MarkdownDescription: "Whether to send notifications for informational alerts.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure - defines configuration for email notify destination resource
+func (r *resourceEmailNotifyDestination) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client.GenClient
+}
+
+// Create - method to create email notify destination resource
+func (r *resourceEmailNotifyDestination) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.EmailNotifyDestination
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createResp, _, err := r.client.EmailNotifyDestinationApi.PostAllEmailNotifyDestinations(ctx).Body(clientgen.EmailNotifyDestinationCreate{
+		EmailAddress:   plan.EmailAddress.ValueString(),
+		NotifyCritical: helper.ValueToPointer[bool](plan.NotifyCritical),
+		NotifyMajor:    helper.ValueToPointer[bool](plan.NotifyMajor),
+		NotifyMinor:    helper.ValueToPointer[bool](plan.NotifyMinor),
+		NotifyInfo:     helper.ValueToPointer[bool](plan.NotifyInfo),
+	}).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating email notify destination",
+			"Could not create email notify destination, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	destination, err := r.ReadAPI(ctx, helper.TfString(createResp.Id).ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting email notify destination after creation",
+			"Could not get email notify destination, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state := r.updateState(destination)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Create")
+}
+
+// Read - reads email notify destination resource information
+func (r *resourceEmailNotifyDestination) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.EmailNotifyDestination
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	destination, err := r.ReadAPI(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading email notify destination",
+			"Could not read email notify destination with error "+id+": "+err.Error(),
+		)
+		return
+	}
+
+	state = r.updateState(destination)
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Read")
+}
+
+// Update - updates email notify destination resource
+func (r *resourceEmailNotifyDestination) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	log.Printf("Started Update")
+
+	var plan models.EmailNotifyDestination
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.EmailNotifyDestination
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	_, err := r.client.EmailNotifyDestinationApi.PatchEmailNotifyDestinationById(ctx, id).Body(clientgen.EmailNotifyDestinationModify{
+		EmailAddress:   helper.ValueToPointer[string](plan.EmailAddress),
+		NotifyCritical: helper.ValueToPointer[bool](plan.NotifyCritical),
+		NotifyMajor:    helper.ValueToPointer[bool](plan.NotifyMajor),
+		NotifyMinor:    helper.ValueToPointer[bool](plan.NotifyMinor),
+		NotifyInfo:     helper.ValueToPointer[bool](plan.NotifyInfo),
+	}).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating email notify destination",
+			"Could not update email notify destination "+id+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	destination, err := r.ReadAPI(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting email notify destination after update",
+			"Could not get email notify destination, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state = r.updateState(destination)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Successfully done with Update")
+}
+
+// Delete - method to delete email notify destination resource
+func (r *resourceEmailNotifyDestination) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	log.Printf("Started with Delete")
+
+	var state models.EmailNotifyDestination
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	_, err := r.client.EmailNotifyDestinationApi.DeleteEmailNotifyDestinationById(ctx, id).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting email notify destination",
+			"Could not delete email notify destination "+id+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	log.Printf("Done with Delete")
+}
+
+// ImportState - imports state for existing email notify destination
+func (r *resourceEmailNotifyDestination) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// ReadAPI - fetches the email notification destination by id
+func (r *resourceEmailNotifyDestination) ReadAPI(ctx context.Context, id string) (*clientgen.EmailNotifyDestinationInstance, error) {
+	queries := make(url.Values)
+	queries.Set("select", emailNotifyDestinationSelect)
+	destination, _, err := r.client.EmailNotifyDestinationApi.GetEmailNotifyDestinationById(ctx, id).Queries(queries).Execute()
+	return destination, err
+}
+
+// updateState - converts the email notification destination response to the resource state
+func (r *resourceEmailNotifyDestination) updateState(destination *clientgen.EmailNotifyDestinationInstance) models.EmailNotifyDestination {
+	return models.EmailNotifyDestination{
+		ID:             helper.TfString(destination.Id),
+		EmailAddress:   helper.TfString(destination.EmailAddress),
+		NotifyCritical: helper.TfBool(destination.NotifyCritical),
+		NotifyMajor:    helper.TfBool(destination.NotifyMajor),
+		NotifyMinor:    helper.TfBool(destination.NotifyMinor),
+		NotifyInfo:     helper.TfBool(destination.NotifyInfo),
+	}
+}
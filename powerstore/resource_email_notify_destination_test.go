@@ -0,0 +1,92 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Test to Create, Update, Import and Delete Email Notify Destination Resource
+func TestAccEmailNotifyDestination(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfigForTesting + EmailNotifyDestinationParamsEmptyAddress,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Length"),
+			},
+			{
+				Config: ProviderConfigForTesting + EmailNotifyDestinationParamsCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerstore_email_notify_destination.test", "email_address", notifyEmailAddress),
+					resource.TestCheckResourceAttr("powerstore_email_notify_destination.test", "notify_critical", "true"),
+					resource.TestCheckResourceAttr("powerstore_email_notify_destination.test", "notify_info", "false"),
+				),
+			},
+			// Import Testing
+			{
+				Config:            ProviderConfigForTesting + EmailNotifyDestinationParamsCreate,
+				ResourceName:      "powerstore_email_notify_destination.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: ProviderConfigForTesting + EmailNotifyDestinationParamsUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerstore_email_notify_destination.test", "notify_minor", "false"),
+					resource.TestCheckResourceAttr("powerstore_email_notify_destination.test", "notify_info", "true"),
+				),
+			},
+		},
+	})
+}
+
+var EmailNotifyDestinationParamsEmptyAddress = `
+resource "powerstore_email_notify_destination" "test" {
+	email_address = ""
+}
+`
+
+var EmailNotifyDestinationParamsCreate = `
+resource "powerstore_email_notify_destination" "test" {
+	email_address = "` + notifyEmailAddress + `"
+	notify_critical = true
+	notify_major = true
+	notify_minor = true
+	notify_info = false
+}
+`
+
+var EmailNotifyDestinationParamsUpdate = `
+resource "powerstore_email_notify_destination" "test" {
+	email_address = "` + notifyEmailAddress + `"
+	notify_critical = true
+	notify_major = true
+	notify_minor = false
+	notify_info = true
+}
+`
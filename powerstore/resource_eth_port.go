@@ -0,0 +1,396 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-powerstore/client"
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+)
+
+// ethPortSelect lists the Ethernet port fields read by the eth port resource
+const ethPortSelect = "id,name,requested_speed,current_speed,supported_speeds,is_link_up,current_mtu,mac_address,appliance_id,node_id,bond_id,partner_id"
+
+// newEthPortResource returns eth port new resource instance
+func newEthPortResource() resource.Resource {
+	return &resourceEthPort{}
+}
+
+type resourceEthPort struct {
+	client    *clientgen.APIClient
+	allclient *client.Client
+}
+
+// Metadata defines resource interface Metadata method
+func (r *resourceEthPort) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_eth_port"
+}
+
+// Schema defines resource interface Schema method
+func (r *resourceEthPort) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage the settings of an existing front-end Ethernet port of PowerStore Array. Ports are hardware entities, so creating this resource adopts the port and destroying it only removes it from the Terraform state. The only setting that can be changed through the PowerStore REST API is the requested speed; the MTU is managed cluster-wide and the link state is read-only. We can also import an existing Ethernet port from PowerStore array.",
+		Description:         "This resource is used to manage the settings of an existing front-end Ethernet port of PowerStore Array. Ports are hardware entities, so creating this resource adopts the port and destroying it only removes it from the Terraform state. The only setting that can be changed through the PowerStore REST API is the requested speed; the MTU is managed cluster-wide and the link state is read-only. We can also import an existing Ethernet port from PowerStore array.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Unique identifier of the Ethernet port. Exactly one of `id` and `name` must be provided.",
+				MarkdownDescription: "Unique identifier of the Ethernet port. Exactly one of `id` and `name` must be provided.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description:         "Name of the Ethernet port. Exactly one of `id` and `name` must be provided.",
+				MarkdownDescription: "Name of the Ethernet port. Exactly one of `id` and `name` must be provided.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"requested_speed": schema.StringAttribute{
+				Description:         "Speed requested for the Ethernet port. If not specified, the current setting of the port is retained.",
+				MarkdownDescription: "Speed requested for the Ethernet port. If not specified, the current setting of the port is retained.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(helper.SliceTransform(clientgen.AllowedEthPortSpeedEnumEnumValues, func(in clientgen.EthPortSpeedEnum) string {
+						return string(in)
+					})...),
+				},
+			},
+			"current_speed": schema.StringAttribute{
+				Description:         "Current speed of the Ethernet port.",
+				MarkdownDescription: "Current speed of the Ethernet port.",
+				Computed:            true,
+			},
+			"supported_speeds": schema.ListAttribute{
+				Description:         "Speeds supported by the Ethernet port.",
+				MarkdownDescription: "Speeds supported by the Ethernet port.",
+				Computed:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_link_up": schema.BoolAttribute{
+				Description:         "Indicates whether the port link is up.",
+				MarkdownDescription: "Indicates whether the port link is up.",
+				Computed:            true,
+			},
+			"current_mtu": schema.Int64Attribute{
+				Description:         "Current MTU of the Ethernet port.",
+				MarkdownDescription: "Current MTU of the Ethernet port.",
+				Computed:            true,
+			},
+			"mac_address": schema.StringAttribute{
+				Description:         "Current MAC address of the Ethernet port.",
+				MarkdownDescription: "Current MAC address of the Ethernet port.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"appliance_id": schema.StringAttribute{
+				Description:         "Unique identifier of the appliance containing the port.",
+				MarkdownDescription: "Unique identifier of the appliance containing the port.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"node_id": schema.StringAttribute{
+				Description:         "Unique identifier of the node containing the port.",
+				MarkdownDescription: "Unique identifier of the node containing the port.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"bond_id": schema.StringAttribute{
+				Description:         "Unique identifier of the bond the port belongs to, if any.",
+				MarkdownDescription: "Unique identifier of the bond the port belongs to, if any.",
+				Computed:            true,
+			},
+			"partner_id": schema.StringAttribute{
+				Description:         "Unique identifier of the partner port on the peer node.",
+				MarkdownDescription: "Unique identifier of the partner port on the peer node.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure - defines configuration for eth port resource
+func (r *resourceEthPort) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client.GenClient
+	r.allclient = client
+}
+
+// Create - adopts an existing Ethernet port and applies the requested speed
+func (r *resourceEthPort) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.EthPort
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	port, err := r.fetchPort(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating eth port",
+			"Could not find eth port, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	id := helper.TfString(port.Id).ValueString()
+	if err := r.modify(ctx, id, plan, port); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating eth port",
+			"Could not update eth port "+id+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	port, err = r.ReadAPI(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting eth port after creation",
+			"Could not get eth port, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state, dgs := r.updateState(ctx, port)
+	resp.Diagnostics.Append(dgs...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Create")
+}
+
+// Read - reads eth port resource information
+func (r *resourceEthPort) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.EthPort
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	port, err := r.ReadAPI(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading eth port",
+			"Could not read eth port with error "+id+": "+err.Error(),
+		)
+		return
+	}
+
+	state, dgs := r.updateState(ctx, port)
+	resp.Diagnostics.Append(dgs...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Read")
+}
+
+// Update - updates the requested speed of the eth port
+func (r *resourceEthPort) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	log.Printf("Started Update")
+
+	var plan models.EthPort
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.EthPort
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	port, err := r.ReadAPI(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating eth port",
+			"Could not read eth port with error "+id+": "+err.Error(),
+		)
+		return
+	}
+
+	if err := r.modify(ctx, id, plan, port); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating eth port",
+			"Could not update eth port "+id+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	port, err = r.ReadAPI(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting eth port after update",
+			"Could not get eth port, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state, dgs := r.updateState(ctx, port)
+	resp.Diagnostics.Append(dgs...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Successfully done with Update")
+}
+
+// Delete - removes the eth port from the state, the port itself is left untouched
+func (r *resourceEthPort) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	log.Printf("Started with Delete")
+	resp.State.RemoveResource(ctx)
+	log.Printf("Done with Delete")
+}
+
+// ImportState - imports state for existing eth port
+func (r *resourceEthPort) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// ReadAPI - fetches the eth port by id
+func (r *resourceEthPort) ReadAPI(ctx context.Context, id string) (*clientgen.EthPortInstance, error) {
+	queries := make(url.Values)
+	queries.Set("select", ethPortSelect)
+	port, _, err := r.client.EthPortApi.GetEthPortById(ctx, id).Queries(queries).Execute()
+	return port, err
+}
+
+// fetchPort - fetches the eth port referenced by either id or name in the plan
+func (r *resourceEthPort) fetchPort(ctx context.Context, plan models.EthPort) (*clientgen.EthPortInstance, error) {
+	if helper.IsKnownValue(plan.ID) {
+		return r.ReadAPI(ctx, plan.ID.ValueString())
+	}
+	queries := make(url.Values)
+	queries.Set("select", ethPortSelect)
+	queries.Set("name", "eq."+plan.Name.ValueString())
+	ports, _, err := r.client.EthPortApi.GetAllEthPorts(ctx).Queries(queries).Execute()
+	if err != nil {
+		return nil, err
+	}
+	if len(ports) != 1 {
+		return nil, fmt.Errorf("expected exactly one eth port with name %s, found %d", plan.Name.ValueString(), len(ports))
+	}
+	return &ports[0], nil
+}
+
+// modify - patches the requested speed if it differs from the current setting
+func (r *resourceEthPort) modify(ctx context.Context, id string, plan models.EthPort, port *clientgen.EthPortInstance) error {
+	if !helper.IsKnownValue(plan.RequestedSpeed) || plan.RequestedSpeed.Equal(helper.TfString(port.RequestedSpeed)) {
+		return nil
+	}
+	return r.allclient.WithMaintenanceWindow(ctx, func() error {
+		_, err := r.client.EthPortApi.PatchEthPortById(ctx, id).Body(clientgen.EthPortModify{
+			RequestedSpeed: clientgen.EthPortSpeedEnum(plan.RequestedSpeed.ValueString()),
+		}).Execute()
+		return err
+	})
+}
+
+// updateState - converts the eth port response to the resource state
+func (r *resourceEthPort) updateState(ctx context.Context, port *clientgen.EthPortInstance) (models.EthPort, diag.Diagnostics) {
+	speeds, diags := types.ListValueFrom(ctx, types.StringType, helper.SliceTransform(port.SupportedSpeeds, func(in clientgen.EthPortSpeedEnum) string {
+		return string(in)
+	}))
+	return models.EthPort{
+		ID:              helper.TfString(port.Id),
+		Name:            helper.TfString(port.Name),
+		RequestedSpeed:  helper.TfString(port.RequestedSpeed),
+		CurrentSpeed:    helper.TfString(port.CurrentSpeed),
+		SupportedSpeeds: speeds,
+		IsLinkUp:        helper.TfBool(port.IsLinkUp),
+		CurrentMTU:      helper.TfInt64(port.CurrentMtu),
+		MacAddress:      helper.TfString(port.MacAddress),
+		ApplianceID:     helper.TfString(port.ApplianceId),
+		NodeID:          helper.TfString(port.NodeId),
+		BondID:          helper.TfString(port.BondId),
+		PartnerID:       helper.TfString(port.PartnerId),
+	}, diags
+}
@@ -0,0 +1,113 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Test to manage Ethernet Port Resource
+func TestAccEthPort(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfigForTesting + EthPortParamsInvalidName,
+				ExpectError: regexp.MustCompile("Error creating eth port"),
+			},
+			{
+				Config:      ProviderConfigForTesting + EthPortParamsInvalidSpeed,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+			{
+				Config:      ProviderConfigForTesting + EthPortParamsIDAndName,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			{
+				Config: ProviderConfigForTesting + EthPortParamsCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerstore_eth_port.test", "name", ethPortName),
+					resource.TestCheckResourceAttr("powerstore_eth_port.test", "requested_speed", "10_Gbps"),
+					resource.TestCheckResourceAttrSet("powerstore_eth_port.test", "id"),
+				),
+			},
+			// Import Testing
+			{
+				Config:            ProviderConfigForTesting + EthPortParamsCreate,
+				ResourceName:      "powerstore_eth_port.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Import Negative Testing
+			{
+				Config:        ProviderConfigForTesting + EthPortParamsCreate,
+				ResourceName:  "powerstore_eth_port.test",
+				ImportState:   true,
+				ExpectError:   regexp.MustCompile("Error reading eth port"),
+				ImportStateId: "invalid-id",
+			},
+			{
+				Config: ProviderConfigForTesting + EthPortParamsUpdate,
+				Check:  resource.TestCheckResourceAttr("powerstore_eth_port.test", "requested_speed", "Auto"),
+			},
+		},
+	})
+}
+
+var EthPortParamsInvalidName = `
+resource "powerstore_eth_port" "test" {
+	name = "invalid-port-name"
+}
+`
+
+var EthPortParamsInvalidSpeed = `
+resource "powerstore_eth_port" "test" {
+	name = "` + ethPortName + `"
+	requested_speed = "invalid"
+}
+`
+
+var EthPortParamsIDAndName = `
+resource "powerstore_eth_port" "test" {
+	id = "invalid-id"
+	name = "` + ethPortName + `"
+}
+`
+
+var EthPortParamsCreate = `
+resource "powerstore_eth_port" "test" {
+	name = "` + ethPortName + `"
+	requested_speed = "10_Gbps"
+}
+`
+
+var EthPortParamsUpdate = `
+resource "powerstore_eth_port" "test" {
+	name = "` + ethPortName + `"
+	requested_speed = "Auto"
+}
+`
@@ -0,0 +1,401 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-powerstore/client"
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+)
+
+// fcPortSelect lists the FC port fields read by the fc port resource
+const fcPortSelect = "id,name,requested_speed,current_speed,supported_speeds,is_link_up,wwn,scsi_mode,protocols,appliance_id,node_id,partner_id"
+
+// newFcPortResource returns fc port new resource instance
+func newFcPortResource() resource.Resource {
+	return &resourceFcPort{}
+}
+
+type resourceFcPort struct {
+	client    *clientgen.APIClient
+	allclient *client.Client
+}
+
+// Metadata defines resource interface Metadata method
+func (r *resourceFcPort) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_fc_port"
+}
+
+// Schema defines resource interface Schema method
+func (r *resourceFcPort) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage the settings of an existing front-end FC port of PowerStore Array. Ports are hardware entities, so creating this resource adopts the port and destroying it only removes it from the Terraform state. The only setting that can be changed through the PowerStore REST API is the requested speed; the SCSI mode, protocols and link state are read-only. We can also import an existing FC port from PowerStore array.",
+		Description:         "This resource is used to manage the settings of an existing front-end FC port of PowerStore Array. Ports are hardware entities, so creating this resource adopts the port and destroying it only removes it from the Terraform state. The only setting that can be changed through the PowerStore REST API is the requested speed; the SCSI mode, protocols and link state are read-only. We can also import an existing FC port from PowerStore array.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Unique identifier of the FC port. Exactly one of `id` and `name` must be provided.",
+				MarkdownDescription: "Unique identifier of the FC port. Exactly one of `id` and `name` must be provided.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description:         "Name of the FC port. Exactly one of `id` and `name` must be provided.",
+				MarkdownDescription: "Name of the FC port. Exactly one of `id` and `name` must be provided.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"requested_speed": schema.StringAttribute{
+				Description:         "Speed requested for the FC port. If not specified, the current setting of the port is retained.",
+				MarkdownDescription: "Speed requested for the FC port. If not specified, the current setting of the port is retained.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(helper.SliceTransform(clientgen.AllowedFcPortSpeedEnumEnumValues, func(in clientgen.FcPortSpeedEnum) string {
+						return string(in)
+					})...),
+				},
+			},
+			"current_speed": schema.StringAttribute{
+				Description:         "Current speed of the FC port.",
+				MarkdownDescription: "Current speed of the FC port.",
+				Computed:            true,
+			},
+			"supported_speeds": schema.ListAttribute{
+				Description:         "Speeds supported by the FC port.",
+				MarkdownDescription: "Speeds supported by the FC port.",
+				Computed:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_link_up": schema.BoolAttribute{
+				Description:         "Indicates whether the port link is up.",
+				MarkdownDescription: "Indicates whether the port link is up.",
+				Computed:            true,
+			},
+			"wwn": schema.StringAttribute{
+				Description:         "World Wide Name (WWN) of the FC port.",
+				MarkdownDescription: "World Wide Name (WWN) of the FC port.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"scsi_mode": schema.StringAttribute{
+				Description:         "SCSI mode of the FC port, either Dual or Target. This setting cannot be changed through the REST API.",
+				MarkdownDescription: "SCSI mode of the FC port, either `Dual` or `Target`. This setting cannot be changed through the REST API.",
+				Computed:            true,
+			},
+			"protocols": schema.ListAttribute{
+				Description:         "Protocols enabled on the FC port.",
+				MarkdownDescription: "Protocols enabled on the FC port.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"appliance_id": schema.StringAttribute{
+				Description:         "Unique identifier of the appliance containing the port.",
+				MarkdownDescription: "Unique identifier of the appliance containing the port.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"node_id": schema.StringAttribute{
+				Description:         "Unique identifier of the node containing the port.",
+				MarkdownDescription: "Unique identifier of the node containing the port.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"partner_id": schema.StringAttribute{
+				Description:         "Unique identifier of the partner port on the peer node.",
+				MarkdownDescription: "Unique identifier of the partner port on the peer node.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure - defines configuration for fc port resource
+func (r *resourceFcPort) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client.GenClient
+	r.allclient = client
+}
+
+// Create - adopts an existing FC port and applies the requested speed
+func (r *resourceFcPort) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.FcPort
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	port, err := r.fetchPort(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating fc port",
+			"Could not find fc port, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	id := helper.TfString(port.Id).ValueString()
+	if err := r.modify(ctx, id, plan, port); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating fc port",
+			"Could not update fc port "+id+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	port, err = r.ReadAPI(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting fc port after creation",
+			"Could not get fc port, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state, dgs := r.updateState(ctx, port)
+	resp.Diagnostics.Append(dgs...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Create")
+}
+
+// Read - reads fc port resource information
+func (r *resourceFcPort) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.FcPort
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	port, err := r.ReadAPI(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading fc port",
+			"Could not read fc port with error "+id+": "+err.Error(),
+		)
+		return
+	}
+
+	state, dgs := r.updateState(ctx, port)
+	resp.Diagnostics.Append(dgs...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Read")
+}
+
+// Update - updates the requested speed of the fc port
+func (r *resourceFcPort) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	log.Printf("Started Update")
+
+	var plan models.FcPort
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.FcPort
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	port, err := r.ReadAPI(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating fc port",
+			"Could not read fc port with error "+id+": "+err.Error(),
+		)
+		return
+	}
+
+	if err := r.modify(ctx, id, plan, port); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating fc port",
+			"Could not update fc port "+id+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	port, err = r.ReadAPI(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting fc port after update",
+			"Could not get fc port, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state, dgs := r.updateState(ctx, port)
+	resp.Diagnostics.Append(dgs...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Successfully done with Update")
+}
+
+// Delete - removes the fc port from the state, the port itself is left untouched
+func (r *resourceFcPort) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	log.Printf("Started with Delete")
+	resp.State.RemoveResource(ctx)
+	log.Printf("Done with Delete")
+}
+
+// ImportState - imports state for existing fc port
+func (r *resourceFcPort) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// ReadAPI - fetches the fc port by id
+func (r *resourceFcPort) ReadAPI(ctx context.Context, id string) (*clientgen.FcPortInstance, error) {
+	queries := make(url.Values)
+	queries.Set("select", fcPortSelect)
+	port, _, err := r.client.FcPortApi.GetFcPortById(ctx, id).Queries(queries).Execute()
+	return port, err
+}
+
+// fetchPort - fetches the fc port referenced by either id or name in the plan
+func (r *resourceFcPort) fetchPort(ctx context.Context, plan models.FcPort) (*clientgen.FcPortInstance, error) {
+	if helper.IsKnownValue(plan.ID) {
+		return r.ReadAPI(ctx, plan.ID.ValueString())
+	}
+	queries := make(url.Values)
+	queries.Set("select", fcPortSelect)
+	queries.Set("name", "eq."+plan.Name.ValueString())
+	ports, _, err := r.client.FcPortApi.GetAllFcPorts(ctx).Queries(queries).Execute()
+	if err != nil {
+		return nil, err
+	}
+	if len(ports) != 1 {
+		return nil, fmt.Errorf("expected exactly one fc port with name %s, found %d", plan.Name.ValueString(), len(ports))
+	}
+	return &ports[0], nil
+}
+
+// modify - patches the requested speed if it differs from the current setting
+func (r *resourceFcPort) modify(ctx context.Context, id string, plan models.FcPort, port *clientgen.FcPortInstance) error {
+	if !helper.IsKnownValue(plan.RequestedSpeed) || plan.RequestedSpeed.Equal(helper.TfString(port.RequestedSpeed)) {
+		return nil
+	}
+	return r.allclient.WithMaintenanceWindow(ctx, func() error {
+		_, err := r.client.FcPortApi.PatchFcPortById(ctx, id).Body(clientgen.FcPortModify{
+			RequestedSpeed: clientgen.FcPortSpeedEnum(plan.RequestedSpeed.ValueString()),
+		}).Execute()
+		return err
+	})
+}
+
+// updateState - converts the fc port response to the resource state
+func (r *resourceFcPort) updateState(ctx context.Context, port *clientgen.FcPortInstance) (models.FcPort, diag.Diagnostics) {
+	speeds, diags := types.ListValueFrom(ctx, types.StringType, helper.SliceTransform(port.SupportedSpeeds, func(in clientgen.FcPortSpeedEnum) string {
+		return string(in)
+	}))
+	protocols, dgs := types.ListValueFrom(ctx, types.StringType, helper.SliceTransform(port.Protocols, func(in clientgen.FcPortProtocolEnum) string {
+		return string(in)
+	}))
+	diags.Append(dgs...)
+	return models.FcPort{
+		ID:              helper.TfString(port.Id),
+		Name:            helper.TfString(port.Name),
+		RequestedSpeed:  helper.TfString(port.RequestedSpeed),
+		CurrentSpeed:    helper.TfString(port.CurrentSpeed),
+		SupportedSpeeds: speeds,
+		IsLinkUp:        helper.TfBool(port.IsLinkUp),
+		WWN:             helper.TfString(port.Wwn),
+		ScsiMode:        helper.TfString(port.ScsiMode),
+		Protocols:       protocols,
+		ApplianceID:     helper.TfString(port.ApplianceId),
+		NodeID:          helper.TfString(port.NodeId),
+		PartnerID:       helper.TfString(port.PartnerId),
+	}, diags
+}
@@ -0,0 +1,113 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Test to manage FC Port Resource
+func TestAccFcPort(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfigForTesting + FcPortParamsInvalidName,
+				ExpectError: regexp.MustCompile("Error creating fc port"),
+			},
+			{
+				Config:      ProviderConfigForTesting + FcPortParamsInvalidSpeed,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+			{
+				Config:      ProviderConfigForTesting + FcPortParamsIDAndName,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			{
+				Config: ProviderConfigForTesting + FcPortParamsCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerstore_fc_port.test", "name", fcPortName),
+					resource.TestCheckResourceAttr("powerstore_fc_port.test", "requested_speed", "16_Gbps"),
+					resource.TestCheckResourceAttrSet("powerstore_fc_port.test", "id"),
+				),
+			},
+			// Import Testing
+			{
+				Config:            ProviderConfigForTesting + FcPortParamsCreate,
+				ResourceName:      "powerstore_fc_port.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Import Negative Testing
+			{
+				Config:        ProviderConfigForTesting + FcPortParamsCreate,
+				ResourceName:  "powerstore_fc_port.test",
+				ImportState:   true,
+				ExpectError:   regexp.MustCompile("Error reading fc port"),
+				ImportStateId: "invalid-id",
+			},
+			{
+				Config: ProviderConfigForTesting + FcPortParamsUpdate,
+				Check:  resource.TestCheckResourceAttr("powerstore_fc_port.test", "requested_speed", "Auto"),
+			},
+		},
+	})
+}
+
+var FcPortParamsInvalidName = `
+resource "powerstore_fc_port" "test" {
+	name = "invalid-port-name"
+}
+`
+
+var FcPortParamsInvalidSpeed = `
+resource "powerstore_fc_port" "test" {
+	name = "` + fcPortName + `"
+	requested_speed = "invalid"
+}
+`
+
+var FcPortParamsIDAndName = `
+resource "powerstore_fc_port" "test" {
+	id = "invalid-id"
+	name = "` + fcPortName + `"
+}
+`
+
+var FcPortParamsCreate = `
+resource "powerstore_fc_port" "test" {
+	name = "` + fcPortName + `"
+	requested_speed = "16_Gbps"
+}
+`
+
+var FcPortParamsUpdate = `
+resource "powerstore_fc_port" "test" {
+	name = "` + fcPortName + `"
+	requested_speed = "Auto"
+}
+`
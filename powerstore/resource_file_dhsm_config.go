@@ -0,0 +1,319 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-powerstore/client"
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+)
+
+// fileDhsmConfigSelect lists the DHSM fields read by the file dhsm config resource
+const fileDhsmConfigSelect = "id,nas_server_id,user_name"
+
+// newFileDhsmConfigResource returns file dhsm config new resource instance
+func newFileDhsmConfigResource() resource.Resource {
+	return &resourceFileDhsmConfig{}
+}
+
+type resourceFileDhsmConfig struct {
+	client *clientgen.APIClient
+}
+
+// Metadata defines resource interface Metadata method
+func (r *resourceFileDhsmConfig) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_file_dhsm_config"
+}
+
+// Schema defines resource interface Schema method
+func (r *resourceFileDhsmConfig) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage the DHSM (Distributed Hierarchical Storage Management) service of a NAS server on PowerStore Array, used by the archiving applications to stub files to secondary storage. We can Create, Update and Delete the DHSM service using this resource. We can also import an existing DHSM service from PowerStore array.",
+		Description:         "This resource is used to manage the DHSM (Distributed Hierarchical Storage Management) service of a NAS server on PowerStore Array, used by the archiving applications to stub files to secondary storage. We can Create, Update and Delete the DHSM service using this resource. We can also import an existing DHSM service from PowerStore array.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Unique identifier of the DHSM service.",
+				MarkdownDescription: "Unique identifier of the DHSM service.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"nas_server_id": schema.StringAttribute{
+				Description:         "Unique identifier of the NAS server the DHSM service is configured on.",
+				MarkdownDescription: "Unique identifier of the NAS server the DHSM service is configured on.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_name": schema.StringAttribute{
+				Description:         "User name used by the archiving application to authenticate to the DHSM service.",
+				MarkdownDescription: "User name used by the archiving application to authenticate to the DHSM service.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"password": schema.StringAttribute{
+				Description:         "Password used by the archiving application to authenticate to the DHSM service. This attribute is write-only and is never stored in the state, change password_version to set a new password.",
+				MarkdownDescription: "Password used by the archiving application to authenticate to the DHSM service. This attribute is write-only and is never stored in the state, change `password_version` to set a new password.",
+				Required:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 15),
+				},
+			},
+			"password_version": schema.Int64Attribute{
+				Description:         "Version of the password. Since the password is not stored in the state, changes to it are only applied when this value changes.",
+				MarkdownDescription: "Version of the password. Since the password is not stored in the state, changes to it are only applied when this value changes.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// Configure - defines configuration for file dhsm config resource
+func (r *resourceFileDhsmConfig) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client.GenClient
+}
+
+// Create - method to create file dhsm config resource
+func (r *resourceFileDhsmConfig) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.FileDhsmConfig
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// write-only attributes are only available in the configuration
+	var password types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &password)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createResp, _, err := r.client.FileDhsmConfigApi.PostAllFileDhsmConfigs(ctx).Body(clientgen.FileDhsmConfigCreate{
+		NasServerId: plan.NasServerID.ValueString(),
+		UserName:    plan.UserName.ValueString(),
+		Password:    password.ValueString(),
+	}).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating file dhsm config",
+			"Could not create file dhsm config, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	dhsmConfig, err := r.ReadAPI(ctx, helper.TfString(createResp.Id).ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting file dhsm config after creation",
+			"Could not get file dhsm config, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state := r.updateState(dhsmConfig, plan.PasswordVersion)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Create")
+}
+
+// Read - reads file dhsm config resource information
+func (r *resourceFileDhsmConfig) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.FileDhsmConfig
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	dhsmConfig, err := r.ReadAPI(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading file dhsm config",
+			"Could not read file dhsm config with error "+id+": "+err.Error(),
+		)
+		return
+	}
+
+	state = r.updateState(dhsmConfig, state.PasswordVersion)
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Read")
+}
+
+// Update - updates file dhsm config resource
+func (r *resourceFileDhsmConfig) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	log.Printf("Started Update")
+
+	var plan models.FileDhsmConfig
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.FileDhsmConfig
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dhsmModify := clientgen.FileDhsmConfigModify{}
+	if !plan.UserName.Equal(state.UserName) {
+		dhsmModify.UserName = helper.ValueToPointer[string](plan.UserName)
+	}
+	if !plan.PasswordVersion.Equal(state.PasswordVersion) {
+		var password types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &password)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		dhsmModify.Password = helper.ValueToPointer[string](password)
+	}
+
+	id := state.ID.ValueString()
+	if dhsmModify != (clientgen.FileDhsmConfigModify{}) {
+		_, err := r.client.FileDhsmConfigApi.PatchFileDhsmConfigById(ctx, id).Body(dhsmModify).Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating file dhsm config",
+				"Could not update file dhsm config "+id+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	dhsmConfig, err := r.ReadAPI(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting file dhsm config after update",
+			"Could not get file dhsm config, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state = r.updateState(dhsmConfig, plan.PasswordVersion)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Successfully done with Update")
+}
+
+// Delete - method to delete file dhsm config resource
+func (r *resourceFileDhsmConfig) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	log.Printf("Started with Delete")
+
+	var state models.FileDhsmConfig
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	_, err := r.client.FileDhsmConfigApi.DeleteFileDhsmConfigById(ctx, id).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting file dhsm config",
+			"Could not delete file dhsm config "+id+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	log.Printf("Done with Delete")
+}
+
+// ImportState - imports state for existing file dhsm config using its id or the id of its NAS server
+func (r *resourceFileDhsmConfig) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	queries := make(url.Values)
+	queries.Set("select", "id")
+	queries.Set("nas_server_id", "eq."+req.ID)
+	dhsmConfigs, _, err := r.client.FileDhsmConfigApi.GetAllFileDhsmConfigs(ctx).Queries(queries).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing file dhsm config",
+			"Could not read file dhsm config of NAS server "+req.ID+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+	id := req.ID
+	if len(dhsmConfigs) > 0 {
+		id = helper.TfString(dhsmConfigs[0].Id).ValueString()
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// ReadAPI - fetches the file dhsm config by id
+func (r *resourceFileDhsmConfig) ReadAPI(ctx context.Context, id string) (*clientgen.FileDhsmConfigInstance, error) {
+	queries := make(url.Values)
+	queries.Set("select", fileDhsmConfigSelect)
+	dhsmConfig, _, err := r.client.FileDhsmConfigApi.GetFileDhsmConfigById(ctx, id).Queries(queries).Execute()
+	return dhsmConfig, err
+}
+
+// updateState - converts the file dhsm config response to the resource state
+func (r *resourceFileDhsmConfig) updateState(dhsmConfig *clientgen.FileDhsmConfigInstance, passwordVersion types.Int64) models.FileDhsmConfig {
+	return models.FileDhsmConfig{
+		ID:              helper.TfString(dhsmConfig.Id),
+		NasServerID:     helper.TfString(dhsmConfig.NasServerId),
+		UserName:        helper.TfString(dhsmConfig.UserName),
+		Password:        types.StringNull(),
+		PasswordVersion: passwordVersion,
+	}
+}
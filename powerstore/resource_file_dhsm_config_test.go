@@ -0,0 +1,93 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Test to Create, Update, Import and Delete File DHSM Config Resource
+func TestAccFileDhsmConfig(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfigForTesting + FileDhsmConfigParamsMissingPassword,
+				ExpectError: regexp.MustCompile("Missing required argument"),
+			},
+			{
+				Config: ProviderConfigForTesting + FileDhsmConfigParamsCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerstore_file_dhsm_config.test", "nas_server_id", nasServerID),
+					resource.TestCheckResourceAttr("powerstore_file_dhsm_config.test", "user_name", "tfacc_dhsm"),
+					resource.TestCheckNoResourceAttr("powerstore_file_dhsm_config.test", "password"),
+				),
+			},
+			// Import Testing using the NAS server id
+			{
+				Config:                  ProviderConfigForTesting + FileDhsmConfigParamsCreate,
+				ResourceName:            "powerstore_file_dhsm_config.test",
+				ImportState:             true,
+				ImportStateId:           nasServerID,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password_version"},
+			},
+			{
+				Config: ProviderConfigForTesting + FileDhsmConfigParamsUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerstore_file_dhsm_config.test", "user_name", "tfacc_dhsm_archive"),
+					resource.TestCheckResourceAttr("powerstore_file_dhsm_config.test", "password_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+var FileDhsmConfigParamsMissingPassword = `
+resource "powerstore_file_dhsm_config" "test" {
+	nas_server_id = "` + nasServerID + `"
+	user_name = "tfacc_dhsm"
+}
+`
+
+var FileDhsmConfigParamsCreate = `
+resource "powerstore_file_dhsm_config" "test" {
+	nas_server_id = "` + nasServerID + `"
+	user_name = "tfacc_dhsm"
+	password = "Password123"
+	password_version = 1
+}
+`
+
+var FileDhsmConfigParamsUpdate = `
+resource "powerstore_file_dhsm_config" "test" {
+	nas_server_id = "` + nasServerID + `"
+	user_name = "tfacc_dhsm_archive"
+	password = "Password456"
+	password_version = 2
+}
+`
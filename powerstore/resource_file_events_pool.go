@@ -0,0 +1,382 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-powerstore/client"
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+)
+
+// fileEventsPoolSelect lists the file events pool fields read by the file events pool resource
+const fileEventsPoolSelect = "id,name,file_events_publisher_servers,file_events_settings,is_replica"
+
+// fileEvents lists the file events that can be published, in the order of the API
+var fileEvents = []string{
+	"open_file_no_access", "open_file_read", "open_file_write", "create_file", "create_dir", "delete_file", "delete_dir",
+	"close_modified", "close_unmodified", "rename_file", "rename_dir", "set_acl_file", "set_acl_dir", "open_dir", "close_dir",
+	"file_read", "file_write", "set_sec_file", "set_sec_dir", "open_file_read_offline", "open_file_write_offline",
+}
+
+// newFileEventsPoolResource returns file events pool new resource instance
+func newFileEventsPoolResource() resource.Resource {
+	return &resourceFileEventsPool{}
+}
+
+type resourceFileEventsPool struct {
+	client *clientgen.APIClient
+}
+
+// Metadata defines resource interface Metadata method
+func (r *resourceFileEventsPool) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_file_events_pool"
+}
+
+// Schema defines resource interface Schema method
+func (r *resourceFileEventsPool) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage the file events pools (CEPA pools) on PowerStore Array. A file events pool is a set of servers running the file event service and the file events sent to them. We can Create, Update and Delete the file events pool using this resource. We can also import an existing file events pool from PowerStore array.",
+		Description:         "This resource is used to manage the file events pools (CEPA pools) on PowerStore Array. A file events pool is a set of servers running the file event service and the file events sent to them. We can Create, Update and Delete the file events pool using this resource. We can also import an existing file events pool from PowerStore array.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Unique identifier of the file events pool.",
+				MarkdownDescription: "Unique identifier of the file events pool.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description:         "Name of the file events pool.",
+				MarkdownDescription: "Name of the file events pool.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"file_events_publisher_servers": schema.SetAttribute{
+				Description:         "IPv4, IPv6 or FQDN addresses of the servers running the file event service.",
+				MarkdownDescription: "IPv4, IPv6 or FQDN addresses of the servers running the file event service.",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeBetween(1, 5),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"file_events_settings": schema.SetNestedAttribute{
+				Description:         "File events sent to the servers, at most one set of events per events category.",
+				MarkdownDescription: "File events sent to the servers, at most one set of events per events category.",
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeBetween(1, 3),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"events_category": schema.StringAttribute{
+							Description:         "Category of the events, one of Pre_Events, Post_Events or Post_Error_Events.",
+							MarkdownDescription: "Category of the events, one of `Pre_Events`, `Post_Events` or `Post_Error_Events`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(helper.SliceTransform(clientgen.AllowedFileEventsCategoryEnumEnumValues, func(in clientgen.FileEventsCategoryEnum) string {
+									return string(in)
+								})...),
+							},
+						},
+						"events": schema.SetAttribute{
+							Description:         "File events sent for the category, for example create_file, delete_file, rename_file or set_acl_file.",
+							MarkdownDescription: "File events sent for the category, for example `create_file`, `delete_file`, `rename_file` or `set_acl_file`.",
+							ElementType:         types.StringType,
+							Required:            true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+								setvalidator.ValueStringsAre(stringvalidator.OneOf(fileEvents...)),
+							},
+						},
+					},
+				},
+			},
+			"is_replica": schema.BoolAttribute{
+				Description:         "Whether the file events pool is a destination of a NAS server replication.",
+				MarkdownDescription: "Whether the file events pool is a destination of a NAS server replication.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure - defines configuration for file events pool resource
+func (r *resourceFileEventsPool) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client.GenClient
+}
+
+// Create - method to create file events pool resource
+func (r *resourceFileEventsPool) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.FileEventsPool
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createResp, _, err := r.client.FileEventsPoolApi.PostAllFileEventsPools(ctx).Body(clientgen.FileEventsPoolCreate{
+		Name:                       plan.Name.ValueString(),
+		FileEventsPublisherServers: helper.SetStrings(plan.FileEventsPublisherServers),
+		FileEventsSettings:         helper.SliceTransform(plan.FileEventsSettings, newFileEventsSettings),
+	}).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating file events pool",
+			"Could not create file events pool, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	pool, err := r.ReadAPI(ctx, helper.TfString(createResp.Id).ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting file events pool after creation",
+			"Could not get file events pool, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state, dgs := r.updateState(ctx, pool)
+	resp.Diagnostics.Append(dgs...)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Create")
+}
+
+// Read - reads file events pool resource information
+func (r *resourceFileEventsPool) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.FileEventsPool
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	pool, err := r.ReadAPI(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading file events pool",
+			"Could not read file events pool with error "+id+": "+err.Error(),
+		)
+		return
+	}
+
+	state, diags = r.updateState(ctx, pool)
+	resp.Diagnostics.Append(diags...)
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Read")
+}
+
+// Update - updates file events pool resource
+func (r *resourceFileEventsPool) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	log.Printf("Started Update")
+
+	var plan models.FileEventsPool
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.FileEventsPool
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	poolModify := clientgen.FileEventsPoolModify{
+		FileEventsPublisherServers: helper.SetStrings(plan.FileEventsPublisherServers),
+		FileEventsSettings:         helper.SliceTransform(plan.FileEventsSettings, newFileEventsSettings),
+	}
+	if !plan.Name.Equal(state.Name) {
+		poolModify.Name = helper.ValueToPointer[string](plan.Name)
+	}
+	// the settings of the categories that are no longer planned have to be removed explicitly
+	for _, settings := range state.FileEventsSettings {
+		if !slices.ContainsFunc(plan.FileEventsSettings, func(in models.FileEventsSettings) bool { return in.EventsCategory.Equal(settings.EventsCategory) }) {
+			poolModify.RemoveCategories = append(poolModify.RemoveCategories, clientgen.FileEventsCategoryEnum(settings.EventsCategory.ValueString()))
+		}
+	}
+
+	id := state.ID.ValueString()
+	_, err := r.client.FileEventsPoolApi.PatchFileEventsPoolById(ctx, id).Body(poolModify).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating file events pool",
+			"Could not update file events pool "+id+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	pool, err := r.ReadAPI(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting file events pool after update",
+			"Could not get file events pool, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state, diags = r.updateState(ctx, pool)
+	resp.Diagnostics.Append(diags...)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Successfully done with Update")
+}
+
+// Delete - method to delete file events pool resource
+func (r *resourceFileEventsPool) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	log.Printf("Started with Delete")
+
+	var state models.FileEventsPool
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	_, err := r.client.FileEventsPoolApi.DeleteFileEventsPoolById(ctx, id).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting file events pool",
+			"Could not delete file events pool "+id+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	log.Printf("Done with Delete")
+}
+
+// ImportState - imports state for existing file events pool
+func (r *resourceFileEventsPool) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// ReadAPI - fetches the file events pool by id
+func (r *resourceFileEventsPool) ReadAPI(ctx context.Context, id string) (*clientgen.FileEventsPoolInstance, error) {
+	queries := make(url.Values)
+	queries.Set("select", fileEventsPoolSelect)
+	pool, _, err := r.client.FileEventsPoolApi.GetFileEventsPoolById(ctx, id).Queries(queries).Execute()
+	return pool, err
+}
+
+// updateState - converts the file events pool response to the resource state
+func (r *resourceFileEventsPool) updateState(ctx context.Context, pool *clientgen.FileEventsPoolInstance) (models.FileEventsPool, diag.Diagnostics) {
+	servers, diags := types.SetValueFrom(ctx, types.StringType, pool.FileEventsPublisherServers)
+	return models.FileEventsPool{
+		ID:                         helper.TfString(pool.Id),
+		Name:                       helper.TfString(pool.Name),
+		FileEventsPublisherServers: servers,
+		FileEventsSettings: helper.SliceTransform(pool.FileEventsSettings, func(in clientgen.FileEventsSettingsInstance) models.FileEventsSettings {
+			var events []attr.Value
+			for event, enabled := range fileEventsFields(&in) {
+				if *enabled != nil && **enabled {
+					events = append(events, types.StringValue(event))
+				}
+			}
+			return models.FileEventsSettings{
+				EventsCategory: helper.TfString(in.EventsCategory),
+				Events:         types.SetValueMust(types.StringType, events),
+			}
+		}),
+		IsReplica: helper.TfBool(pool.IsReplica),
+	}, diags
+}
+
+// newFileEventsSettings converts planned file events settings, every event that is not planned is disabled
+func newFileEventsSettings(in models.FileEventsSettings) clientgen.FileEventsSettingsInstance {
+	settings := clientgen.FileEventsSettingsInstance{
+		EventsCategory: (*clientgen.FileEventsCategoryEnum)(helper.ValueToPointer[string](in.EventsCategory)),
+	}
+	events := helper.SetStrings(in.Events)
+	for event, enabled := range fileEventsFields(&settings) {
+		*enabled = helper.GetPointer(slices.Contains(events, event))
+	}
+	return settings
+}
+
+// fileEventsFields maps the file events to the fields of the file events settings
+func fileEventsFields(in *clientgen.FileEventsSettingsInstance) map[string]**bool {
+	return map[string]**bool{
+		"open_file_no_access":     &in.OpenFileNoAccess,
+		"open_file_read":          &in.OpenFileRead,
+		"open_file_write":         &in.OpenFileWrite,
+		"create_file":             &in.CreateFile,
+		"create_dir":              &in.CreateDir,
+		"delete_file":             &in.DeleteFile,
+		"delete_dir":              &in.DeleteDir,
+		"close_modified":          &in.CloseModified,
+		"close_unmodified":        &in.CloseUnmodified,
+		"rename_file":             &in.RenameFile,
+		"rename_dir":              &in.RenameDir,
+		"set_acl_file":            &in.SetAclFile,
+		"set_acl_dir":             &in.SetAclDir,
+		"open_dir":                &in.OpenDir,
+		"close_dir":               &in.CloseDir,
+		"file_read":               &in.FileRead,
+		"file_write":              &in.FileWrite,
+		"set_sec_file":            &in.SetSecFile,
+		"set_sec_dir":             &in.SetSecDir,
+		"open_file_read_offline":  &in.OpenFileReadOffline,
+		"open_file_write_offline": &in.OpenFileWriteOffline,
+	}
+}
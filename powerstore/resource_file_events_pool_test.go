@@ -0,0 +1,110 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Test to Create, Update, Import and Delete File Events Pool Resource
+func TestAccFileEventsPool(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfigForTesting + FileEventsPoolParamsInvalidEvent,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+			{
+				Config: ProviderConfigForTesting + FileEventsPoolParamsCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerstore_file_events_pool.test", "name", "tfacc_file_events_pool"),
+					resource.TestCheckTypeSetElemAttr("powerstore_file_events_pool.test", "file_events_publisher_servers.*", fileEventsServer),
+					resource.TestCheckResourceAttr("powerstore_file_events_pool.test", "file_events_settings.#", "1"),
+					resource.TestCheckResourceAttr("powerstore_file_events_pool.test", "is_replica", "false"),
+				),
+			},
+			// Import Testing
+			{
+				Config:            ProviderConfigForTesting + FileEventsPoolParamsCreate,
+				ResourceName:      "powerstore_file_events_pool.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: ProviderConfigForTesting + FileEventsPoolParamsUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerstore_file_events_pool.test", "name", "tfacc_file_events_pool_updated"),
+					resource.TestCheckResourceAttr("powerstore_file_events_pool.test", "file_events_settings.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+var FileEventsPoolParamsInvalidEvent = `
+resource "powerstore_file_events_pool" "test" {
+	name = "tfacc_file_events_pool"
+	file_events_publisher_servers = ["` + fileEventsServer + `"]
+	file_events_settings = [
+		{
+			events_category = "Post_Events"
+			events = ["invalid_event"]
+		}
+	]
+}
+`
+
+var FileEventsPoolParamsCreate = `
+resource "powerstore_file_events_pool" "test" {
+	name = "tfacc_file_events_pool"
+	file_events_publisher_servers = ["` + fileEventsServer + `"]
+	file_events_settings = [
+		{
+			events_category = "Post_Events"
+			events = ["create_file", "delete_file", "rename_file"]
+		}
+	]
+}
+`
+
+var FileEventsPoolParamsUpdate = `
+resource "powerstore_file_events_pool" "test" {
+	name = "tfacc_file_events_pool_updated"
+	file_events_publisher_servers = ["` + fileEventsServer + `"]
+	file_events_settings = [
+		{
+			events_category = "Post_Events"
+			events = ["create_file", "delete_file", "set_acl_file"]
+		},
+		{
+			events_category = "Pre_Events"
+			events = ["open_file_write"]
+		}
+	]
+}
+`
@@ -0,0 +1,499 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-powerstore/client"
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+)
+
+// fileEventsPublisherSelect lists the file events publisher fields read by the file events publisher resource
+const fileEventsPublisherSelect = "id,name,is_enabled,heartbeat,connection_timeout,post_event_policy,deny_access_when_all_servers_offline,username,http_port,file_events_pools(id),nas_servers(id)"
+
+// newFileEventsPublisherResource returns file events publisher new resource instance
+func newFileEventsPublisherResource() resource.Resource {
+	return &resourceFileEventsPublisher{}
+}
+
+type resourceFileEventsPublisher struct {
+	client *clientgen.APIClient
+}
+
+// Metadata defines resource interface Metadata method
+func (r *resourceFileEventsPublisher) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_file_events_publisher"
+}
+
+// Schema defines resource interface Schema method
+func (r *resourceFileEventsPublisher) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage the file events publishers on PowerStore Array. A file events publisher sends the SMB and NFS file events of its NAS servers to file events pools. We can Create, Update and Delete the file events publisher using this resource. We can also import an existing file events publisher from PowerStore array.",
+		Description:         "This resource is used to manage the file events publishers on PowerStore Array. A file events publisher sends the SMB and NFS file events of its NAS servers to file events pools. We can Create, Update and Delete the file events publisher using this resource. We can also import an existing file events publisher from PowerStore array.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Unique identifier of the file events publisher.",
+				MarkdownDescription: "Unique identifier of the file events publisher.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description:         "Name of the file events publisher.",
+				MarkdownDescription: "Name of the file events publisher.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 80),
+				},
+			},
+			"is_enabled": schema.BoolAttribute{
+				Description:         "Whether the file events publisher publishes the file events.",
+				MarkdownDescription: "Whether the file events publisher publishes the file events.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"heartbeat": schema.Int64Attribute{
+				Description:         "Interval in seconds to check the online or offline status of each server.",
+				MarkdownDescription: "Interval in seconds to check the online or offline status of each server.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 120),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"connection_timeout": schema.Int64Attribute{
+				Description:         "Timeout in milliseconds after which a server not acknowledging an event is considered offline.",
+				MarkdownDescription: "Timeout in milliseconds after which a server not acknowledging an event is considered offline.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(50, 5000),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"post_event_policy": schema.StringAttribute{
+				Description:         "Behavior when all the servers of a pool are offline, one of Ignore, Accumulate, Guarantee or Deny.",
+				MarkdownDescription: "Behavior when all the servers of a pool are offline, one of `Ignore`, `Accumulate`, `Guarantee` or `Deny`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(helper.SliceTransform(clientgen.AllowedPostEventPolicyEnumEnumValues, func(in clientgen.PostEventPolicyEnum) string {
+						return string(in)
+					})...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"deny_access_when_all_servers_offline": schema.BoolAttribute{
+				Description:         "Whether the I/O to the file systems is denied when an event cannot be published to any server.",
+				MarkdownDescription: "Whether the I/O to the file systems is denied when an event cannot be published to any server.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"username": schema.StringAttribute{
+				Description:         "Name of the Windows user used to connect to the servers through Microsoft RPC.",
+				MarkdownDescription: "Name of the Windows user used to connect to the servers through Microsoft RPC.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"password": schema.StringAttribute{
+				Description:         "Password of the Windows user. This attribute is write-only and is never stored in the state, change password_version to set a new password.",
+				MarkdownDescription: "Password of the Windows user. This attribute is write-only and is never stored in the state, change `password_version` to set a new password.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("username")),
+				},
+			},
+			"password_version": schema.Int64Attribute{
+				Description:         "Version of the password. Since the password is not stored in the state, changes to it are only applied when this value changes.",
+				MarkdownDescription: "Version of the password. Since the password is not stored in the state, changes to it are only applied when this value changes.",
+				Optional:            true,
+			},
+			"http_port": schema.Int64Attribute{
+				Description:         "TCP port used to connect to the servers through HTTP, 0 disables HTTP.",
+				MarkdownDescription: "TCP port used to connect to the servers through HTTP, 0 disables HTTP.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 65535),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"file_events_pool_ids": schema.SetAttribute{
+				Description:         "Unique identifiers of the file events pools the events are published to.",
+				MarkdownDescription: "Unique identifiers of the file events pools the events are published to.",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeBetween(1, 3),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"nas_server_ids": schema.SetAttribute{
+				Description:         "Unique identifiers of the NAS servers publishing their file events through the file events publisher.",
+				MarkdownDescription: "Unique identifiers of the NAS servers publishing their file events through the file events publisher.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure - defines configuration for file events publisher resource
+func (r *resourceFileEventsPublisher) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client.GenClient
+}
+
+// Create - method to create file events publisher resource
+func (r *resourceFileEventsPublisher) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.FileEventsPublisher
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// write-only attributes are only available in the configuration
+	var password types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &password)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createResp, _, err := r.client.FileEventsPublisherApi.PostAllFileEventsPublishers(ctx).Body(clientgen.FileEventsPublisherCreate{
+		Name:                            plan.Name.ValueString(),
+		Heartbeat:                       helper.KnownInt32(plan.Heartbeat),
+		ConnectionTimeout:               helper.KnownInt32(plan.ConnectionTimeout),
+		PostEventPolicy:                 (*clientgen.PostEventPolicyEnum)(helper.ValueToPointer[string](plan.PostEventPolicy)),
+		DenyAccessWhenAllServersOffline: helper.ValueToPointer[bool](plan.DenyAccessWhenAllServersOffline),
+		Username:                        helper.ValueToPointer[string](plan.Username),
+		Password:                        helper.ValueToPointer[string](password),
+		HttpPort:                        helper.KnownInt32(plan.HTTPPort),
+		FileEventsPoolIds:               helper.SetStrings(plan.FileEventsPoolIDs),
+	}).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating file events publisher",
+			"Could not create file events publisher, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	id := helper.TfString(createResp.Id).ValueString()
+	// the publisher is always created enabled
+	if helper.IsKnownValue(plan.IsEnabled) && !plan.IsEnabled.ValueBool() {
+		_, err = r.client.FileEventsPublisherApi.PatchFileEventsPublisherById(ctx, id).Body(clientgen.FileEventsPublisherModify{
+			IsEnabled: helper.GetPointer(false),
+		}).Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating file events publisher",
+				"Could not disable file events publisher "+id+", unexpected error: "+err.Error(),
+			)
+		}
+	}
+
+	if !resp.Diagnostics.HasError() && helper.IsKnownValue(plan.NasServerIDs) {
+		resp.Diagnostics.Append(r.setNasServers(ctx, helper.SetStrings(plan.NasServerIDs), id)...)
+	}
+
+	publisher, err := r.ReadAPI(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting file events publisher after creation",
+			"Could not get file events publisher, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state, dgs := r.updateState(ctx, publisher, plan.PasswordVersion)
+	resp.Diagnostics.Append(dgs...)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Create")
+}
+
+// Read - reads file events publisher resource information
+func (r *resourceFileEventsPublisher) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.FileEventsPublisher
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	publisher, err := r.ReadAPI(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading file events publisher",
+			"Could not read file events publisher with error "+id+": "+err.Error(),
+		)
+		return
+	}
+
+	state, diags = r.updateState(ctx, publisher, state.PasswordVersion)
+	resp.Diagnostics.Append(diags...)
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Read")
+}
+
+// Update - updates file events publisher resource
+func (r *resourceFileEventsPublisher) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	log.Printf("Started Update")
+
+	var plan models.FileEventsPublisher
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.FileEventsPublisher
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	publisherModify := clientgen.FileEventsPublisherModify{}
+	if !plan.Name.Equal(state.Name) {
+		publisherModify.Name = helper.ValueToPointer[string](plan.Name)
+	}
+	if !plan.IsEnabled.Equal(state.IsEnabled) {
+		publisherModify.IsEnabled = helper.ValueToPointer[bool](plan.IsEnabled)
+	}
+	if !plan.Heartbeat.Equal(state.Heartbeat) {
+		publisherModify.Heartbeat = helper.KnownInt32(plan.Heartbeat)
+	}
+	if !plan.ConnectionTimeout.Equal(state.ConnectionTimeout) {
+		publisherModify.ConnectionTimeout = helper.KnownInt32(plan.ConnectionTimeout)
+	}
+	if !plan.PostEventPolicy.Equal(state.PostEventPolicy) {
+		publisherModify.PostEventPolicy = (*clientgen.PostEventPolicyEnum)(helper.ValueToPointer[string](plan.PostEventPolicy))
+	}
+	if !plan.DenyAccessWhenAllServersOffline.Equal(state.DenyAccessWhenAllServersOffline) {
+		publisherModify.DenyAccessWhenAllServersOffline = helper.ValueToPointer[bool](plan.DenyAccessWhenAllServersOffline)
+	}
+	if !plan.Username.Equal(state.Username) {
+		publisherModify.Username = helper.ValueToPointer[string](plan.Username)
+	}
+	if !plan.PasswordVersion.Equal(state.PasswordVersion) {
+		var password types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &password)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		publisherModify.Password = helper.ValueToPointer[string](password)
+	}
+	if !plan.HTTPPort.Equal(state.HTTPPort) {
+		publisherModify.HttpPort = helper.KnownInt32(plan.HTTPPort)
+	}
+	if !plan.FileEventsPoolIDs.Equal(state.FileEventsPoolIDs) {
+		publisherModify.FileEventsPoolIds = helper.SetStrings(plan.FileEventsPoolIDs)
+	}
+
+	id := state.ID.ValueString()
+	if !reflect.ValueOf(publisherModify).IsZero() {
+		_, err := r.client.FileEventsPublisherApi.PatchFileEventsPublisherById(ctx, id).Body(publisherModify).Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating file events publisher",
+				"Could not update file events publisher "+id+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	if helper.IsKnownValue(plan.NasServerIDs) && !plan.NasServerIDs.Equal(state.NasServerIDs) {
+		add, remove := helper.SetDifference(plan.NasServerIDs, state.NasServerIDs)
+		// NAS servers are detached first so that a NAS server moved between publishers is free to attach
+		resp.Diagnostics.Append(r.setNasServers(ctx, remove, "")...)
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(r.setNasServers(ctx, add, id)...)
+		}
+	}
+
+	publisher, err := r.ReadAPI(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting file events publisher after update",
+			"Could not get file events publisher, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state, diags = r.updateState(ctx, publisher, plan.PasswordVersion)
+	resp.Diagnostics.Append(diags...)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Successfully done with Update")
+}
+
+// Delete - method to delete file events publisher resource
+func (r *resourceFileEventsPublisher) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	log.Printf("Started with Delete")
+
+	var state models.FileEventsPublisher
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// a publisher still used by NAS servers cannot be deleted
+	resp.Diagnostics.Append(r.setNasServers(ctx, helper.SetStrings(state.NasServerIDs), "")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	_, err := r.client.FileEventsPublisherApi.DeleteFileEventsPublisherById(ctx, id).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting file events publisher",
+			"Could not delete file events publisher "+id+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	log.Printf("Done with Delete")
+}
+
+// ImportState - imports state for existing file events publisher
+func (r *resourceFileEventsPublisher) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// ReadAPI - fetches the file events publisher by id
+func (r *resourceFileEventsPublisher) ReadAPI(ctx context.Context, id string) (*clientgen.FileEventsPublisherInstance, error) {
+	queries := make(url.Values)
+	queries.Set("select", fileEventsPublisherSelect)
+	publisher, _, err := r.client.FileEventsPublisherApi.GetFileEventsPublisherById(ctx, id).Queries(queries).Execute()
+	return publisher, err
+}
+
+// setNasServers - sets the file events publisher of the NAS servers, an empty publisher id detaches them
+func (r *resourceFileEventsPublisher) setNasServers(ctx context.Context, nasServerIDs []string, publisherID string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, nasServerID := range nasServerIDs {
+		_, err := r.client.NasServerApi.PatchNasServerById(ctx, nasServerID).Body(clientgen.NasServerModify{
+			FileEventsPublisherId: helper.GetPointer(publisherID),
+		}).Execute()
+		if err != nil {
+			diags.AddError(
+				"Error setting the file events publisher of NAS server",
+				"Could not set the file events publisher of NAS server "+nasServerID+", unexpected error: "+err.Error(),
+			)
+		}
+	}
+	return diags
+}
+
+// updateState - converts the file events publisher response to the resource state
+func (r *resourceFileEventsPublisher) updateState(ctx context.Context, publisher *clientgen.FileEventsPublisherInstance, passwordVersion types.Int64) (models.FileEventsPublisher, diag.Diagnostics) {
+	poolIDs, diags := types.SetValueFrom(ctx, types.StringType, helper.SliceTransform(publisher.FileEventsPools, func(in clientgen.FileEventsPoolInstance) string {
+		return helper.TfString(in.Id).ValueString()
+	}))
+	nasServerIDs, dgs := types.SetValueFrom(ctx, types.StringType, helper.SliceTransform(publisher.NasServers, func(in clientgen.NasServerInstance) string {
+		return helper.TfString(in.Id).ValueString()
+	}))
+	diags.Append(dgs...)
+	return models.FileEventsPublisher{
+		ID:                              helper.TfString(publisher.Id),
+		Name:                            helper.TfString(publisher.Name),
+		IsEnabled:                       helper.TfBool(publisher.IsEnabled),
+		Heartbeat:                       helper.TfInt64(publisher.Heartbeat),
+		ConnectionTimeout:               helper.TfInt64(publisher.ConnectionTimeout),
+		PostEventPolicy:                 helper.TfString(publisher.PostEventPolicy),
+		DenyAccessWhenAllServersOffline: helper.TfBool(publisher.DenyAccessWhenAllServersOffline),
+		Username:                        helper.TfString(publisher.Username),
+		Password:                        types.StringNull(),
+		PasswordVersion:                 passwordVersion,
+		HTTPPort:                        helper.TfInt64(publisher.HttpPort),
+		FileEventsPoolIDs:               poolIDs,
+		NasServerIDs:                    nasServerIDs,
+	}, diags
+}
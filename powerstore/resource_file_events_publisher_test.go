@@ -0,0 +1,117 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Test to Create, Update, Import and Delete File Events Publisher Resource
+func TestAccFileEventsPublisher(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfigForTesting + FileEventsPublisherParamsInvalidHeartbeat,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value"),
+			},
+			{
+				Config: ProviderConfigForTesting + FileEventsPublisherParamsCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerstore_file_events_publisher.test", "name", "tfacc_file_events_publisher"),
+					resource.TestCheckResourceAttr("powerstore_file_events_publisher.test", "is_enabled", "true"),
+					resource.TestCheckResourceAttr("powerstore_file_events_publisher.test", "post_event_policy", "Accumulate"),
+					resource.TestCheckResourceAttr("powerstore_file_events_publisher.test", "file_events_pool_ids.#", "1"),
+					resource.TestCheckResourceAttr("powerstore_file_events_publisher.test", "nas_server_ids.#", "0"),
+				),
+			},
+			// Import Testing
+			{
+				Config:                  ProviderConfigForTesting + FileEventsPublisherParamsCreate,
+				ResourceName:            "powerstore_file_events_publisher.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password_version"},
+			},
+			{
+				Config: ProviderConfigForTesting + FileEventsPublisherParamsUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerstore_file_events_publisher.test", "heartbeat", "20"),
+					resource.TestCheckResourceAttr("powerstore_file_events_publisher.test", "post_event_policy", "Ignore"),
+					resource.TestCheckTypeSetElemAttr("powerstore_file_events_publisher.test", "nas_server_ids.*", nasServerID),
+				),
+			},
+			// Detach the NAS server before the destroy
+			{
+				Config: ProviderConfigForTesting + FileEventsPublisherParamsCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerstore_file_events_publisher.test", "nas_server_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+var FileEventsPublisherPool = `
+resource "powerstore_file_events_pool" "test" {
+	name = "tfacc_file_events_publisher_pool"
+	file_events_publisher_servers = ["` + fileEventsServer + `"]
+	file_events_settings = [
+		{
+			events_category = "Post_Events"
+			events = ["create_file", "delete_file"]
+		}
+	]
+}
+`
+
+var FileEventsPublisherParamsInvalidHeartbeat = `
+resource "powerstore_file_events_publisher" "test" {
+	name = "tfacc_file_events_publisher"
+	heartbeat = 500
+	file_events_pool_ids = ["tfacc_file_events_pool_id"]
+}
+`
+
+var FileEventsPublisherParamsCreate = FileEventsPublisherPool + `
+resource "powerstore_file_events_publisher" "test" {
+	name = "tfacc_file_events_publisher"
+	post_event_policy = "Accumulate"
+	file_events_pool_ids = [powerstore_file_events_pool.test.id]
+	nas_server_ids = []
+}
+`
+
+var FileEventsPublisherParamsUpdate = FileEventsPublisherPool + `
+resource "powerstore_file_events_publisher" "test" {
+	name = "tfacc_file_events_publisher"
+	heartbeat = 20
+	post_event_policy = "Ignore"
+	file_events_pool_ids = [powerstore_file_events_pool.test.id]
+	nas_server_ids = ["` + nasServerID + `"]
+}
+`
@@ -0,0 +1,538 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-powerstore/client"
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+)
+
+// fileFtpSelect lists the FTP server fields read by the file ftp resource
+const fileFtpSelect = "id,nas_server_id,is_ftp_enabled,is_sftp_enabled,is_smb_authentication_enabled,is_unix_authentication_enabled," +
+	"is_anonymous_authentication_enabled,is_homedir_limit_enabled,default_homedir,welcome_message,message_of_the_day," +
+	"is_audit_enabled,audit_dir,audit_max_size,hosts,users,groups,is_allowed_hosts,is_allowed_users,is_allowed_groups"
+
+// newFileFtpResource returns file ftp new resource instance
+func newFileFtpResource() resource.Resource {
+	return &resourceFileFtp{}
+}
+
+type resourceFileFtp struct {
+	client *clientgen.APIClient
+}
+
+// Metadata defines resource interface Metadata method
+func (r *resourceFileFtp) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_file_ftp"
+}
+
+// Schema defines resource interface Schema method
+func (r *resourceFileFtp) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage the FTP and SFTP server of a NAS server on PowerStore Array. We can Create, Update and Delete the FTP server using this resource. We can also import an existing FTP server from PowerStore array.",
+		Description:         "This resource is used to manage the FTP and SFTP server of a NAS server on PowerStore Array. We can Create, Update and Delete the FTP server using this resource. We can also import an existing FTP server from PowerStore array.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Unique identifier of the FTP server.",
+				MarkdownDescription: "Unique identifier of the FTP server.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"nas_server_id": schema.StringAttribute{
+				Description:         "Unique identifier of the NAS server the FTP server is configured on.",
+				MarkdownDescription: "Unique identifier of the NAS server the FTP server is configured on.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"is_ftp_enabled": schema.BoolAttribute{
+				Description:         "Whether the FTP server is enabled on the NAS server.",
+				MarkdownDescription: "Whether the FTP server is enabled on the NAS server.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_sftp_enabled": schema.BoolAttribute{
+				Description:         "Whether the SFTP server is enabled on the NAS server.",
+				MarkdownDescription: "Whether the SFTP server is enabled on the NAS server.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_smb_authentication_enabled": schema.BoolAttribute{
+				Description:         "Whether FTP and SFTP clients can be authenticated using an SMB user name.",
+				MarkdownDescription: "Whether FTP and SFTP clients can be authenticated using an SMB user name.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_unix_authentication_enabled": schema.BoolAttribute{
+				Description:         "Whether FTP and SFTP clients can be authenticated using a Unix user name.",
+				MarkdownDescription: "Whether FTP and SFTP clients can be authenticated using a Unix user name.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_anonymous_authentication_enabled": schema.BoolAttribute{
+				Description:         "Whether FTP clients can be authenticated anonymously.",
+				MarkdownDescription: "Whether FTP clients can be authenticated anonymously.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_homedir_limit_enabled": schema.BoolAttribute{
+				Description:         "Whether FTP and SFTP users can only access their home directory.",
+				MarkdownDescription: "Whether FTP and SFTP users can only access their home directory.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"default_homedir": schema.StringAttribute{
+				Description:         "Default directory of FTP and SFTP clients whose home directory is not defined or not accessible. Applies when is_homedir_limit_enabled is false.",
+				MarkdownDescription: "Default directory of FTP and SFTP clients whose home directory is not defined or not accessible. Applies when `is_homedir_limit_enabled` is false.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(511),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"welcome_message": schema.StringAttribute{
+				Description:         "Welcome message displayed to FTP and SFTP clients before their authentication.",
+				MarkdownDescription: "Welcome message displayed to FTP and SFTP clients before their authentication.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(511),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"message_of_the_day": schema.StringAttribute{
+				Description:         "Message of the day displayed to FTP clients after their authentication.",
+				MarkdownDescription: "Message of the day displayed to FTP clients after their authentication.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(511),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_audit_enabled": schema.BoolAttribute{
+				Description:         "Whether the activity of FTP and SFTP clients is tracked in audit files.",
+				MarkdownDescription: "Whether the activity of FTP and SFTP clients is tracked in audit files.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"audit_dir": schema.StringAttribute{
+				Description:         "Directory of the FTP and SFTP audit files. Applies when is_audit_enabled is true.",
+				MarkdownDescription: "Directory of the FTP and SFTP audit files. Applies when `is_audit_enabled` is true.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(511),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"audit_max_size": schema.Int64Attribute{
+				Description:         "Maximum size in bytes of all the FTP and SFTP audit files. Applies when is_audit_enabled is true.",
+				MarkdownDescription: "Maximum size in bytes of all the FTP and SFTP audit files. Applies when `is_audit_enabled` is true.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(40960, 5368709120),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"hosts": schema.SetAttribute{
+				Description:         "IP addresses or subnets in CIDR notation of the hosts allowed or denied to connect through FTP or SFTP, depending on is_allowed_hosts.",
+				MarkdownDescription: "IP addresses or subnets in CIDR notation of the hosts allowed or denied to connect through FTP or SFTP, depending on `is_allowed_hosts`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(64),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_allowed_hosts": schema.BoolAttribute{
+				Description:         "Whether hosts lists the allowed hosts or the denied hosts.",
+				MarkdownDescription: "Whether `hosts` lists the allowed hosts or the denied hosts.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"users": schema.SetAttribute{
+				Description:         "Users allowed or denied to connect through FTP or SFTP, depending on is_allowed_users.",
+				MarkdownDescription: "Users allowed or denied to connect through FTP or SFTP, depending on `is_allowed_users`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(64),
+					setvalidator.ValueStringsAre(stringvalidator.LengthBetween(1, 63)),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_allowed_users": schema.BoolAttribute{
+				Description:         "Whether users lists the allowed users or the denied users.",
+				MarkdownDescription: "Whether `users` lists the allowed users or the denied users.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"groups": schema.SetAttribute{
+				Description:         "User groups allowed or denied to connect through FTP or SFTP, depending on is_allowed_groups.",
+				MarkdownDescription: "User groups allowed or denied to connect through FTP or SFTP, depending on `is_allowed_groups`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(64),
+					setvalidator.ValueStringsAre(stringvalidator.LengthBetween(1, 63)),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_allowed_groups": schema.BoolAttribute{
+				Description:         "Whether groups lists the allowed groups or the denied groups.",
+				MarkdownDescription: "Whether `groups` lists the allowed groups or the denied groups.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure - defines configuration for file ftp resource
+func (r *resourceFileFtp) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client.GenClient
+}
+
+// Create - method to create file ftp resource
+func (r *resourceFileFtp) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.FileFtp
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createResp, _, err := r.client.FileFtpApi.PostAllFileFtps(ctx).Body(clientgen.FileFtpCreate{
+		NasServerId:                      plan.NasServerID.ValueString(),
+		IsFtpEnabled:                     helper.ValueToPointer[bool](plan.IsFtpEnabled),
+		IsSftpEnabled:                    helper.ValueToPointer[bool](plan.IsSftpEnabled),
+		IsSmbAuthenticationEnabled:       helper.ValueToPointer[bool](plan.IsSmbAuthenticationEnabled),
+		IsUnixAuthenticationEnabled:      helper.ValueToPointer[bool](plan.IsUnixAuthenticationEnabled),
+		IsAnonymousAuthenticationEnabled: helper.ValueToPointer[bool](plan.IsAnonymousAuthenticationEnabled),
+		IsHomedirLimitEnabled:            helper.ValueToPointer[bool](plan.IsHomedirLimitEnabled),
+		DefaultHomedir:                   helper.ValueToPointer[string](plan.DefaultHomedir),
+		WelcomeMessage:                   helper.ValueToPointer[string](plan.WelcomeMessage),
+		MessageOfTheDay:                  helper.ValueToPointer[string](plan.MessageOfTheDay),
+		IsAuditEnabled:                   helper.ValueToPointer[bool](plan.IsAuditEnabled),
+		AuditDir:                         helper.ValueToPointer[string](plan.AuditDir),
+		AuditMaxSize:                     helper.KnownInt64(plan.AuditMaxSize),
+		Hosts:                            helper.SetStrings(plan.Hosts),
+		Users:                            helper.SetStrings(plan.Users),
+		Groups:                           helper.SetStrings(plan.Groups),
+		IsAllowedHosts:                   helper.ValueToPointer[bool](plan.IsAllowedHosts),
+		IsAllowedUsers:                   helper.ValueToPointer[bool](plan.IsAllowedUsers),
+		IsAllowedGroups:                  helper.ValueToPointer[bool](plan.IsAllowedGroups),
+	}).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating file ftp",
+			"Could not create file ftp, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	ftp, err := r.ReadAPI(ctx, helper.TfString(createResp.Id).ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting file ftp after creation",
+			"Could not get file ftp, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state, dgs := r.updateState(ctx, ftp)
+	resp.Diagnostics.Append(dgs...)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Create")
+}
+
+// Read - reads file ftp resource information
+func (r *resourceFileFtp) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.FileFtp
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	ftp, err := r.ReadAPI(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading file ftp",
+			"Could not read file ftp with error "+id+": "+err.Error(),
+		)
+		return
+	}
+
+	state, diags = r.updateState(ctx, ftp)
+	resp.Diagnostics.Append(diags...)
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Read")
+}
+
+// Update - updates file ftp resource
+func (r *resourceFileFtp) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	log.Printf("Started Update")
+
+	var plan models.FileFtp
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.FileFtp
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ftpModify := clientgen.FileFtpModify{
+		IsFtpEnabled:                     helper.ValueToPointer[bool](plan.IsFtpEnabled),
+		IsSftpEnabled:                    helper.ValueToPointer[bool](plan.IsSftpEnabled),
+		IsSmbAuthenticationEnabled:       helper.ValueToPointer[bool](plan.IsSmbAuthenticationEnabled),
+		IsUnixAuthenticationEnabled:      helper.ValueToPointer[bool](plan.IsUnixAuthenticationEnabled),
+		IsAnonymousAuthenticationEnabled: helper.ValueToPointer[bool](plan.IsAnonymousAuthenticationEnabled),
+		IsHomedirLimitEnabled:            helper.ValueToPointer[bool](plan.IsHomedirLimitEnabled),
+		DefaultHomedir:                   helper.ValueToPointer[string](plan.DefaultHomedir),
+		WelcomeMessage:                   helper.ValueToPointer[string](plan.WelcomeMessage),
+		MessageOfTheDay:                  helper.ValueToPointer[string](plan.MessageOfTheDay),
+		IsAuditEnabled:                   helper.ValueToPointer[bool](plan.IsAuditEnabled),
+		AuditDir:                         helper.ValueToPointer[string](plan.AuditDir),
+		AuditMaxSize:                     helper.KnownInt64(plan.AuditMaxSize),
+		IsAllowedHosts:                   helper.ValueToPointer[bool](plan.IsAllowedHosts),
+		IsAllowedUsers:                   helper.ValueToPointer[bool](plan.IsAllowedUsers),
+		IsAllowedGroups:                  helper.ValueToPointer[bool](plan.IsAllowedGroups),
+	}
+	// the host, user and group lists are updated incrementally as an empty list cannot be sent to clear them
+	ftpModify.AddHosts, ftpModify.RemoveHosts = helper.SetDifference(plan.Hosts, state.Hosts)
+	ftpModify.AddUsers, ftpModify.RemoveUsers = helper.SetDifference(plan.Users, state.Users)
+	ftpModify.AddGroups, ftpModify.RemoveGroups = helper.SetDifference(plan.Groups, state.Groups)
+
+	id := state.ID.ValueString()
+	_, err := r.client.FileFtpApi.PatchFileFtpById(ctx, id).Body(ftpModify).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating file ftp",
+			"Could not update file ftp "+id+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	ftp, err := r.ReadAPI(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting file ftp after update",
+			"Could not get file ftp, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state, diags = r.updateState(ctx, ftp)
+	resp.Diagnostics.Append(diags...)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Successfully done with Update")
+}
+
+// Delete - method to delete file ftp resource
+func (r *resourceFileFtp) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	log.Printf("Started with Delete")
+
+	var state models.FileFtp
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	_, err := r.client.FileFtpApi.DeleteFileFtpById(ctx, id).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting file ftp",
+			"Could not delete file ftp "+id+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	log.Printf("Done with Delete")
+}
+
+// ImportState - imports state for existing file ftp using its id or the id of its NAS server
+func (r *resourceFileFtp) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	queries := make(url.Values)
+	queries.Set("select", "id")
+	queries.Set("nas_server_id", "eq."+req.ID)
+	ftps, _, err := r.client.FileFtpApi.GetAllFileFtps(ctx).Queries(queries).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing file ftp",
+			"Could not read file ftp of NAS server "+req.ID+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+	id := req.ID
+	if len(ftps) > 0 {
+		id = helper.TfString(ftps[0].Id).ValueString()
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// ReadAPI - fetches the file ftp by id
+func (r *resourceFileFtp) ReadAPI(ctx context.Context, id string) (*clientgen.FileFtpInstance, error) {
+	queries := make(url.Values)
+	queries.Set("select", fileFtpSelect)
+	ftp, _, err := r.client.FileFtpApi.GetFileFtpById(ctx, id).Queries(queries).Execute()
+	return ftp, err
+}
+
+// updateState - converts the file ftp response to the resource state
+func (r *resourceFileFtp) updateState(ctx context.Context, ftp *clientgen.FileFtpInstance) (models.FileFtp, diag.Diagnostics) {
+	// the lists are copied so that empty lists are stored as empty sets rather than null
+	var diags diag.Diagnostics
+	hosts, dgs := types.SetValueFrom(ctx, types.StringType, append([]string{}, ftp.Hosts...))
+	diags.Append(dgs...)
+	users, dgs := types.SetValueFrom(ctx, types.StringType, append([]string{}, ftp.Users...))
+	diags.Append(dgs...)
+	groups, dgs := types.SetValueFrom(ctx, types.StringType, append([]string{}, ftp.Groups...))
+	diags.Append(dgs...)
+	return models.FileFtp{
+		ID:                               helper.TfString(ftp.Id),
+		NasServerID:                      helper.TfString(ftp.NasServerId),
+		IsFtpEnabled:                     helper.TfBool(ftp.IsFtpEnabled),
+		IsSftpEnabled:                    helper.TfBool(ftp.IsSftpEnabled),
+		IsSmbAuthenticationEnabled:       helper.TfBool(ftp.IsSmbAuthenticationEnabled),
+		IsUnixAuthenticationEnabled:      helper.TfBool(ftp.IsUnixAuthenticationEnabled),
+		IsAnonymousAuthenticationEnabled: helper.TfBool(ftp.IsAnonymousAuthenticationEnabled),
+		IsHomedirLimitEnabled:            helper.TfBool(ftp.IsHomedirLimitEnabled),
+		DefaultHomedir:                   helper.TfString(ftp.DefaultHomedir),
+		WelcomeMessage:                   helper.TfString(ftp.WelcomeMessage),
+		MessageOfTheDay:                  helper.TfString(ftp.MessageOfTheDay),
+		IsAuditEnabled:                   helper.TfBool(ftp.IsAuditEnabled),
+		AuditDir:                         helper.TfString(ftp.AuditDir),
+		AuditMaxSize:                     helper.TfInt64(ftp.AuditMaxSize),
+		Hosts:                            hosts,
+		IsAllowedHosts:                   helper.TfBool(ftp.IsAllowedHosts),
+		Users:                            users,
+		IsAllowedUsers:                   helper.TfBool(ftp.IsAllowedUsers),
+		Groups:                           groups,
+		IsAllowedGroups:                  helper.TfBool(ftp.IsAllowedGroups),
+	}, diags
+}
@@ -0,0 +1,103 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Test to Create, Update, Import and Delete File FTP Resource
+func TestAccFileFtp(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfigForTesting + FileFtpParamsInvalidAuditSize,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value"),
+			},
+			{
+				Config: ProviderConfigForTesting + FileFtpParamsCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerstore_file_ftp.test", "nas_server_id", nasServerID),
+					resource.TestCheckResourceAttr("powerstore_file_ftp.test", "is_ftp_enabled", "false"),
+					resource.TestCheckResourceAttr("powerstore_file_ftp.test", "is_sftp_enabled", "true"),
+					resource.TestCheckResourceAttr("powerstore_file_ftp.test", "users.#", "2"),
+				),
+			},
+			// Import Testing using the NAS server id
+			{
+				Config:            ProviderConfigForTesting + FileFtpParamsCreate,
+				ResourceName:      "powerstore_file_ftp.test",
+				ImportState:       true,
+				ImportStateId:     nasServerID,
+				ImportStateVerify: true,
+			},
+			{
+				Config: ProviderConfigForTesting + FileFtpParamsUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerstore_file_ftp.test", "is_ftp_enabled", "true"),
+					resource.TestCheckResourceAttr("powerstore_file_ftp.test", "is_homedir_limit_enabled", "false"),
+					resource.TestCheckResourceAttr("powerstore_file_ftp.test", "default_homedir", "/"),
+					resource.TestCheckResourceAttr("powerstore_file_ftp.test", "users.#", "0"),
+					resource.TestCheckResourceAttr("powerstore_file_ftp.test", "hosts.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+var FileFtpParamsInvalidAuditSize = `
+resource "powerstore_file_ftp" "test" {
+	nas_server_id = "` + nasServerID + `"
+	audit_max_size = 1024
+}
+`
+
+var FileFtpParamsCreate = `
+resource "powerstore_file_ftp" "test" {
+	nas_server_id = "` + nasServerID + `"
+	is_ftp_enabled = false
+	is_sftp_enabled = true
+	is_anonymous_authentication_enabled = false
+	users = ["tfacc_user1", "tfacc_user2"]
+	is_allowed_users = true
+}
+`
+
+var FileFtpParamsUpdate = `
+resource "powerstore_file_ftp" "test" {
+	nas_server_id = "` + nasServerID + `"
+	is_ftp_enabled = true
+	is_sftp_enabled = true
+	is_anonymous_authentication_enabled = false
+	is_homedir_limit_enabled = false
+	default_homedir = "/"
+	users = []
+	hosts = ["10.230.24.0/24"]
+	is_allowed_hosts = true
+}
+`
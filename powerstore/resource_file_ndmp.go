@@ -0,0 +1,319 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-powerstore/client"
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+)
+
+// fileNdmpSelect lists the NDMP fields read by the file ndmp resource
+const fileNdmpSelect = "id,nas_server_id,user_name"
+
+// newFileNdmpResource returns file ndmp new resource instance
+func newFileNdmpResource() resource.Resource {
+	return &resourceFileNdmp{}
+}
+
+type resourceFileNdmp struct {
+	client *clientgen.APIClient
+}
+
+// Metadata defines resource interface Metadata method
+func (r *resourceFileNdmp) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_file_ndmp"
+}
+
+// Schema defines resource interface Schema method
+func (r *resourceFileNdmp) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage the NDMP service of a NAS server on PowerStore Array. We can Create, Update and Delete the NDMP service using this resource. We can also import an existing NDMP service from PowerStore array.",
+		Description:         "This resource is used to manage the NDMP service of a NAS server on PowerStore Array. We can Create, Update and Delete the NDMP service using this resource. We can also import an existing NDMP service from PowerStore array.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Unique identifier of the NDMP service.",
+				MarkdownDescription: "Unique identifier of the NDMP service.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"nas_server_id": schema.StringAttribute{
+				Description:         "Unique identifier of the NAS server the NDMP service is configured on.",
+				MarkdownDescription: "Unique identifier of the NAS server the NDMP service is configured on.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_name": schema.StringAttribute{
+				Description:         "User name used by the backup application to authenticate to the NDMP service.",
+				MarkdownDescription: "User name used by the backup application to authenticate to the NDMP service.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"password": schema.StringAttribute{
+				Description:         "Password used by the backup application to authenticate to the NDMP service. This attribute is write-only and is never stored in the state, change password_version to set a new password.",
+				MarkdownDescription: "Password used by the backup application to authenticate to the NDMP service. This attribute is write-only and is never stored in the state, change `password_version` to set a new password.",
+				Required:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"password_version": schema.Int64Attribute{
+				Description:         "Version of the password. Since the password is not stored in the state, changes to it are only applied when this value changes.",
+				MarkdownDescription: "Version of the password. Since the password is not stored in the state, changes to it are only applied when this value changes.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// Configure - defines configuration for file ndmp resource
+func (r *resourceFileNdmp) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client.GenClient
+}
+
+// Create - method to create file ndmp resource
+func (r *resourceFileNdmp) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.FileNdmp
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// write-only attributes are only available in the configuration
+	var password types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &password)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createResp, _, err := r.client.FileNdmpApi.PostAllFileNdmps(ctx).Body(clientgen.FileNdmpCreate{
+		NasServerId: plan.NasServerID.ValueString(),
+		UserName:    plan.UserName.ValueString(),
+		Password:    password.ValueString(),
+	}).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating file ndmp",
+			"Could not create file ndmp, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	ndmp, err := r.ReadAPI(ctx, helper.TfString(createResp.Id).ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting file ndmp after creation",
+			"Could not get file ndmp, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state := r.updateState(ndmp, plan.PasswordVersion)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Create")
+}
+
+// Read - reads file ndmp resource information
+func (r *resourceFileNdmp) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.FileNdmp
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	ndmp, err := r.ReadAPI(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading file ndmp",
+			"Could not read file ndmp with error "+id+": "+err.Error(),
+		)
+		return
+	}
+
+	state = r.updateState(ndmp, state.PasswordVersion)
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Read")
+}
+
+// Update - updates file ndmp resource
+func (r *resourceFileNdmp) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	log.Printf("Started Update")
+
+	var plan models.FileNdmp
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.FileNdmp
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ndmpModify := clientgen.FileNdmpModify{}
+	if !plan.UserName.Equal(state.UserName) {
+		ndmpModify.UserName = helper.ValueToPointer[string](plan.UserName)
+	}
+	if !plan.PasswordVersion.Equal(state.PasswordVersion) {
+		var password types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &password)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		ndmpModify.Password = helper.ValueToPointer[string](password)
+	}
+
+	id := state.ID.ValueString()
+	if ndmpModify != (clientgen.FileNdmpModify{}) {
+		_, err := r.client.FileNdmpApi.PatchFileNdmpById(ctx, id).Body(ndmpModify).Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating file ndmp",
+				"Could not update file ndmp "+id+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	ndmp, err := r.ReadAPI(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting file ndmp after update",
+			"Could not get file ndmp, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state = r.updateState(ndmp, plan.PasswordVersion)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Successfully done with Update")
+}
+
+// Delete - method to delete file ndmp resource
+func (r *resourceFileNdmp) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	log.Printf("Started with Delete")
+
+	var state models.FileNdmp
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	_, err := r.client.FileNdmpApi.DeleteFileNdmpById(ctx, id).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting file ndmp",
+			"Could not delete file ndmp "+id+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	log.Printf("Done with Delete")
+}
+
+// ImportState - imports state for existing file ndmp using its id or the id of its NAS server
+func (r *resourceFileNdmp) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	queries := make(url.Values)
+	queries.Set("select", "id")
+	queries.Set("nas_server_id", "eq."+req.ID)
+	ndmps, _, err := r.client.FileNdmpApi.GetAllFileNdmps(ctx).Queries(queries).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing file ndmp",
+			"Could not read file ndmp of NAS server "+req.ID+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+	id := req.ID
+	if len(ndmps) > 0 {
+		id = helper.TfString(ndmps[0].Id).ValueString()
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// ReadAPI - fetches the file ndmp by id
+func (r *resourceFileNdmp) ReadAPI(ctx context.Context, id string) (*clientgen.FileNdmpInstance, error) {
+	queries := make(url.Values)
+	queries.Set("select", fileNdmpSelect)
+	ndmp, _, err := r.client.FileNdmpApi.GetFileNdmpById(ctx, id).Queries(queries).Execute()
+	return ndmp, err
+}
+
+// updateState - converts the file ndmp response to the resource state
+func (r *resourceFileNdmp) updateState(ndmp *clientgen.FileNdmpInstance, passwordVersion types.Int64) models.FileNdmp {
+	return models.FileNdmp{
+		ID:              helper.TfString(ndmp.Id),
+		NasServerID:     helper.TfString(ndmp.NasServerId),
+		UserName:        helper.TfString(ndmp.UserName),
+		Password:        types.StringNull(),
+		PasswordVersion: passwordVersion,
+	}
+}
@@ -0,0 +1,488 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-powerstore/client"
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+)
+
+// fileVirusCheckerSelect lists the virus checker fields read by the file virus checker resource
+const fileVirusCheckerSelect = "id,nas_server_id,is_enabled,is_config_file_uploaded,offline_policy"
+
+// newFileVirusCheckerResource returns file virus checker new resource instance
+func newFileVirusCheckerResource() resource.Resource {
+	return &resourceFileVirusChecker{}
+}
+
+type resourceFileVirusChecker struct {
+	client *clientgen.APIClient
+}
+
+// Metadata defines resource interface Metadata method
+func (r *resourceFileVirusChecker) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_file_virus_checker"
+}
+
+// Schema defines resource interface Schema method
+func (r *resourceFileVirusChecker) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage the virus checker (CAVA) configuration of a NAS server on PowerStore Array. We can Create, Update and Delete the virus checker using this resource. We can also import an existing virus checker from PowerStore array.",
+		Description:         "This resource is used to manage the virus checker (CAVA) configuration of a NAS server on PowerStore Array. We can Create, Update and Delete the virus checker using this resource. We can also import an existing virus checker from PowerStore array.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Unique identifier of the virus checker.",
+				MarkdownDescription: "Unique identifier of the virus checker.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"nas_server_id": schema.StringAttribute{
+				Description:         "Unique identifier of the NAS server the virus checker is configured on. Only one virus checker can be configured per NAS server.",
+				MarkdownDescription: "Unique identifier of the NAS server the virus checker is configured on. Only one virus checker can be configured per NAS server.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"is_enabled": schema.BoolAttribute{
+				Description:         "Whether files created or modified by SMB clients are scanned by the virus checker servers. A configuration file must be uploaded before the virus checker can be enabled.",
+				MarkdownDescription: "Whether files created or modified by SMB clients are scanned by the virus checker servers. A configuration file must be uploaded before the virus checker can be enabled.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"offline_policy": schema.StringAttribute{
+				Description:         "Behavior when all the virus checker servers are offline. Accepted values are `Allow_Access`, `Stop_SMB_Access` and `Disable_Virus_Checker`.",
+				MarkdownDescription: "Behavior when all the virus checker servers are offline. Accepted values are `Allow_Access`, `Stop_SMB_Access` and `Disable_Virus_Checker`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(helper.SliceTransform(clientgen.AllowedFileVirusCheckerOfflinePolicyEnumEnumValues, func(in clientgen.FileVirusCheckerOfflinePolicyEnum) string {
+						return string(in)
+					})...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"config": schema.StringAttribute{
+				Description:         "Content of the viruschecker.conf configuration file to upload. Conflicts with `config_file_path`.",
+				MarkdownDescription: "Content of the viruschecker.conf configuration file to upload. Conflicts with `config_file_path`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("config_file_path")),
+				},
+			},
+			"config_file_path": schema.StringAttribute{
+				Description:         "Path of the viruschecker.conf configuration file to upload on the machine running Terraform. Conflicts with `config`.",
+				MarkdownDescription: "Path of the viruschecker.conf configuration file to upload on the machine running Terraform. Conflicts with `config`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"config_hash": schema.StringAttribute{
+				Description:         "SHA-256 hash of the configuration file uploaded on the NAS server. When `config` or `config_file_path` is set, a change of this hash on the array is detected as drift and the configuration file is uploaded again.",
+				MarkdownDescription: "SHA-256 hash of the configuration file uploaded on the NAS server. When `config` or `config_file_path` is set, a change of this hash on the array is detected as drift and the configuration file is uploaded again.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					virusCheckerConfigHashModifier{},
+				},
+			},
+			"is_config_file_uploaded": schema.BoolAttribute{
+				Description:         "Whether a configuration file has been uploaded on the NAS server.",
+				MarkdownDescription: "Whether a configuration file has been uploaded on the NAS server.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure - defines configuration for file virus checker resource
+func (r *resourceFileVirusChecker) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client.GenClient
+}
+
+// Create - method to create file virus checker resource
+func (r *resourceFileVirusChecker) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.FileVirusChecker
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createResp, _, err := r.client.FileVirusCheckerApi.PostAllFileVirusCheckers(ctx).Body(clientgen.FileVirusCheckerCreate{
+		NasServerId:   plan.NasServerID.ValueString(),
+		OfflinePolicy: (*clientgen.FileVirusCheckerOfflinePolicyEnum)(helper.ValueToPointer[string](plan.OfflinePolicy)),
+	}).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating file virus checker",
+			"Could not create file virus checker, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	id := helper.TfString(createResp.Id).ValueString()
+	// the configuration file has to be uploaded before the virus checker can be enabled
+	if isVirusCheckerConfigManaged(plan) {
+		if err := r.uploadConfig(ctx, id, plan); err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating file virus checker",
+				"Could not upload virus checker configuration file, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+	if !plan.IsEnabled.IsUnknown() {
+		_, err = r.client.FileVirusCheckerApi.PatchFileVirusCheckerById(ctx, id).Body(clientgen.FileVirusCheckerModify{
+			IsEnabled: helper.ValueToPointer[bool](plan.IsEnabled),
+		}).Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating file virus checker",
+				"Could not enable file virus checker, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	checker, err := r.ReadAPI(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting file virus checker after creation",
+			"Could not get file virus checker, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state, err := r.updateState(ctx, checker, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting file virus checker after creation",
+			"Could not download virus checker configuration file, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Create")
+}
+
+// Read - reads file virus checker resource information
+func (r *resourceFileVirusChecker) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.FileVirusChecker
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	checker, err := r.ReadAPI(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading file virus checker",
+			"Could not read file virus checker with error "+id+": "+err.Error(),
+		)
+		return
+	}
+
+	state, err = r.updateState(ctx, checker, state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading file virus checker",
+			"Could not download virus checker configuration file of "+id+": "+err.Error(),
+		)
+		return
+	}
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Read")
+}
+
+// Update - updates file virus checker resource
+func (r *resourceFileVirusChecker) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	log.Printf("Started Update")
+
+	var plan models.FileVirusChecker
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.FileVirusChecker
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	// the configuration file is uploaded again when its content changed or drifted on the array
+	if isVirusCheckerConfigManaged(plan) && !plan.ConfigHash.Equal(state.ConfigHash) {
+		if err := r.uploadConfig(ctx, id, plan); err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating file virus checker",
+				"Could not upload virus checker configuration file of "+id+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+	_, err := r.client.FileVirusCheckerApi.PatchFileVirusCheckerById(ctx, id).Body(clientgen.FileVirusCheckerModify{
+		IsEnabled:     helper.ValueToPointer[bool](plan.IsEnabled),
+		OfflinePolicy: (*clientgen.FileVirusCheckerOfflinePolicyEnum)(helper.ValueToPointer[string](plan.OfflinePolicy)),
+	}).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating file virus checker",
+			"Could not update file virus checker "+id+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	checker, err := r.ReadAPI(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting file virus checker after update",
+			"Could not get file virus checker, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state, err = r.updateState(ctx, checker, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting file virus checker after update",
+			"Could not download virus checker configuration file, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Successfully done with Update")
+}
+
+// Delete - method to delete file virus checker resource
+func (r *resourceFileVirusChecker) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	log.Printf("Started with Delete")
+
+	var state models.FileVirusChecker
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	_, err := r.client.FileVirusCheckerApi.DeleteFileVirusCheckerById(ctx, id).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting file virus checker",
+			"Could not delete file virus checker "+id+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	log.Printf("Done with Delete")
+}
+
+// ImportState - imports state for existing file virus checker
+func (r *resourceFileVirusChecker) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// ReadAPI - fetches the file virus checker by id
+func (r *resourceFileVirusChecker) ReadAPI(ctx context.Context, id string) (*clientgen.FileVirusCheckerInstance, error) {
+	queries := make(url.Values)
+	queries.Set("select", fileVirusCheckerSelect)
+	checker, _, err := r.client.FileVirusCheckerApi.GetFileVirusCheckerById(ctx, id).Queries(queries).Execute()
+	return checker, err
+}
+
+// updateState - converts the file virus checker response to the resource state
+// The configuration inputs are kept from the plan, the hash is computed from the configuration file downloaded from the array
+func (r *resourceFileVirusChecker) updateState(ctx context.Context, checker *clientgen.FileVirusCheckerInstance, plan models.FileVirusChecker) (models.FileVirusChecker, error) {
+	state := models.FileVirusChecker{
+		ID:                   helper.TfString(checker.Id),
+		NasServerID:          helper.TfString(checker.NasServerId),
+		IsEnabled:            helper.TfBool(checker.IsEnabled),
+		OfflinePolicy:        helper.TfString(checker.OfflinePolicy),
+		Config:               plan.Config,
+		ConfigFilePath:       plan.ConfigFilePath,
+		ConfigHash:           types.StringNull(),
+		IsConfigFileUploaded: helper.TfBool(checker.IsConfigFileUploaded),
+	}
+	if state.IsConfigFileUploaded.ValueBool() {
+		config, err := r.downloadConfig(ctx, state.ID.ValueString())
+		if err != nil {
+			return state, err
+		}
+		state.ConfigHash = types.StringValue(virusCheckerConfigHash(config))
+	}
+	return state, nil
+}
+
+// uploadConfig - uploads the planned configuration file, the array expects it to be named viruschecker.conf
+func (r *resourceFileVirusChecker) uploadConfig(ctx context.Context, id string, plan models.FileVirusChecker) error {
+	config, err := virusCheckerConfig(plan.Config, plan.ConfigFilePath)
+	if err != nil {
+		return err
+	}
+	dir, err := os.MkdirTemp("", "viruschecker")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+	file, err := os.Create(filepath.Join(dir, "viruschecker.conf"))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	if _, err = file.WriteString(config); err != nil {
+		return err
+	}
+	if _, err = file.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	_, err = r.client.FileVirusCheckerApi.FileVirusCheckerUploadConfig(ctx, id).Body(file).Execute()
+	return err
+}
+
+// downloadConfig - downloads the configuration file uploaded on the array
+// The file is served as text which the generated client cannot decode, so it is read from the raw response
+func (r *resourceFileVirusChecker) downloadConfig(ctx context.Context, id string) (string, error) {
+	_, httpResp, err := r.client.FileVirusCheckerApi.FileVirusCheckerDownloadConfig(ctx, id).Execute()
+	if httpResp == nil || httpResp.StatusCode >= 300 {
+		return "", err
+	}
+	config, err := io.ReadAll(httpResp.Body)
+	return string(config), err
+}
+
+// isVirusCheckerConfigManaged - whether the configuration file is managed by terraform
+func isVirusCheckerConfigManaged(plan models.FileVirusChecker) bool {
+	return !plan.Config.IsNull() || !plan.ConfigFilePath.IsNull()
+}
+
+// virusCheckerConfig - returns the configured configuration file content, read from the local file when given by path
+func virusCheckerConfig(config, filePath types.String) (string, error) {
+	if !config.IsNull() {
+		return config.ValueString(), nil
+	}
+	content, err := os.ReadFile(filePath.ValueString())
+	return string(content), err
+}
+
+// virusCheckerConfigHash - returns the hex encoded SHA-256 hash of a configuration file
+func virusCheckerConfigHash(config string) string {
+	sum := sha256.Sum256([]byte(config))
+	return hex.EncodeToString(sum[:])
+}
+
+// virusCheckerConfigHashModifier plans the hash of the configured configuration file
+// so that drift of the configuration file on the array shows up in the plan
+type virusCheckerConfigHashModifier struct{}
+
+// PlanModifyString plans the configuration file hash
+func (m virusCheckerConfigHashModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	var config, filePath types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("config"), &config)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("config_file_path"), &filePath)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// the uploaded configuration file is left as it is when it is not managed by terraform
+	if config.IsNull() && filePath.IsNull() {
+		if !req.StateValue.IsNull() {
+			resp.PlanValue = req.StateValue
+		}
+		return
+	}
+	if config.IsUnknown() || filePath.IsUnknown() {
+		return
+	}
+	content, err := virusCheckerConfig(config, filePath)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("config_file_path"),
+			"Error reading virus checker configuration file",
+			"Could not read virus checker configuration file, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	resp.PlanValue = types.StringValue(virusCheckerConfigHash(content))
+}
+
+// Description of the configuration file hash plan modifier
+func (m virusCheckerConfigHashModifier) Description(ctx context.Context) string {
+	return "Plans the SHA-256 hash of the configured virus checker configuration file"
+}
+
+// MarkdownDescription of the configuration file hash plan modifier
+func (m virusCheckerConfigHashModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
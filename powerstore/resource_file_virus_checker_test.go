@@ -0,0 +1,110 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Test to Create, Update, Import and Delete File Virus Checker Resource
+func TestAccFileVirusChecker(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfigForTesting + FileVirusCheckerParamsConflict,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			{
+				Config:      ProviderConfigForTesting + FileVirusCheckerParamsMissingFile,
+				ExpectError: regexp.MustCompile("Error reading virus checker configuration file"),
+			},
+			{
+				Config: ProviderConfigForTesting + FileVirusCheckerParamsCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerstore_file_virus_checker.test", "nas_server_id", nasServerID),
+					resource.TestCheckResourceAttr("powerstore_file_virus_checker.test", "is_enabled", "false"),
+					resource.TestCheckResourceAttr("powerstore_file_virus_checker.test", "is_config_file_uploaded", "true"),
+					resource.TestCheckResourceAttrSet("powerstore_file_virus_checker.test", "config_hash"),
+				),
+			},
+			// Import Testing
+			{
+				Config:                  ProviderConfigForTesting + FileVirusCheckerParamsCreate,
+				ResourceName:            "powerstore_file_virus_checker.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"config"},
+			},
+			{
+				Config: ProviderConfigForTesting + FileVirusCheckerParamsUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerstore_file_virus_checker.test", "is_enabled", "true"),
+					resource.TestCheckResourceAttr("powerstore_file_virus_checker.test", "offline_policy", "Stop_SMB_Access"),
+				),
+			},
+		},
+	})
+}
+
+var FileVirusCheckerParamsConflict = `
+resource "powerstore_file_virus_checker" "test" {
+	nas_server_id = "` + nasServerID + `"
+	config = "addr=` + virusCheckerAddress + `"
+	config_file_path = "viruschecker.conf"
+}
+`
+
+var FileVirusCheckerParamsMissingFile = `
+resource "powerstore_file_virus_checker" "test" {
+	nas_server_id = "` + nasServerID + `"
+	config_file_path = "/tmp/tfacc-missing/viruschecker.conf"
+}
+`
+
+var FileVirusCheckerParamsCreate = `
+resource "powerstore_file_virus_checker" "test" {
+	nas_server_id = "` + nasServerID + `"
+	is_enabled = false
+	config = <<-EOT
+		masks=*.exe:*.com:*.doc:*.docx:*.xls:*.xlsx
+		addr=` + virusCheckerAddress + `
+	EOT
+}
+`
+
+var FileVirusCheckerParamsUpdate = `
+resource "powerstore_file_virus_checker" "test" {
+	nas_server_id = "` + nasServerID + `"
+	is_enabled = true
+	offline_policy = "Stop_SMB_Access"
+	config = <<-EOT
+		masks=*.exe:*.com:*.doc:*.docx:*.xls:*.xlsx:*.zip
+		addr=` + virusCheckerAddress + `
+	EOT
+}
+`
@@ -24,6 +24,7 @@ import (
 	"regexp"
 	client "terraform-provider-powerstore/client"
 	"terraform-provider-powerstore/models"
+	"time"
 
 	"terraform-provider-powerstore/powerstore/helper"
 
@@ -456,11 +457,12 @@ func (r fileSystemResource) Create(ctx context.Context, req resource.CreateReque
 	}
 
 	// Create New FileSystem
+	started := time.Now()
 	fsCreateResponse, err := r.client.PStoreClient.CreateFS(context.Background(), fileSystemCreate)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating file system",
-			"Could not create file system, unexpected error: "+err.Error(),
+			"Could not create file system, unexpected error: "+err.Error()+r.client.FailedJobDetails(ctx, started, "file_system", "", fileSystemCreate.Name),
 		)
 		return
 	}
@@ -637,11 +639,12 @@ func (r fileSystemResource) Update(ctx context.Context, req resource.UpdateReque
 		}
 	}
 
+	started := time.Now()
 	err := r.client.ModifyFS(context.Background(), fsModify, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating file system",
-			"Could not update file system "+err.Error(),
+			"Could not update file system "+err.Error()+r.client.FailedJobDetails(ctx, started, "file_system", state.ID.ValueString(), ""),
 		)
 		return
 	}
@@ -682,11 +685,12 @@ func (r fileSystemResource) Delete(ctx context.Context, req resource.DeleteReque
 	fsID := state.ID.ValueString()
 
 	// Delete file system  by calling API
+	started := time.Now()
 	_, err := r.client.PStoreClient.DeleteFS(context.Background(), fsID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting file system",
-			"Could not delete file system "+fsID+": "+err.Error(),
+			"Could not delete file system "+fsID+": "+err.Error()+r.client.FailedJobDetails(ctx, started, "file_system", fsID, ""),
 		)
 		return
 	}
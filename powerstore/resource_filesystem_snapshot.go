@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"time"
 
 	"github.com/dell/gopowerstore"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -161,11 +162,12 @@ func (r *resourceFileSystemSnapshot) Create(ctx context.Context, req resource.Cr
 		AccessType:          plan.AccessType.ValueString(),
 	}
 
+	started := time.Now()
 	snapCreateResponse, err := r.client.PStoreClient.CreateFsSnapshot(context.Background(), snapCreate, fileSystemID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating filesystem snapshot",
-			"Could not create filesystem snapshot, unexpected error: "+err.Error(),
+			"Could not create filesystem snapshot, unexpected error: "+err.Error()+r.client.FailedJobDetails(ctx, started, "file_system", fileSystemID, ""),
 		)
 		return
 	}
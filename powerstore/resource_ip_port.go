@@ -0,0 +1,370 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-powerstore/client"
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+)
+
+// ipPortSelect lists the IP port fields read by the ip port resource
+const ipPortSelect = "id,current_usages,available_usages,target_iqn,eth_port_id,bond_id,ip_pool_addresses(address,network_id)"
+
+// ipPortManagedUsages are the IP port usages that can be added to or removed from an IP port
+var ipPortManagedUsages = []string{
+	string(clientgen.IPPORTUSAGEENUM_ISCSI),
+	string(clientgen.IPPORTUSAGEENUM_NVME_TCP),
+	string(clientgen.IPPORTUSAGEENUM_EXTERNAL_REPLICATION),
+}
+
+// newIPPortResource returns ip port new resource instance
+func newIPPortResource() resource.Resource {
+	return &resourceIPPort{}
+}
+
+type resourceIPPort struct {
+	client    *clientgen.APIClient
+	allclient *client.Client
+}
+
+// Metadata defines resource interface Metadata method
+func (r *resourceIPPort) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ip_port"
+}
+
+// Schema defines resource interface Schema method
+func (r *resourceIPPort) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to map a storage network to an IP port of PowerStore Array, which assigns storage IPs of the network to the port. We can Create, Update and Delete the mapping using this resource. We can also import an existing mapping from PowerStore array.",
+		Description:         "This resource is used to map a storage network to an IP port of PowerStore Array, which assigns storage IPs of the network to the port. We can Create, Update and Delete the mapping using this resource. We can also import an existing mapping from PowerStore array.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Unique identifier of the IP port.",
+				MarkdownDescription: "Unique identifier of the IP port.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ip_port_id": schema.StringAttribute{
+				Description:         "Unique identifier of the IP port to which storage IPs are assigned. Cannot be updated.",
+				MarkdownDescription: "Unique identifier of the IP port to which storage IPs are assigned. Cannot be updated.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				Description:         "Unique identifier of the storage network whose IPs are assigned to the IP port. Cannot be updated.",
+				MarkdownDescription: "Unique identifier of the storage network whose IPs are assigned to the IP port. Cannot be updated.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"usages": schema.SetAttribute{
+				Description:         "Usages of the IP port on the network. Valid values are ISCSI, NVMe_TCP and External_Replication. The usages must be in the available usages of the IP port.",
+				MarkdownDescription: "Usages of the IP port on the network. Valid values are `ISCSI`, `NVMe_TCP` and `External_Replication`. The usages must be in the available usages of the IP port.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(ipPortManagedUsages...)),
+				},
+			},
+			"current_usages": schema.ListAttribute{
+				Description:         "All current usages of the IP port.",
+				MarkdownDescription: "All current usages of the IP port.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"available_usages": schema.ListAttribute{
+				Description:         "Usages available on the IP port.",
+				MarkdownDescription: "Usages available on the IP port.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"target_iqn": schema.StringAttribute{
+				Description:         "iSCSI qualified name used by the target configured on top of the IP port.",
+				MarkdownDescription: "iSCSI qualified name used by the target configured on top of the IP port.",
+				Computed:            true,
+			},
+			"eth_port_id": schema.StringAttribute{
+				Description:         "Unique identifier of the Ethernet port on which the IP port is configured.",
+				MarkdownDescription: "Unique identifier of the Ethernet port on which the IP port is configured.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"bond_id": schema.StringAttribute{
+				Description:         "Unique identifier of the bond on which the IP port is configured.",
+				MarkdownDescription: "Unique identifier of the bond on which the IP port is configured.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ip_addresses": schema.ListAttribute{
+				Description:         "IP addresses of the network assigned to the IP port.",
+				MarkdownDescription: "IP addresses of the network assigned to the IP port.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+// Configure - defines configuration for ip port resource
+func (r *resourceIPPort) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client.GenClient
+	r.allclient = client
+}
+
+// Create - assigns the network to the IP port by adding the usages
+func (r *resourceIPPort) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.IPPort
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := plan.IPPortID.ValueString()
+	err := r.modify(ctx, id, plan.NetworkID.ValueString(), helper.SetStrings(plan.Usages), nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating ip port",
+			"Could not assign network to ip port "+id+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state, dgs := r.read(ctx, id, plan.NetworkID.ValueString())
+	resp.Diagnostics.Append(dgs...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Create")
+}
+
+// Read - reads ip port resource information
+func (r *resourceIPPort) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.IPPort
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, dgs := r.read(ctx, state.ID.ValueString(), state.NetworkID.ValueString())
+	resp.Diagnostics.Append(dgs...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Read")
+}
+
+// Update - adds and removes usages of the IP port
+func (r *resourceIPPort) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	log.Printf("Started Update")
+
+	var plan models.IPPort
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.IPPort
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	add, remove := helper.SetDifference(plan.Usages, state.Usages)
+	err := r.modify(ctx, id, state.NetworkID.ValueString(), add, remove)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating ip port",
+			"Could not update ip port "+id+": "+err.Error(),
+		)
+		return
+	}
+
+	state, dgs := r.read(ctx, id, state.NetworkID.ValueString())
+	resp.Diagnostics.Append(dgs...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Successfully done with Update")
+}
+
+// Delete - unassigns the network from the IP port by removing the usages
+func (r *resourceIPPort) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	log.Printf("Started with Delete")
+
+	var state models.IPPort
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	err := r.modify(ctx, id, state.NetworkID.ValueString(), nil, helper.SetStrings(state.Usages))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting ip port",
+			"Could not unassign network from ip port "+id+": "+err.Error(),
+		)
+		return
+	}
+
+	log.Printf("Done with Delete")
+}
+
+// ImportState - imports state for existing ip port, the import id is <ip_port_id>/<network_id>
+func (r *resourceIPPort) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ipPortID, networkID, found := strings.Cut(req.ID, "/")
+	if !found || ipPortID == "" || networkID == "" {
+		resp.Diagnostics.AddError(
+			"Error importing ip port",
+			"Expected import identifier with format <ip_port_id>/<network_id>, got: "+req.ID,
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), ipPortID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ip_port_id"), ipPortID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), networkID)...)
+}
+
+// modify - adds and removes usages of the IP port on the network
+func (r *resourceIPPort) modify(ctx context.Context, id, networkID string, add, remove []string) error {
+	if len(add) == 0 && len(remove) == 0 {
+		return nil
+	}
+	toUsages := func(in string) clientgen.IpPortUsageEnum { return clientgen.IpPortUsageEnum(in) }
+	return r.allclient.WithMaintenanceWindow(ctx, func() error {
+		_, err := r.client.IpPortApi.PatchIpPortById(ctx, id).Body(clientgen.IpPortModify{
+			AddCurrentUsages:    helper.SliceTransform(add, toUsages),
+			RemoveCurrentUsages: helper.SliceTransform(remove, toUsages),
+			NetworkId:           &networkID,
+		}).Execute()
+		return err
+	})
+}
+
+// read - fetches the IP port and converts it to the resource state
+func (r *resourceIPPort) read(ctx context.Context, id, networkID string) (models.IPPort, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	queries := make(url.Values)
+	queries.Set("select", ipPortSelect)
+	ipPort, _, err := r.client.IpPortApi.GetIpPortById(ctx, id).Queries(queries).Execute()
+	if err != nil {
+		diags.AddError(
+			"Error reading ip port",
+			"Could not read ip port with error "+id+": "+err.Error(),
+		)
+		return models.IPPort{}, diags
+	}
+
+	toStrings := func(in clientgen.IpPortUsageEnum) string { return string(in) }
+	currentUsages := helper.SliceTransform(ipPort.CurrentUsages, toStrings)
+	var usages, addresses []string
+	for _, usage := range currentUsages {
+		if slices.Contains(ipPortManagedUsages, usage) {
+			usages = append(usages, usage)
+		}
+	}
+	for _, address := range ipPort.IpPoolAddresses {
+		if helper.TfString(address.NetworkId).ValueString() == networkID {
+			addresses = append(addresses, helper.TfString(address.Address).ValueString())
+		}
+	}
+
+	state := models.IPPort{
+		ID:        helper.TfString(ipPort.Id),
+		IPPortID:  helper.TfString(ipPort.Id),
+		NetworkID: types.StringValue(networkID),
+		TargetIqn: helper.TfString(ipPort.TargetIqn),
+		EthPortID: helper.TfString(ipPort.EthPortId),
+		BondID:    helper.TfString(ipPort.BondId),
+	}
+	var dgs diag.Diagnostics
+	state.Usages, dgs = types.SetValueFrom(ctx, types.StringType, usages)
+	diags.Append(dgs...)
+	state.CurrentUsages, dgs = types.ListValueFrom(ctx, types.StringType, currentUsages)
+	diags.Append(dgs...)
+	state.AvailableUsages, dgs = types.ListValueFrom(ctx, types.StringType, helper.SliceTransform(ipPort.AvailableUsages, toStrings))
+	diags.Append(dgs...)
+	state.IPAddresses, dgs = types.ListValueFrom(ctx, types.StringType, addresses)
+	diags.Append(dgs...)
+	return state, diags
+}
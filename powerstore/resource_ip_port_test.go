@@ -0,0 +1,123 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// Test to Create, Update and Import IP Port Resource
+func TestAccIPPort(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfigForTesting + IPPortParamsInvalidUsage,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+			{
+				Config:      ProviderConfigForTesting + IPPortParamsInvalidPort,
+				ExpectError: regexp.MustCompile("Error creating ip port"),
+			},
+			{
+				Config: ProviderConfigForTesting + IPPortParamsCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerstore_ip_port.test", "ip_port_id", ipPortID),
+					resource.TestCheckResourceAttr("powerstore_ip_port.test", "usages.#", "1"),
+					resource.TestCheckResourceAttr("powerstore_ip_port.test", "ip_addresses.#", "1"),
+				),
+			},
+			// Import Testing
+			{
+				Config:            ProviderConfigForTesting + IPPortParamsCreate,
+				ResourceName:      "powerstore_ip_port.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["powerstore_ip_port.test"]
+					return rs.Primary.Attributes["ip_port_id"] + "/" + rs.Primary.Attributes["network_id"], nil
+				},
+			},
+			// Import Negative Testing
+			{
+				Config:        ProviderConfigForTesting + IPPortParamsCreate,
+				ResourceName:  "powerstore_ip_port.test",
+				ImportState:   true,
+				ExpectError:   regexp.MustCompile("Error importing ip port"),
+				ImportStateId: "invalid-id",
+			},
+			{
+				Config: ProviderConfigForTesting + IPPortParamsUpdate,
+				Check:  resource.TestCheckResourceAttr("powerstore_ip_port.test", "usages.#", "2"),
+			},
+		},
+	})
+}
+
+var IPPortNetworkConfig = `
+resource "powerstore_network" "test" {
+	name = "tfacc_ip_port_network"
+	type = "Storage"
+	purposes = ["ISCSI", "NVMe_TCP"]
+	prefix_length = 24
+	mtu = 1500
+	addresses = [` + networkAddresses + `]
+}
+`
+
+var IPPortParamsInvalidUsage = `
+resource "powerstore_ip_port" "test" {
+	ip_port_id = "` + ipPortID + `"
+	network_id = "invalid-id"
+	usages = ["invalid"]
+}
+`
+
+var IPPortParamsInvalidPort = `
+resource "powerstore_ip_port" "test" {
+	ip_port_id = "invalid-id"
+	network_id = "invalid-id"
+	usages = ["ISCSI"]
+}
+`
+
+var IPPortParamsCreate = IPPortNetworkConfig + `
+resource "powerstore_ip_port" "test" {
+	ip_port_id = "` + ipPortID + `"
+	network_id = powerstore_network.test.id
+	usages = ["ISCSI"]
+}
+`
+
+var IPPortParamsUpdate = IPPortNetworkConfig + `
+resource "powerstore_ip_port" "test" {
+	ip_port_id = "` + ipPortID + `"
+	network_id = powerstore_network.test.id
+	usages = ["ISCSI", "NVMe_TCP"]
+}
+`
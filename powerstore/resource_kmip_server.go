@@ -0,0 +1,495 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-powerstore/client"
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+)
+
+// newKmipServerResource returns kmip server new resource instance
+func newKmipServerResource() resource.Resource {
+	return &resourceKmipServer{}
+}
+
+type resourceKmipServer struct {
+	client *clientgen.APIClient
+}
+
+// Metadata defines resource interface Metadata method
+func (r *resourceKmipServer) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kmip_server"
+}
+
+// Schema defines resource interface Schema method
+func (r *resourceKmipServer) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage the external key manager (KMIP) servers used by PowerStore Array for data at rest encryption. The cluster has exactly one KMIP configuration, so creating this resource adopts the existing configuration and destroying it disables the external key management. The client and CA certificates are imported as KMIP_HTTP certificates. We can also import the existing KMIP configuration from PowerStore array.",
+		Description:         "This resource is used to manage the external key manager (KMIP) servers used by PowerStore Array for data at rest encryption. The cluster has exactly one KMIP configuration, so creating this resource adopts the existing configuration and destroying it disables the external key management. The client and CA certificates are imported as KMIP_HTTP certificates. We can also import the existing KMIP configuration from PowerStore array.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Unique identifier of the KMIP configuration.",
+				MarkdownDescription: "Unique identifier of the KMIP configuration.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"servers": schema.SetAttribute{
+				Description:         "IPv4, IPv6 addresses or host names of the KMIP servers.",
+				MarkdownDescription: "IPv4, IPv6 addresses or host names of the KMIP servers.",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"port": schema.Int64Attribute{
+				Description:         "Port used to connect to the KMIP servers, 5696 by default.",
+				MarkdownDescription: "Port used to connect to the KMIP servers, `5696` by default.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_timeout": schema.Int64Attribute{
+				Description:         "Timeout in seconds to connect to a KMIP server, 5 by default.",
+				MarkdownDescription: "Timeout in seconds to connect to a KMIP server, `5` by default.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"username": schema.StringAttribute{
+				Description:         "User name used to access the KMIP servers.",
+				MarkdownDescription: "User name used to access the KMIP servers.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"password": schema.StringAttribute{
+				Description:         "Password used to access the KMIP servers. This attribute is write-only and is never stored in the state, change password_version to set a new password.",
+				MarkdownDescription: "Password used to access the KMIP servers. This attribute is write-only and is never stored in the state, change `password_version` to set a new password.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"password_version": schema.Int64Attribute{
+				Description:         "Version of the password. Since the password is not stored in the state, changes to it are only applied when this value changes.",
+				MarkdownDescription: "Version of the password. Since the password is not stored in the state, changes to it are only applied when this value changes.",
+				Optional:            true,
+			},
+			"is_enabled": schema.BoolAttribute{
+				Description:         "Whether the external key management is enabled. Enabling it requires at least one reachable KMIP server.",
+				MarkdownDescription: "Whether the external key management is enabled. Enabling it requires at least one reachable KMIP server.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"client_certificate": schema.StringAttribute{
+				Description:         "PEM encoded client certificate chain the array presents to the KMIP servers. Changing it imports a new certificate.",
+				MarkdownDescription: "PEM encoded client certificate chain the array presents to the KMIP servers. Changing it imports a new certificate.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("client_private_key")),
+				},
+			},
+			"client_private_key": schema.StringAttribute{
+				Description:         "PEM encoded private key of the client certificate, following encrypted PKCS8. This attribute is write-only and is never stored in the state, it is only sent along with a new client_certificate.",
+				MarkdownDescription: "PEM encoded private key of the client certificate, following encrypted PKCS8. This attribute is write-only and is never stored in the state, it is only sent along with a new `client_certificate`.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("client_certificate"), path.MatchRoot("client_private_key_passphrase")),
+				},
+			},
+			"client_private_key_passphrase": schema.StringAttribute{
+				Description:         "Passphrase used to encrypt the private key of the client certificate. This attribute is write-only and is never stored in the state.",
+				MarkdownDescription: "Passphrase used to encrypt the private key of the client certificate. This attribute is write-only and is never stored in the state.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("client_private_key")),
+				},
+			},
+			"client_certificate_id": schema.StringAttribute{
+				Description:         "Unique identifier of the imported client certificate.",
+				MarkdownDescription: "Unique identifier of the imported client certificate.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					certificateIDModifier{certificate: "client_certificate"},
+				},
+			},
+			"ca_certificate": schema.StringAttribute{
+				Description:         "PEM encoded CA certificate used to verify the KMIP servers. Changing it imports a new certificate.",
+				MarkdownDescription: "PEM encoded CA certificate used to verify the KMIP servers. Changing it imports a new certificate.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"ca_certificate_id": schema.StringAttribute{
+				Description:         "Unique identifier of the imported CA certificate.",
+				MarkdownDescription: "Unique identifier of the imported CA certificate.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					certificateIDModifier{certificate: "ca_certificate"},
+				},
+			},
+		},
+	}
+}
+
+// Configure - defines configuration for kmip server resource
+func (r *resourceKmipServer) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client.GenClient
+}
+
+// Create - adopts the KMIP configuration of the cluster and applies the planned servers
+func (r *resourceKmipServer) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.KmipServer
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configs, _, err := r.client.KmipConfigApi.GetAllKmipConfigs(ctx).Execute()
+	if err == nil && len(configs) == 0 {
+		err = fmt.Errorf("no KMIP configuration found")
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating kmip server",
+			"Could not find kmip config, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	id := helper.TfString(configs[0].Id).ValueString()
+
+	// the certificates are needed to connect to the servers, so they are imported first
+	resp.Diagnostics.Append(r.importCertificates(ctx, req.Config, &plan, models.KmipServer{})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var password types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &password)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err = r.client.KmipConfigApi.PatchKmipConfigById(ctx, id).Body(clientgen.KmipConfigModify{
+		IsEnabled:     helper.ValueToPointer[bool](plan.IsEnabled),
+		Servers:       helper.SetStrings(plan.Servers),
+		Port:          helper.KnownInt32(plan.Port),
+		ServerTimeout: helper.KnownInt32(plan.ServerTimeout),
+		Username:      helper.ValueToPointer[string](plan.Username),
+		Password:      helper.ValueToPointer[string](password),
+	}).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating kmip server",
+			"Could not update kmip config "+id+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	config, _, err := r.client.KmipConfigApi.GetKmipConfigById(ctx, id).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting kmip server after creation",
+			"Could not get kmip config, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state, dgs := r.updateState(ctx, config, plan)
+	resp.Diagnostics.Append(dgs...)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Create")
+}
+
+// Read - reads kmip server resource information
+func (r *resourceKmipServer) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.KmipServer
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	config, _, err := r.client.KmipConfigApi.GetKmipConfigById(ctx, id).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading kmip server",
+			"Could not read kmip config with error "+id+": "+err.Error(),
+		)
+		return
+	}
+
+	state, diags = r.updateState(ctx, config, state)
+	resp.Diagnostics.Append(diags...)
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Read")
+}
+
+// Update - updates kmip server resource
+func (r *resourceKmipServer) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	log.Printf("Started Update")
+
+	var plan models.KmipServer
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.KmipServer
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.importCertificates(ctx, req.Config, &plan, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configModify := clientgen.KmipConfigModify{}
+	if !plan.IsEnabled.Equal(state.IsEnabled) {
+		configModify.IsEnabled = helper.ValueToPointer[bool](plan.IsEnabled)
+	}
+	if !plan.Servers.Equal(state.Servers) {
+		configModify.Servers = helper.SetStrings(plan.Servers)
+	}
+	if !plan.Port.Equal(state.Port) {
+		configModify.Port = helper.KnownInt32(plan.Port)
+	}
+	if !plan.ServerTimeout.Equal(state.ServerTimeout) {
+		configModify.ServerTimeout = helper.KnownInt32(plan.ServerTimeout)
+	}
+	if !plan.Username.Equal(state.Username) {
+		configModify.Username = helper.ValueToPointer[string](plan.Username)
+	}
+	if !plan.PasswordVersion.Equal(state.PasswordVersion) {
+		var password types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &password)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		configModify.Password = helper.ValueToPointer[string](password)
+	}
+
+	id := state.ID.ValueString()
+	_, err := r.client.KmipConfigApi.PatchKmipConfigById(ctx, id).Body(configModify).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating kmip server",
+			"Could not update kmip config "+id+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	config, _, err := r.client.KmipConfigApi.GetKmipConfigById(ctx, id).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting kmip server after update",
+			"Could not get kmip config, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state, diags = r.updateState(ctx, config, plan)
+	resp.Diagnostics.Append(diags...)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Successfully done with Update")
+}
+
+// Delete - disables the external key management
+func (r *resourceKmipServer) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	log.Printf("Started with Delete")
+
+	var state models.KmipServer
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	// the KMIP configuration itself cannot be deleted, and neither can the imported certificates
+	_, err := r.client.KmipConfigApi.PatchKmipConfigById(ctx, id).Body(clientgen.KmipConfigModify{
+		IsEnabled: helper.GetPointer(false),
+	}).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting kmip server",
+			"Could not disable kmip config "+id+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	log.Printf("Done with Delete")
+}
+
+// ImportState - imports state for existing kmip config
+func (r *resourceKmipServer) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// importCertificates - imports the client and CA certificates which changed since the state
+func (r *resourceKmipServer) importCertificates(ctx context.Context, config tfsdk.Config, plan *models.KmipServer, state models.KmipServer) diag.Diagnostics {
+	var diags diag.Diagnostics
+	plan.ClientCertificateID = state.ClientCertificateID
+	plan.CaCertificateID = state.CaCertificateID
+
+	if helper.IsKnownValue(plan.ClientCertificate) && !plan.ClientCertificate.Equal(state.ClientCertificate) {
+		var privateKey, passphrase types.String
+		diags.Append(config.GetAttribute(ctx, path.Root("client_private_key"), &privateKey)...)
+		diags.Append(config.GetAttribute(ctx, path.Root("client_private_key_passphrase"), &passphrase)...)
+		if diags.HasError() {
+			return diags
+		}
+		id, err := r.importCertificate(ctx, clientgen.X509CERTIFICATEUSAGETYPEENUM_CLIENT, plan.ClientCertificate, privateKey, passphrase)
+		if err != nil {
+			diags.AddError(
+				"Error importing kmip client certificate",
+				"Could not import kmip client certificate, unexpected error: "+err.Error(),
+			)
+			return diags
+		}
+		plan.ClientCertificateID = types.StringValue(id)
+	}
+
+	if helper.IsKnownValue(plan.CaCertificate) && !plan.CaCertificate.Equal(state.CaCertificate) {
+		id, err := r.importCertificate(ctx, clientgen.X509CERTIFICATEUSAGETYPEENUM_CA_SERVER_VALIDATION, plan.CaCertificate, types.StringNull(), types.StringNull())
+		if err != nil {
+			diags.AddError(
+				"Error importing kmip CA certificate",
+				"Could not import kmip CA certificate, unexpected error: "+err.Error(),
+			)
+			return diags
+		}
+		plan.CaCertificateID = types.StringValue(id)
+	}
+	return diags
+}
+
+// importCertificate - imports a certificate of the KMIP service and returns its id
+func (r *resourceKmipServer) importCertificate(ctx context.Context, usage clientgen.X509CertificateUsageTypeEnum, certificate, privateKey, passphrase types.String) (string, error) {
+	createResp, _, err := r.client.X509CertificateApi.PostAllX509Certificates(ctx).Body(clientgen.X509CertificateCreate{
+		Type:        usage,
+		Service:     clientgen.X509CERTIFICATESERVICEENUM_KMIP_HTTP,
+		Certificate: certificate.ValueString(),
+		PrivateKey:  helper.ValueToPointer[string](privateKey),
+		Passphrase:  helper.ValueToPointer[string](passphrase),
+		IsCurrent:   helper.GetPointer(true),
+	}).Execute()
+	if err != nil {
+		return "", err
+	}
+	return helper.TfString(createResp.Id).ValueString(), nil
+}
+
+// updateState - converts the kmip config response to the resource state
+func (r *resourceKmipServer) updateState(ctx context.Context, config *clientgen.KmipConfigInstance, plan models.KmipServer) (models.KmipServer, diag.Diagnostics) {
+	servers, diags := types.SetValueFrom(ctx, types.StringType, helper.SliceTransform(config.Servers, func(in clientgen.KmipConfigMemberInstance) string {
+		return helper.TfString(in.Address).ValueString()
+	}))
+	return models.KmipServer{
+		ID:                         helper.TfString(config.Id),
+		Servers:                    servers,
+		Port:                       helper.TfInt64(config.Port),
+		ServerTimeout:              helper.TfInt64(config.ServerTimeout),
+		Username:                   helper.TfString(config.Username),
+		Password:                   types.StringNull(),
+		PasswordVersion:            plan.PasswordVersion,
+		IsEnabled:                  helper.TfBool(config.IsEnabled),
+		ClientCertificate:          plan.ClientCertificate,
+		ClientPrivateKey:           types.StringNull(),
+		ClientPrivateKeyPassphrase: types.StringNull(),
+		ClientCertificateID:        plan.ClientCertificateID,
+		CaCertificate:              plan.CaCertificate,
+		CaCertificateID:            plan.CaCertificateID,
+	}, diags
+}
@@ -0,0 +1,132 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Test to Create, Update, Import and Delete KMIP Server Resource
+func TestAccKmipServer(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfigForTesting + KmipServerParamsInvalidPort,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value"),
+			},
+			{
+				Config:      ProviderConfigForTesting + KmipServerParamsKeyWithoutCertificate,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			{
+				Config: ProviderConfigForTesting + KmipServerParamsCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerstore_kmip_server.test", "servers.#", "1"),
+					resource.TestCheckResourceAttr("powerstore_kmip_server.test", "port", "5696"),
+					resource.TestCheckResourceAttr("powerstore_kmip_server.test", "is_enabled", "false"),
+				),
+			},
+			// Import Testing
+			{
+				Config:            ProviderConfigForTesting + KmipServerParamsCreate,
+				ResourceName:      "powerstore_kmip_server.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: ProviderConfigForTesting + KmipServerParamsUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerstore_kmip_server.test", "server_timeout", "10"),
+					resource.TestCheckResourceAttr("powerstore_kmip_server.test", "username", "tfacc_kmip_user"),
+				),
+			},
+			// Add a CA certificate and then change it, the id of the imported certificate must follow
+			{
+				Config: ProviderConfigForTesting + KmipServerParamsCaCertificate(x509CACertificate),
+				Check:  resource.TestCheckResourceAttrSet("powerstore_kmip_server.test", "ca_certificate_id"),
+			},
+			{
+				Config: ProviderConfigForTesting + KmipServerParamsCaCertificate(x509CACertificateUpdate),
+				Check:  resource.TestCheckResourceAttrSet("powerstore_kmip_server.test", "ca_certificate_id"),
+			},
+		},
+	})
+}
+
+var KmipServerParamsInvalidPort = `
+resource "powerstore_kmip_server" "test" {
+	servers = ["` + kmipServerAddress + `"]
+	port = 70000
+}
+`
+
+var KmipServerParamsKeyWithoutCertificate = `
+resource "powerstore_kmip_server" "test" {
+	servers = ["` + kmipServerAddress + `"]
+	client_private_key = "invalid"
+	client_private_key_passphrase = "invalid"
+}
+`
+
+var KmipServerParamsCreate = `
+resource "powerstore_kmip_server" "test" {
+	servers = ["` + kmipServerAddress + `"]
+	port = 5696
+	is_enabled = false
+}
+`
+
+var KmipServerParamsUpdate = `
+resource "powerstore_kmip_server" "test" {
+	servers = ["` + kmipServerAddress + `"]
+	port = 5696
+	server_timeout = 10
+	username = "tfacc_kmip_user"
+	password = "Password123!"
+	password_version = 1
+	is_enabled = false
+}
+`
+
+// KmipServerParamsCaCertificate returns the updated configuration with the given CA certificate
+func KmipServerParamsCaCertificate(certificate string) string {
+	return `
+resource "powerstore_kmip_server" "test" {
+	servers = ["` + kmipServerAddress + `"]
+	port = 5696
+	server_timeout = 10
+	username = "tfacc_kmip_user"
+	password = "Password123!"
+	password_version = 1
+	is_enabled = false
+	ca_certificate = <<-EOT
+` + certificate + `
+EOT
+}
+`
+}
@@ -0,0 +1,305 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"terraform-provider-powerstore/client"
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+)
+
+// ldapAccountSelect lists the LDAP account fields read by the ldap account resource
+const ldapAccountSelect = "id,domain_id,name,type,role_id,dn"
+
+// newLdapAccountResource returns ldap account new resource instance
+func newLdapAccountResource() resource.Resource {
+	return &resourceLdapAccount{}
+}
+
+type resourceLdapAccount struct {
+	client *clientgen.APIClient
+}
+
+// Metadata defines resource interface Metadata method
+func (r *resourceLdapAccount) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ldap_account"
+}
+
+// Schema defines resource interface Schema method
+func (r *resourceLdapAccount) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to map an LDAP user or group of a management LDAP domain to a role of PowerStore Array. We can Create, Update and Delete the LDAP account using this resource. We can also import an existing LDAP account from PowerStore array.",
+		Description:         "This resource is used to map an LDAP user or group of a management LDAP domain to a role of PowerStore Array. We can Create, Update and Delete the LDAP account using this resource. We can also import an existing LDAP account from PowerStore array.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Unique identifier of the LDAP account.",
+				MarkdownDescription: "Unique identifier of the LDAP account.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain_id": schema.StringAttribute{
+				Description:         "Unique identifier of the LDAP domain the user or group belongs to. Cannot be updated.",
+				MarkdownDescription: "Unique identifier of the LDAP domain the user or group belongs to. Cannot be updated.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description:         "Name of the LDAP user or group. Cannot be updated.",
+				MarkdownDescription: "Name of the LDAP user or group. Cannot be updated.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description:         "Type of the LDAP account. Valid values are User and Group. Defaults to User. Cannot be updated.",
+				MarkdownDescription: "Type of the LDAP account. Valid values are `User` and `Group`. Defaults to `User`. Cannot be updated.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(helper.SliceTransform(clientgen.AllowedLDAPAccountTypeEnumEnumValues, func(in clientgen.LDAPAccountTypeEnum) string {
+						return string(in)
+					})...),
+				},
+			},
+			"role_id": schema.StringAttribute{
+				Description:         "Unique identifier of the role the LDAP account is mapped to. Use the role datasource to find the role ids.",
+				MarkdownDescription: "Unique identifier of the role the LDAP account is mapped to. Use the role datasource to find the role ids.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"dn": schema.StringAttribute{
+				Description:         "Distinguished name of the LDAP user or group the account is mapped from.",
+				MarkdownDescription: "Distinguished name of the LDAP user or group the account is mapped from.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure - defines configuration for ldap account resource
+func (r *resourceLdapAccount) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client.GenClient
+}
+
+// Create - method to create ldap account resource
+func (r *resourceLdapAccount) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.LdapAccount
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createResp, _, err := r.client.LdapAccountApi.PostAllLdapAccounts(ctx).Body(clientgen.LdapAccountCreate{
+		DomainId: plan.DomainID.ValueString(),
+		Name:     plan.Name.ValueString(),
+		Type:     (*clientgen.LDAPAccountTypeEnum)(helper.ValueToPointer[string](plan.Type)),
+		RoleId:   plan.RoleID.ValueString(),
+	}).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating ldap account",
+			"Could not create ldap account, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	account, err := r.ReadAPI(ctx, helper.TfString(createResp.Id).ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting ldap account after creation",
+			"Could not get ldap account, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state := r.updateState(account)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Create")
+}
+
+// Read - reads ldap account resource information
+func (r *resourceLdapAccount) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.LdapAccount
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	account, err := r.ReadAPI(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ldap account",
+			"Could not read ldap account with error "+id+": "+err.Error(),
+		)
+		return
+	}
+
+	state = r.updateState(account)
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Read")
+}
+
+// Update - updates the role of the ldap account
+func (r *resourceLdapAccount) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	log.Printf("Started Update")
+
+	var plan models.LdapAccount
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.LdapAccount
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	_, err := r.client.LdapAccountApi.PatchLdapAccountById(ctx, id).Body(clientgen.LdapAccountModify{
+		RoleId: helper.ValueToPointer[string](plan.RoleID),
+	}).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating ldap account",
+			"Could not update ldap account "+id+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	account, err := r.ReadAPI(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting ldap account after update",
+			"Could not get ldap account, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state = r.updateState(account)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Successfully done with Update")
+}
+
+// Delete - method to delete ldap account resource
+func (r *resourceLdapAccount) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	log.Printf("Started with Delete")
+
+	var state models.LdapAccount
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	_, err := r.client.LdapAccountApi.DeleteLdapAccountById(ctx, id).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting ldap account",
+			"Could not delete ldap account "+id+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	log.Printf("Done with Delete")
+}
+
+// ImportState - imports state for existing ldap account
+func (r *resourceLdapAccount) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// ReadAPI - fetches the LDAP account by id
+func (r *resourceLdapAccount) ReadAPI(ctx context.Context, id string) (*clientgen.LdapAccountInstance, error) {
+	queries := make(url.Values)
+	queries.Set("select", ldapAccountSelect)
+	account, _, err := r.client.LdapAccountApi.GetLdapAccountById(ctx, id).Queries(queries).Execute()
+	return account, err
+}
+
+// updateState - converts the LDAP account response to the resource state
+func (r *resourceLdapAccount) updateState(account *clientgen.LdapAccountInstance) models.LdapAccount {
+	return models.LdapAccount{
+		ID:       helper.TfString(account.Id),
+		DomainID: helper.TfString(account.DomainId),
+		Name:     helper.TfString(account.Name),
+		Type:     helper.TfString(account.Type),
+		RoleID:   helper.TfString(account.RoleId),
+		DN:       helper.TfString(account.Dn),
+	}
+}
@@ -0,0 +1,90 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Test to Create, Update, Import and Delete LDAP Account Resource
+func TestAccLdapAccount(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfigForTesting + LdapAccountParamsInvalidDomain,
+				ExpectError: regexp.MustCompile("Error creating ldap account"),
+			},
+			{
+				Config: ProviderConfigForTesting + ManagementLdapParamsCreate + LdapAccountParamsCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerstore_ldap_account.test", "name", ldapGroupName),
+					resource.TestCheckResourceAttr("powerstore_ldap_account.test", "type", "Group"),
+					resource.TestCheckResourceAttr("powerstore_ldap_account.test", "role_id", "3"),
+				),
+			},
+			// Import Testing
+			{
+				Config:            ProviderConfigForTesting + ManagementLdapParamsCreate + LdapAccountParamsCreate,
+				ResourceName:      "powerstore_ldap_account.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: ProviderConfigForTesting + ManagementLdapParamsCreate + LdapAccountParamsUpdate,
+				Check:  resource.TestCheckResourceAttr("powerstore_ldap_account.test", "role_id", "1"),
+			},
+		},
+	})
+}
+
+var LdapAccountParamsInvalidDomain = `
+resource "powerstore_ldap_account" "test" {
+	domain_id = "invalid-domain-id"
+	name = "` + ldapGroupName + `"
+	type = "Group"
+	role_id = "3"
+}
+`
+
+var LdapAccountParamsCreate = `
+resource "powerstore_ldap_account" "test" {
+	domain_id = powerstore_management_ldap.test.id
+	name = "` + ldapGroupName + `"
+	type = "Group"
+	role_id = "3"
+}
+`
+
+var LdapAccountParamsUpdate = `
+resource "powerstore_ldap_account" "test" {
+	domain_id = powerstore_management_ldap.test.id
+	name = "` + ldapGroupName + `"
+	type = "Group"
+	role_id = "1"
+}
+`
@@ -0,0 +1,373 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-powerstore/client"
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+)
+
+// localUserSelect lists the local user fields read by the local user resource
+const localUserSelect = "id,name,role_id,is_locked,is_mfa_bypass,is_built_in,is_default_password,password_expiration_timestamp"
+
+// newLocalUserResource returns local user new resource instance
+func newLocalUserResource() resource.Resource {
+	return &resourceLocalUser{}
+}
+
+type resourceLocalUser struct {
+	client *clientgen.APIClient
+}
+
+// Metadata defines resource interface Metadata method
+func (r *resourceLocalUser) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_local_user"
+}
+
+// Schema defines resource interface Schema method
+func (r *resourceLocalUser) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage the local user accounts of PowerStore Array. We can Create, Update and Delete the local user using this resource. The password is write-only and is never stored in the Terraform state. We can also import an existing local user from PowerStore array.",
+		Description:         "This resource is used to manage the local user accounts of PowerStore Array. We can Create, Update and Delete the local user using this resource. The password is write-only and is never stored in the Terraform state. We can also import an existing local user from PowerStore array.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Unique identifier of the local user.",
+				MarkdownDescription: "Unique identifier of the local user.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description:         "Name of the local user. The name can be 1 to 64 characters long and may only use alphanumeric characters and dots. Cannot be updated.",
+				MarkdownDescription: "Name of the local user. The name can be 1 to 64 characters long and may only use alphanumeric characters and dots. Cannot be updated.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[a-zA-Z0-9.]+$`), "must only contain alphanumeric characters and dots"),
+				},
+			},
+			"role_id": schema.StringAttribute{
+				Description:         "Unique identifier of the role the local user is mapped to. Use the role datasource to find the role ids.",
+				MarkdownDescription: "Unique identifier of the role the local user is mapped to. Use the role datasource to find the role ids.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"is_locked": schema.BoolAttribute{
+				Description:         "Whether the local user account is locked. Defaults to false.",
+				MarkdownDescription: "Whether the local user account is locked. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"is_mfa_bypass": schema.BoolAttribute{
+				Description:         "Whether multi-factor authentication is bypassed for the local user. Only applies when MFA is enabled.",
+				MarkdownDescription: "Whether multi-factor authentication is bypassed for the local user. Only applies when MFA is enabled.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"password": schema.StringAttribute{
+				Description:         "Password of the local user. The password can be 8 to 40 characters long and must include at least one uppercase character, one lowercase character, one numeric character and one special character from (!,@#$%^*?_~). This attribute is write-only and is never stored in the state, change password_version to set a new password.",
+				MarkdownDescription: "Password of the local user. The password can be 8 to 40 characters long and must include at least one uppercase character, one lowercase character, one numeric character and one special character from (!,@#$%^*?_~). This attribute is write-only and is never stored in the state, change `password_version` to set a new password.",
+				Required:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(8, 40),
+				},
+			},
+			"password_version": schema.Int64Attribute{
+				Description:         "Version of the password. Since the password is not stored in the state, changes to it are only applied when this value changes.",
+				MarkdownDescription: "Version of the password. Since the password is not stored in the state, changes to it are only applied when this value changes.",
+				Optional:            true,
+			},
+			"is_built_in": schema.BoolAttribute{
+				Description:         "Whether the local user account is built-in.",
+				MarkdownDescription: "Whether the local user account is built-in.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_default_password": schema.BoolAttribute{
+				Description:         "Whether the local user account has a default password. Only applies to default user accounts.",
+				MarkdownDescription: "Whether the local user account has a default password. Only applies to default user accounts.",
+				Computed:            true,
+			},
+			"password_expiration_timestamp": schema.StringAttribute{
+				Description:         "Time when the password of the local user will expire.",
+				MarkdownDescription: "Time when the password of the local user will expire.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure - defines configuration for local user resource
+func (r *resourceLocalUser) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client.GenClient
+}
+
+// Create - method to create local user resource
+func (r *resourceLocalUser) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.LocalUser
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// write-only attributes are only available in the configuration
+	var password types.String
+	diags = req.Config.GetAttribute(ctx, path.Root("password"), &password)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createResp, _, err := r.client.LocalUserApi.PostAllLocalUsers(ctx).Body(clientgen.LocalUserCreate{
+		Name:        plan.Name.ValueString(),
+		Password:    password.ValueString(),
+		RoleId:      plan.RoleID.ValueString(),
+		IsMfaBypass: helper.ValueToPointer[bool](plan.IsMfaBypass),
+	}).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating local user",
+			"Could not create local user, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	id := helper.TfString(createResp.Id).ValueString()
+	// new users are always created unlocked
+	if plan.IsLocked.ValueBool() {
+		_, err := r.client.LocalUserApi.PatchLocalUserById(ctx, id).Body(clientgen.LocalUserModify{
+			IsLocked: clientgen.PtrBool(true),
+		}).Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating local user",
+				"Could not lock local user "+id+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	user, err := r.ReadAPI(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting local user after creation",
+			"Could not get local user, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state := r.updateState(user, plan.PasswordVersion)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Create")
+}
+
+// Read - reads local user resource information
+func (r *resourceLocalUser) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.LocalUser
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	user, err := r.ReadAPI(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading local user",
+			"Could not read local user with error "+id+": "+err.Error(),
+		)
+		return
+	}
+
+	state = r.updateState(user, state.PasswordVersion)
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Read")
+}
+
+// Update - updates local user resource
+func (r *resourceLocalUser) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	log.Printf("Started Update")
+
+	var plan models.LocalUser
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.LocalUser
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userModify := clientgen.LocalUserModify{}
+	if !plan.RoleID.Equal(state.RoleID) {
+		userModify.RoleId = helper.ValueToPointer[string](plan.RoleID)
+	}
+	if !plan.IsLocked.Equal(state.IsLocked) {
+		userModify.IsLocked = helper.ValueToPointer[bool](plan.IsLocked)
+	}
+	if helper.IsKnownValue(plan.IsMfaBypass) && !plan.IsMfaBypass.Equal(state.IsMfaBypass) {
+		userModify.IsMfaBypass = helper.ValueToPointer[bool](plan.IsMfaBypass)
+	}
+	if !plan.PasswordVersion.Equal(state.PasswordVersion) {
+		var password types.String
+		diags = req.Config.GetAttribute(ctx, path.Root("password"), &password)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		userModify.Password = helper.ValueToPointer[string](password)
+	}
+
+	id := state.ID.ValueString()
+	if userModify != (clientgen.LocalUserModify{}) {
+		_, err := r.client.LocalUserApi.PatchLocalUserById(ctx, id).Body(userModify).Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating local user",
+				"Could not update local user "+id+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	user, err := r.ReadAPI(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting local user after update",
+			"Could not get local user, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state = r.updateState(user, plan.PasswordVersion)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Successfully done with Update")
+}
+
+// Delete - method to delete local user resource
+func (r *resourceLocalUser) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	log.Printf("Started with Delete")
+
+	var state models.LocalUser
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	_, err := r.client.LocalUserApi.DeleteLocalUserById(ctx, id).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting local user",
+			"Could not delete local user "+id+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	log.Printf("Done with Delete")
+}
+
+// ImportState - imports state for existing local user
+func (r *resourceLocalUser) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// ReadAPI - fetches the local user by id
+func (r *resourceLocalUser) ReadAPI(ctx context.Context, id string) (*clientgen.LocalUserInstance, error) {
+	queries := make(url.Values)
+	queries.Set("select", localUserSelect)
+	user, _, err := r.client.LocalUserApi.GetLocalUserById(ctx, id).Queries(queries).Execute()
+	return user, err
+}
+
+// updateState - converts the local user response to the resource state
+// The password is write-only, so it is never set in the state
+func (r *resourceLocalUser) updateState(user *clientgen.LocalUserInstance, passwordVersion types.Int64) models.LocalUser {
+	return models.LocalUser{
+		ID:                          helper.TfString(user.Id),
+		Name:                        helper.TfString(user.Name),
+		RoleID:                      helper.TfString(user.RoleId),
+		IsLocked:                    helper.TfBool(user.IsLocked),
+		IsMfaBypass:                 helper.TfBool(user.IsMfaBypass),
+		Password:                    types.StringNull(),
+		PasswordVersion:             passwordVersion,
+		IsBuiltIn:                   helper.TfBool(user.IsBuiltIn),
+		IsDefaultPassword:           helper.TfBool(user.IsDefaultPassword),
+		PasswordExpirationTimestamp: helper.TfStringFromPTime(user.PasswordExpirationTimestamp),
+	}
+}
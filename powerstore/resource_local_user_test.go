@@ -0,0 +1,117 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Test to Create, Update, Import and Delete Local User Resource
+func TestAccLocalUser(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfigForTesting + LocalUserParamsInvalidName,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+			{
+				Config:      ProviderConfigForTesting + LocalUserParamsInvalidRole,
+				ExpectError: regexp.MustCompile("Error creating local user"),
+			},
+			{
+				Config: ProviderConfigForTesting + LocalUserParamsCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerstore_local_user.test", "name", "tfacc.local.user"),
+					resource.TestCheckResourceAttr("powerstore_local_user.test", "role_id", "3"),
+					resource.TestCheckResourceAttr("powerstore_local_user.test", "is_locked", "false"),
+					resource.TestCheckNoResourceAttr("powerstore_local_user.test", "password"),
+				),
+			},
+			// Import Testing
+			{
+				Config:                  ProviderConfigForTesting + LocalUserParamsCreate,
+				ResourceName:            "powerstore_local_user.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password_version"},
+			},
+			// Import Negative Testing
+			{
+				Config:        ProviderConfigForTesting + LocalUserParamsCreate,
+				ResourceName:  "powerstore_local_user.test",
+				ImportState:   true,
+				ExpectError:   regexp.MustCompile("Error reading local user"),
+				ImportStateId: "invalid-id",
+			},
+			{
+				Config: ProviderConfigForTesting + LocalUserParamsUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerstore_local_user.test", "role_id", "2"),
+					resource.TestCheckResourceAttr("powerstore_local_user.test", "is_locked", "true"),
+					resource.TestCheckResourceAttr("powerstore_local_user.test", "password_version", "2"),
+					resource.TestCheckNoResourceAttr("powerstore_local_user.test", "password"),
+				),
+			},
+		},
+	})
+}
+
+var LocalUserParamsInvalidName = `
+resource "powerstore_local_user" "test" {
+	name = "tfacc-local-user"
+	role_id = "3"
+	password = "Password123!"
+}
+`
+
+var LocalUserParamsInvalidRole = `
+resource "powerstore_local_user" "test" {
+	name = "tfacc.local.user"
+	role_id = "invalid-role-id"
+	password = "Password123!"
+}
+`
+
+var LocalUserParamsCreate = `
+resource "powerstore_local_user" "test" {
+	name = "tfacc.local.user"
+	role_id = "3"
+	password = "Password123!"
+	password_version = 1
+}
+`
+
+var LocalUserParamsUpdate = `
+resource "powerstore_local_user" "test" {
+	name = "tfacc.local.user"
+	role_id = "2"
+	is_locked = true
+	password = "Password456!"
+	password_version = 2
+}
+`
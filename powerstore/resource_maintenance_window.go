@@ -0,0 +1,321 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-powerstore/client"
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+)
+
+// maintenanceWindowSelect lists the maintenance window fields read by the maintenance window resource
+const maintenanceWindowSelect = "id,appliance_id,is_enabled,end_time"
+
+// newMaintenanceWindowResource returns maintenance window new resource instance
+func newMaintenanceWindowResource() resource.Resource {
+	return &resourceMaintenanceWindow{}
+}
+
+type resourceMaintenanceWindow struct {
+	client *clientgen.APIClient
+}
+
+// Metadata defines resource interface Metadata method
+func (r *resourceMaintenanceWindow) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_maintenance_window"
+}
+
+// Schema defines resource interface Schema method
+func (r *resourceMaintenanceWindow) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to open and close the maintenance window of an appliance of PowerStore Array. While the maintenance window is enabled, call home alerts of the appliance are suppressed. Every appliance has exactly one maintenance window, so creating this resource adopts the window of the appliance and destroying it closes the window. We can also import an existing maintenance window from PowerStore array.",
+		Description:         "This resource is used to open and close the maintenance window of an appliance of PowerStore Array. While the maintenance window is enabled, call home alerts of the appliance are suppressed. Every appliance has exactly one maintenance window, so creating this resource adopts the window of the appliance and destroying it closes the window. We can also import an existing maintenance window from PowerStore array.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Unique identifier of the maintenance window.",
+				MarkdownDescription: "Unique identifier of the maintenance window.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"appliance_id": schema.StringAttribute{
+				Description:         "Unique identifier of the appliance the maintenance window belongs to.",
+				MarkdownDescription: "Unique identifier of the appliance the maintenance window belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"is_enabled": schema.BoolAttribute{
+				Description:         "Whether the maintenance window is enabled. Defaults to true. Once the window expires it is reported as disabled and the next apply opens it again.",
+				MarkdownDescription: "Whether the maintenance window is enabled. Defaults to `true`. Once the window expires it is reported as disabled and the next apply opens it again.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"duration": schema.Int64Attribute{
+				Description:         "Number of seconds from the time of apply after which the maintenance window expires. Defaults to 3600. An enabled window can be extended, but not reduced, by changing this value.",
+				MarkdownDescription: "Number of seconds from the time of apply after which the maintenance window expires. Defaults to `3600`. An enabled window can be extended, but not reduced, by changing this value.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(3600),
+				Validators: []validator.Int64{
+					int64validator.Between(60, 432000),
+				},
+			},
+			"end_time": schema.StringAttribute{
+				Description:         "Time when the maintenance window will close or did close.",
+				MarkdownDescription: "Time when the maintenance window will close or did close.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure - defines configuration for maintenance window resource
+func (r *resourceMaintenanceWindow) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client.GenClient
+}
+
+// Create - adopts the maintenance window of the appliance and opens or closes it
+func (r *resourceMaintenanceWindow) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.MaintenanceWindow
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	window, err := r.fetchWindow(ctx, plan.ApplianceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating maintenance window",
+			"Could not find maintenance window, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	id := helper.TfString(window.Id).ValueString()
+	if err := r.modify(ctx, id, plan); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating maintenance window",
+			"Could not update maintenance window "+id+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	window, err = r.ReadAPI(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting maintenance window after creation",
+			"Could not get maintenance window, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state := r.updateState(window, plan.Duration)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Create")
+}
+
+// Read - reads maintenance window resource information
+func (r *resourceMaintenanceWindow) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.MaintenanceWindow
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	window, err := r.ReadAPI(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading maintenance window",
+			"Could not read maintenance window with error "+id+": "+err.Error(),
+		)
+		return
+	}
+
+	state = r.updateState(window, state.Duration)
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Read")
+}
+
+// Update - opens, extends or closes the maintenance window
+func (r *resourceMaintenanceWindow) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	log.Printf("Started Update")
+
+	var plan models.MaintenanceWindow
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.MaintenanceWindow
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	if err := r.modify(ctx, id, plan); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating maintenance window",
+			"Could not update maintenance window "+id+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	window, err := r.ReadAPI(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting maintenance window after update",
+			"Could not get maintenance window, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state = r.updateState(window, plan.Duration)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Successfully done with Update")
+}
+
+// Delete - closes the maintenance window, the window itself always exists on the appliance
+func (r *resourceMaintenanceWindow) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	log.Printf("Started with Delete")
+
+	var state models.MaintenanceWindow
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	_, err := r.client.MaintenanceWindowApi.PatchMaintenanceWindowById(ctx, id).Body(clientgen.MaintenanceWindowModify{
+		IsEnabled: clientgen.PtrBool(false),
+	}).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting maintenance window",
+			"Could not close maintenance window "+id+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	log.Printf("Done with Delete")
+}
+
+// ImportState - imports state for existing maintenance window
+func (r *resourceMaintenanceWindow) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("duration"), types.Int64Value(3600))...)
+}
+
+// ReadAPI - fetches the maintenance window by id
+func (r *resourceMaintenanceWindow) ReadAPI(ctx context.Context, id string) (*clientgen.MaintenanceWindowInstance, error) {
+	queries := make(url.Values)
+	queries.Set("select", maintenanceWindowSelect)
+	window, _, err := r.client.MaintenanceWindowApi.GetMaintenanceWindowById(ctx, id).Queries(queries).Execute()
+	return window, err
+}
+
+// fetchWindow - fetches the maintenance window of the appliance
+func (r *resourceMaintenanceWindow) fetchWindow(ctx context.Context, applianceID string) (*clientgen.MaintenanceWindowInstance, error) {
+	queries := make(url.Values)
+	queries.Set("select", maintenanceWindowSelect)
+	queries.Set("appliance_id", "eq."+applianceID)
+	windows, _, err := r.client.MaintenanceWindowApi.GetAllMaintenanceWindows(ctx).Queries(queries).Execute()
+	if err != nil {
+		return nil, err
+	}
+	if len(windows) != 1 {
+		return nil, fmt.Errorf("expected exactly one maintenance window for appliance %s, found %d", applianceID, len(windows))
+	}
+	return &windows[0], nil
+}
+
+// modify - opens the maintenance window for the planned duration or closes it
+func (r *resourceMaintenanceWindow) modify(ctx context.Context, id string, plan models.MaintenanceWindow) error {
+	body := clientgen.MaintenanceWindowModify{
+		IsEnabled: clientgen.PtrBool(plan.IsEnabled.ValueBool()),
+	}
+	if plan.IsEnabled.ValueBool() {
+		body.EndOffset = clientgen.PtrInt32(int32(plan.Duration.ValueInt64()))
+	}
+	_, err := r.client.MaintenanceWindowApi.PatchMaintenanceWindowById(ctx, id).Body(body).Execute()
+	return err
+}
+
+// updateState - converts the maintenance window response to the resource state
+// The duration is not reported by the array, so the configured value is kept
+func (r *resourceMaintenanceWindow) updateState(window *clientgen.MaintenanceWindowInstance, duration types.Int64) models.MaintenanceWindow {
+	return models.MaintenanceWindow{
+		ID:          helper.TfString(window.Id),
+		ApplianceID: helper.TfString(window.ApplianceId),
+		IsEnabled:   helper.TfBool(window.IsEnabled),
+		Duration:    duration,
+		EndTime:     helper.TfStringFromPTime(window.EndTime),
+	}
+}
@@ -0,0 +1,104 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Test to manage Maintenance Window Resource
+func TestAccMaintenanceWindow(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfigForTesting + MaintenanceWindowParamsInvalidAppliance,
+				ExpectError: regexp.MustCompile("Error creating maintenance window"),
+			},
+			{
+				Config:      ProviderConfigForTesting + MaintenanceWindowParamsInvalidDuration,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value"),
+			},
+			{
+				Config: ProviderConfigForTesting + MaintenanceWindowParamsCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerstore_maintenance_window.test", "appliance_id", applianceID),
+					resource.TestCheckResourceAttr("powerstore_maintenance_window.test", "is_enabled", "true"),
+					resource.TestCheckResourceAttrSet("powerstore_maintenance_window.test", "id"),
+					resource.TestCheckResourceAttrSet("powerstore_maintenance_window.test", "end_time"),
+				),
+			},
+			// Import Testing
+			{
+				Config:                  ProviderConfigForTesting + MaintenanceWindowParamsCreate,
+				ResourceName:            "powerstore_maintenance_window.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"duration"},
+			},
+			// Import Negative Testing
+			{
+				Config:        ProviderConfigForTesting + MaintenanceWindowParamsCreate,
+				ResourceName:  "powerstore_maintenance_window.test",
+				ImportState:   true,
+				ExpectError:   regexp.MustCompile("Error reading maintenance window"),
+				ImportStateId: "invalid-id",
+			},
+			{
+				Config: ProviderConfigForTesting + MaintenanceWindowParamsUpdate,
+				Check:  resource.TestCheckResourceAttr("powerstore_maintenance_window.test", "is_enabled", "false"),
+			},
+		},
+	})
+}
+
+var MaintenanceWindowParamsInvalidAppliance = `
+resource "powerstore_maintenance_window" "test" {
+	appliance_id = "invalid-appliance-id"
+}
+`
+
+var MaintenanceWindowParamsInvalidDuration = `
+resource "powerstore_maintenance_window" "test" {
+	appliance_id = "` + applianceID + `"
+	duration = 10
+}
+`
+
+var MaintenanceWindowParamsCreate = `
+resource "powerstore_maintenance_window" "test" {
+	appliance_id = "` + applianceID + `"
+	duration = 7200
+}
+`
+
+var MaintenanceWindowParamsUpdate = `
+resource "powerstore_maintenance_window" "test" {
+	appliance_id = "` + applianceID + `"
+	is_enabled = false
+}
+`
@@ -0,0 +1,596 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-powerstore/client"
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+)
+
+// ldapDomainSelect lists the LDAP domain fields read by the management ldap resource
+const ldapDomainSelect = "id,domain_name,ldap_servers,port,protocol,ldap_server_type,bind_user,ldap_timeout,is_global_catalog,user_id_attribute,user_object_class,user_search_path,group_name_attribute,group_member_attribute,group_object_class,group_search_path,group_search_level"
+
+// newManagementLdapResource returns management ldap new resource instance
+func newManagementLdapResource() resource.Resource {
+	return &resourceManagementLdap{}
+}
+
+type resourceManagementLdap struct {
+	client *clientgen.APIClient
+}
+
+// Metadata defines resource interface Metadata method
+func (r *resourceManagementLdap) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_management_ldap"
+}
+
+// Schema defines resource interface Schema method
+func (r *resourceManagementLdap) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage the LDAP domain used to authenticate management users of PowerStore Array. We can Create, Update and Delete the LDAP domain using this resource. The bind password is write-only and is never stored in the Terraform state. We can also import an existing LDAP domain from PowerStore array.",
+		Description:         "This resource is used to manage the LDAP domain used to authenticate management users of PowerStore Array. We can Create, Update and Delete the LDAP domain using this resource. The bind password is write-only and is never stored in the Terraform state. We can also import an existing LDAP domain from PowerStore array.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Unique identifier of the LDAP domain.",
+				MarkdownDescription: "Unique identifier of the LDAP domain.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain_name": schema.StringAttribute{
+				Description:         "Name of the LDAP authority. Cannot be updated.",
+				MarkdownDescription: "Name of the LDAP authority. Cannot be updated.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"ldap_servers": schema.SetAttribute{
+				Description:         "IP addresses of the LDAP servers of the domain.",
+				MarkdownDescription: "IP addresses of the LDAP servers of the domain.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"port": schema.Int64Attribute{
+				Description:         "Port used to connect to the LDAP servers. The array defaults it based on the protocol and global catalog settings.",
+				MarkdownDescription: "Port used to connect to the LDAP servers. The array defaults it based on the protocol and global catalog settings.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"protocol": schema.StringAttribute{
+				Description:         "Protocol used to connect to the LDAP servers. Valid values are LDAP and LDAPS. LDAPS requires the ca_certificate of the LDAP servers.",
+				MarkdownDescription: "Protocol used to connect to the LDAP servers. Valid values are `LDAP` and `LDAPS`. `LDAPS` requires the `ca_certificate` of the LDAP servers.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(helper.SliceTransform(clientgen.AllowedLDAPProtocolEnumEnumValues, func(in clientgen.LDAPProtocolEnum) string {
+						return string(in)
+					})...),
+				},
+			},
+			"ldap_server_type": schema.StringAttribute{
+				Description:         "Type of the LDAP servers. Valid values are AD and OpenLDAP.",
+				MarkdownDescription: "Type of the LDAP servers. Valid values are `AD` and `OpenLDAP`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(helper.SliceTransform(clientgen.AllowedLDAPServerTypeEnumEnumValues, func(in clientgen.LDAPServerTypeEnum) string {
+						return string(in)
+					})...),
+				},
+			},
+			"bind_user": schema.StringAttribute{
+				Description:         "Distinguished Name (DN) of the user used to bind to the LDAP servers.",
+				MarkdownDescription: "Distinguished Name (DN) of the user used to bind to the LDAP servers.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"bind_password": schema.StringAttribute{
+				Description:         "Password of the bind user. This attribute is write-only and is never stored in the state, change bind_password_version to set a new password.",
+				MarkdownDescription: "Password of the bind user. This attribute is write-only and is never stored in the state, change `bind_password_version` to set a new password.",
+				Required:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"bind_password_version": schema.Int64Attribute{
+				Description:         "Version of the bind password. Since the bind password is not stored in the state, changes to it are only applied when this value changes.",
+				MarkdownDescription: "Version of the bind password. Since the bind password is not stored in the state, changes to it are only applied when this value changes.",
+				Optional:            true,
+			},
+			"ldap_timeout": schema.Int64Attribute{
+				Description:         "Timeout for establishing a connection to an LDAP server, in milliseconds.",
+				MarkdownDescription: "Timeout for establishing a connection to an LDAP server, in milliseconds.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"is_global_catalog": schema.BoolAttribute{
+				Description:         "Whether the LDAP servers are Active Directory global catalog servers.",
+				MarkdownDescription: "Whether the LDAP servers are Active Directory global catalog servers.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id_attribute": schema.StringAttribute{
+				Description:         "Name of the LDAP attribute whose value indicates the unique identifier of the user.",
+				MarkdownDescription: "Name of the LDAP attribute whose value indicates the unique identifier of the user.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_object_class": schema.StringAttribute{
+				Description:         "LDAP object class for users.",
+				MarkdownDescription: "LDAP object class for users.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_search_path": schema.StringAttribute{
+				Description:         "Path used to search for users on the directory server.",
+				MarkdownDescription: "Path used to search for users on the directory server.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"group_name_attribute": schema.StringAttribute{
+				Description:         "Name of the LDAP attribute whose value indicates the group name.",
+				MarkdownDescription: "Name of the LDAP attribute whose value indicates the group name.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group_member_attribute": schema.StringAttribute{
+				Description:         "Name of the LDAP attribute whose value contains the names of group members within a group.",
+				MarkdownDescription: "Name of the LDAP attribute whose value contains the names of group members within a group.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group_object_class": schema.StringAttribute{
+				Description:         "LDAP object class for groups.",
+				MarkdownDescription: "LDAP object class for groups.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group_search_path": schema.StringAttribute{
+				Description:         "Path used to search for groups on the directory server.",
+				MarkdownDescription: "Path used to search for groups on the directory server.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"group_search_level": schema.Int64Attribute{
+				Description:         "Nested search level for performing group search. 0 means no limitation of the nested search level.",
+				MarkdownDescription: "Nested search level for performing group search. 0 means no limitation of the nested search level.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"ca_certificate": schema.StringAttribute{
+				Description:         "PEM encoded certificate of the CA that issued the certificates of the LDAP servers. It is uploaded as an LDAP CA certificate scoped to the domain before the domain is configured.",
+				MarkdownDescription: "PEM encoded certificate of the CA that issued the certificates of the LDAP servers. It is uploaded as an LDAP CA certificate scoped to the domain before the domain is configured.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"ca_certificate_id": schema.StringAttribute{
+				Description:         "Unique identifier of the uploaded CA certificate.",
+				MarkdownDescription: "Unique identifier of the uploaded CA certificate.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					certificateIDModifier{certificate: "ca_certificate"},
+				},
+			},
+		},
+	}
+}
+
+// Configure - defines configuration for management ldap resource
+func (r *resourceManagementLdap) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client.GenClient
+}
+
+// Create - method to create management ldap resource
+func (r *resourceManagementLdap) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.ManagementLdap
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// write-only attributes are only available in the configuration
+	var bindPassword types.String
+	diags = req.Config.GetAttribute(ctx, path.Root("bind_password"), &bindPassword)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the CA certificate has to be in place before the array connects to LDAPS servers
+	certificateID := types.StringNull()
+	if helper.IsKnownValue(plan.CaCertificate) {
+		id, err := r.uploadCaCertificate(ctx, plan)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating management ldap",
+				"Could not upload CA certificate, unexpected error: "+err.Error(),
+			)
+			return
+		}
+		certificateID = types.StringValue(id)
+	}
+
+	createResp, _, err := r.client.LdapDomainApi.PostAllLdapDomains(ctx).Body(clientgen.LdapDomainCreate{
+		DomainName:           plan.DomainName.ValueString(),
+		LdapServers:          helper.SetStrings(plan.LdapServers),
+		Port:                 helper.KnownInt32(plan.Port),
+		Protocol:             (*clientgen.LDAPProtocolEnum)(helper.ValueToPointer[string](plan.Protocol)),
+		LdapServerType:       (*clientgen.LDAPServerTypeEnum)(helper.ValueToPointer[string](plan.LdapServerType)),
+		BindUser:             plan.BindUser.ValueString(),
+		BindPassword:         bindPassword.ValueString(),
+		LdapTimeout:          helper.KnownInt32(plan.LdapTimeout),
+		IsGlobalCatalog:      helper.ValueToPointer[bool](plan.IsGlobalCatalog),
+		UserIdAttribute:      helper.ValueToPointer[string](plan.UserIDAttribute),
+		UserObjectClass:      helper.ValueToPointer[string](plan.UserObjectClass),
+		UserSearchPath:       plan.UserSearchPath.ValueString(),
+		GroupNameAttribute:   helper.ValueToPointer[string](plan.GroupNameAttribute),
+		GroupMemberAttribute: helper.ValueToPointer[string](plan.GroupMemberAttribute),
+		GroupObjectClass:     helper.ValueToPointer[string](plan.GroupObjectClass),
+		GroupSearchPath:      plan.GroupSearchPath.ValueString(),
+		GroupSearchLevel:     helper.KnownInt32(plan.GroupSearchLevel),
+	}).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating management ldap",
+			"Could not create management ldap, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	id := helper.TfString(createResp.Id).ValueString()
+	domain, err := r.ReadAPI(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting management ldap after creation",
+			"Could not get management ldap, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.CaCertificateID = certificateID
+	state, dgs := r.updateState(ctx, domain, plan)
+	resp.Diagnostics.Append(dgs...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Create")
+}
+
+// Read - reads management ldap resource information
+func (r *resourceManagementLdap) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.ManagementLdap
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	domain, err := r.ReadAPI(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading management ldap",
+			"Could not read management ldap with error "+id+": "+err.Error(),
+		)
+		return
+	}
+
+	state, dgs := r.updateState(ctx, domain, state)
+	resp.Diagnostics.Append(dgs...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Read")
+}
+
+// Update - updates management ldap resource
+func (r *resourceManagementLdap) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	log.Printf("Started Update")
+
+	var plan models.ManagementLdap
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.ManagementLdap
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.CaCertificateID = state.CaCertificateID
+	if helper.IsKnownValue(plan.CaCertificate) && !plan.CaCertificate.Equal(state.CaCertificate) {
+		id, err := r.uploadCaCertificate(ctx, plan)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating management ldap",
+				"Could not upload CA certificate, unexpected error: "+err.Error(),
+			)
+			return
+		}
+		plan.CaCertificateID = types.StringValue(id)
+	}
+
+	domainModify := clientgen.LdapDomainModify{}
+	if !plan.LdapServers.Equal(state.LdapServers) {
+		domainModify.LdapServers = helper.SetStrings(plan.LdapServers)
+	}
+	if helper.IsKnownValue(plan.Port) && !plan.Port.Equal(state.Port) {
+		domainModify.Port = helper.KnownInt32(plan.Port)
+	}
+	if helper.IsKnownValue(plan.Protocol) && !plan.Protocol.Equal(state.Protocol) {
+		domainModify.Protocol = (*clientgen.LDAPProtocolEnum)(helper.ValueToPointer[string](plan.Protocol))
+	}
+	if helper.IsKnownValue(plan.LdapServerType) && !plan.LdapServerType.Equal(state.LdapServerType) {
+		domainModify.LdapServerType = (*clientgen.LDAPServerTypeEnum)(helper.ValueToPointer[string](plan.LdapServerType))
+	}
+	if !plan.BindUser.Equal(state.BindUser) {
+		domainModify.BindUser = helper.ValueToPointer[string](plan.BindUser)
+	}
+	if !plan.BindPasswordVersion.Equal(state.BindPasswordVersion) {
+		var bindPassword types.String
+		diags = req.Config.GetAttribute(ctx, path.Root("bind_password"), &bindPassword)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		domainModify.BindPassword = helper.ValueToPointer[string](bindPassword)
+	}
+	if helper.IsKnownValue(plan.LdapTimeout) && !plan.LdapTimeout.Equal(state.LdapTimeout) {
+		domainModify.LdapTimeout = helper.KnownInt32(plan.LdapTimeout)
+	}
+	if helper.IsKnownValue(plan.IsGlobalCatalog) && !plan.IsGlobalCatalog.Equal(state.IsGlobalCatalog) {
+		domainModify.IsGlobalCatalog = helper.ValueToPointer[bool](plan.IsGlobalCatalog)
+	}
+	if helper.IsKnownValue(plan.UserIDAttribute) && !plan.UserIDAttribute.Equal(state.UserIDAttribute) {
+		domainModify.UserIdAttribute = helper.ValueToPointer[string](plan.UserIDAttribute)
+	}
+	if helper.IsKnownValue(plan.UserObjectClass) && !plan.UserObjectClass.Equal(state.UserObjectClass) {
+		domainModify.UserObjectClass = helper.ValueToPointer[string](plan.UserObjectClass)
+	}
+	if !plan.UserSearchPath.Equal(state.UserSearchPath) {
+		domainModify.UserSearchPath = helper.ValueToPointer[string](plan.UserSearchPath)
+	}
+	if helper.IsKnownValue(plan.GroupNameAttribute) && !plan.GroupNameAttribute.Equal(state.GroupNameAttribute) {
+		domainModify.GroupNameAttribute = helper.ValueToPointer[string](plan.GroupNameAttribute)
+	}
+	if helper.IsKnownValue(plan.GroupMemberAttribute) && !plan.GroupMemberAttribute.Equal(state.GroupMemberAttribute) {
+		domainModify.GroupMemberAttribute = helper.ValueToPointer[string](plan.GroupMemberAttribute)
+	}
+	if helper.IsKnownValue(plan.GroupObjectClass) && !plan.GroupObjectClass.Equal(state.GroupObjectClass) {
+		domainModify.GroupObjectClass = helper.ValueToPointer[string](plan.GroupObjectClass)
+	}
+	if !plan.GroupSearchPath.Equal(state.GroupSearchPath) {
+		domainModify.GroupSearchPath = helper.ValueToPointer[string](plan.GroupSearchPath)
+	}
+	if helper.IsKnownValue(plan.GroupSearchLevel) && !plan.GroupSearchLevel.Equal(state.GroupSearchLevel) {
+		domainModify.GroupSearchLevel = helper.KnownInt32(plan.GroupSearchLevel)
+	}
+
+	id := state.ID.ValueString()
+	_, err := r.client.LdapDomainApi.PatchLdapDomainById(ctx, id).Body(domainModify).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating management ldap",
+			"Could not update management ldap "+id+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	domain, err := r.ReadAPI(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting management ldap after update",
+			"Could not get management ldap, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state, dgs := r.updateState(ctx, domain, plan)
+	resp.Diagnostics.Append(dgs...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Successfully done with Update")
+}
+
+// Delete - method to delete management ldap resource
+func (r *resourceManagementLdap) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	log.Printf("Started with Delete")
+
+	var state models.ManagementLdap
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	_, err := r.client.LdapDomainApi.DeleteLdapDomainById(ctx, id).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting management ldap",
+			"Could not delete management ldap "+id+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	log.Printf("Done with Delete")
+}
+
+// ImportState - imports state for existing management ldap
+func (r *resourceManagementLdap) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// ReadAPI - fetches the LDAP domain by id
+func (r *resourceManagementLdap) ReadAPI(ctx context.Context, id string) (*clientgen.LdapDomainInstance, error) {
+	queries := make(url.Values)
+	queries.Set("select", ldapDomainSelect)
+	domain, _, err := r.client.LdapDomainApi.GetLdapDomainById(ctx, id).Queries(queries).Execute()
+	return domain, err
+}
+
+// uploadCaCertificate - uploads the CA certificate of the LDAP servers scoped to the domain
+func (r *resourceManagementLdap) uploadCaCertificate(ctx context.Context, plan models.ManagementLdap) (string, error) {
+	createResp, _, err := r.client.X509CertificateApi.PostAllX509Certificates(ctx).Body(clientgen.X509CertificateCreate{
+		Type:        clientgen.X509CERTIFICATEUSAGETYPEENUM_CA_SERVER_VALIDATION,
+		Service:     clientgen.X509CERTIFICATESERVICEENUM_LDAP_HTTP,
+		Scope:       helper.ValueToPointer[string](plan.DomainName),
+		Certificate: plan.CaCertificate.ValueString(),
+	}).Execute()
+	if err != nil {
+		return "", err
+	}
+	return helper.TfString(createResp.Id).ValueString(), nil
+}
+
+// updateState - converts the LDAP domain response to the resource state
+// The bind password and CA certificate are not reported by the array, so the planned values are kept
+func (r *resourceManagementLdap) updateState(ctx context.Context, domain *clientgen.LdapDomainInstance, plan models.ManagementLdap) (models.ManagementLdap, diag.Diagnostics) {
+	servers, diags := types.SetValueFrom(ctx, types.StringType, domain.LdapServers)
+	return models.ManagementLdap{
+		ID:                   helper.TfString(domain.Id),
+		DomainName:           helper.TfString(domain.DomainName),
+		LdapServers:          servers,
+		Port:                 helper.TfInt64(domain.Port),
+		Protocol:             helper.TfString(domain.Protocol),
+		LdapServerType:       helper.TfString(domain.LdapServerType),
+		BindUser:             helper.TfString(domain.BindUser),
+		BindPassword:         types.StringNull(),
+		BindPasswordVersion:  plan.BindPasswordVersion,
+		LdapTimeout:          helper.TfInt64(domain.LdapTimeout),
+		IsGlobalCatalog:      helper.TfBool(domain.IsGlobalCatalog),
+		UserIDAttribute:      helper.TfString(domain.UserIdAttribute),
+		UserObjectClass:      helper.TfString(domain.UserObjectClass),
+		UserSearchPath:       helper.TfString(domain.UserSearchPath),
+		GroupNameAttribute:   helper.TfString(domain.GroupNameAttribute),
+		GroupMemberAttribute: helper.TfString(domain.GroupMemberAttribute),
+		GroupObjectClass:     helper.TfString(domain.GroupObjectClass),
+		GroupSearchPath:      helper.TfString(domain.GroupSearchPath),
+		GroupSearchLevel:     helper.TfInt64(domain.GroupSearchLevel),
+		CaCertificate:        plan.CaCertificate,
+		CaCertificateID:      plan.CaCertificateID,
+	}, diags
+}
@@ -0,0 +1,149 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Test to Create, Update, Import and Delete Management LDAP Resource
+func TestAccManagementLdap(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfigForTesting + ManagementLdapParamsInvalidProtocol,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+			{
+				Config: ProviderConfigForTesting + ManagementLdapParamsCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerstore_management_ldap.test", "domain_name", ldapDomainName),
+					resource.TestCheckResourceAttr("powerstore_management_ldap.test", "protocol", "LDAP"),
+					resource.TestCheckResourceAttrSet("powerstore_management_ldap.test", "port"),
+					resource.TestCheckNoResourceAttr("powerstore_management_ldap.test", "bind_password"),
+				),
+			},
+			// Import Testing
+			{
+				Config:                  ProviderConfigForTesting + ManagementLdapParamsCreate,
+				ResourceName:            "powerstore_management_ldap.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"bind_password_version"},
+			},
+			// Import Negative Testing
+			{
+				Config:        ProviderConfigForTesting + ManagementLdapParamsCreate,
+				ResourceName:  "powerstore_management_ldap.test",
+				ImportState:   true,
+				ExpectError:   regexp.MustCompile("Error reading management ldap"),
+				ImportStateId: "invalid-id",
+			},
+			{
+				Config: ProviderConfigForTesting + ManagementLdapParamsUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerstore_management_ldap.test", "ldap_timeout", "60000"),
+					resource.TestCheckResourceAttr("powerstore_management_ldap.test", "group_search_level", "2"),
+				),
+			},
+			// Add a CA certificate and then change it, the id of the uploaded certificate must follow
+			{
+				Config: ProviderConfigForTesting + ManagementLdapParamsCaCertificate(x509CACertificate),
+				Check:  resource.TestCheckResourceAttrSet("powerstore_management_ldap.test", "ca_certificate_id"),
+			},
+			{
+				Config: ProviderConfigForTesting + ManagementLdapParamsCaCertificate(x509CACertificateUpdate),
+				Check:  resource.TestCheckResourceAttrSet("powerstore_management_ldap.test", "ca_certificate_id"),
+			},
+		},
+	})
+}
+
+var ManagementLdapParamsInvalidProtocol = `
+resource "powerstore_management_ldap" "test" {
+	domain_name = "` + ldapDomainName + `"
+	ldap_servers = [` + ldapServers + `]
+	protocol = "invalid"
+	bind_user = "` + ldapBindUser + `"
+	bind_password = "` + ldapBindPassword + `"
+	user_search_path = "` + ldapSearchPath + `"
+	group_search_path = "` + ldapSearchPath + `"
+}
+`
+
+var ManagementLdapParamsCreate = `
+resource "powerstore_management_ldap" "test" {
+	domain_name = "` + ldapDomainName + `"
+	ldap_servers = [` + ldapServers + `]
+	protocol = "LDAP"
+	ldap_server_type = "AD"
+	bind_user = "` + ldapBindUser + `"
+	bind_password = "` + ldapBindPassword + `"
+	bind_password_version = 1
+	user_search_path = "` + ldapSearchPath + `"
+	group_search_path = "` + ldapSearchPath + `"
+}
+`
+
+var ManagementLdapParamsUpdate = `
+resource "powerstore_management_ldap" "test" {
+	domain_name = "` + ldapDomainName + `"
+	ldap_servers = [` + ldapServers + `]
+	protocol = "LDAP"
+	ldap_server_type = "AD"
+	bind_user = "` + ldapBindUser + `"
+	bind_password = "` + ldapBindPassword + `"
+	bind_password_version = 2
+	ldap_timeout = 60000
+	user_search_path = "` + ldapSearchPath + `"
+	group_search_path = "` + ldapSearchPath + `"
+	group_search_level = 2
+}
+`
+
+// ManagementLdapParamsCaCertificate returns the updated configuration with the given CA certificate
+func ManagementLdapParamsCaCertificate(certificate string) string {
+	return `
+resource "powerstore_management_ldap" "test" {
+	domain_name = "` + ldapDomainName + `"
+	ldap_servers = [` + ldapServers + `]
+	protocol = "LDAP"
+	ldap_server_type = "AD"
+	bind_user = "` + ldapBindUser + `"
+	bind_password = "` + ldapBindPassword + `"
+	bind_password_version = 2
+	ldap_timeout = 60000
+	user_search_path = "` + ldapSearchPath + `"
+	group_search_path = "` + ldapSearchPath + `"
+	group_search_level = 2
+	ca_certificate = <<-EOT
+` + certificate + `
+EOT
+}
+`
+}
@@ -0,0 +1,461 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-powerstore/client"
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+)
+
+// networkSelect lists the network fields read by the network resource
+const networkSelect = "id,name,type,ip_version,purposes,vlan_id,gateway,prefix_length,mtu,ip_pool_addresses(address,purposes)"
+
+// newNetworkResource returns network new resource instance
+func newNetworkResource() resource.Resource {
+	return &resourceNetwork{}
+}
+
+type resourceNetwork struct {
+	client    *clientgen.APIClient
+	allclient *client.Client
+}
+
+// Metadata defines resource interface Metadata method
+func (r *resourceNetwork) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network"
+}
+
+// Schema defines resource interface Schema method
+func (r *resourceNetwork) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage the network entity of PowerStore Array. We can Create, Update and Delete the network using this resource. We can also import an existing network from PowerStore array.",
+		Description:         "This resource is used to manage the network entity of PowerStore Array. We can Create, Update and Delete the network using this resource. We can also import an existing network from PowerStore array.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Unique identifier of the network.",
+				MarkdownDescription: "Unique identifier of the network.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description:         "Name of the network.",
+				MarkdownDescription: "Name of the network.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description:         "Type of the network, eg. Storage, Management, Intra_Cluster_Management or Intra_Cluster_Data. Cannot be updated.",
+				MarkdownDescription: "Type of the network, eg. `Storage`, `Management`, `Intra_Cluster_Management` or `Intra_Cluster_Data`. Cannot be updated.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(helper.SliceTransform(clientgen.AllowedNetworkTypeEnumEnumValues, func(in clientgen.NetworkTypeEnum) string {
+						return string(in)
+					})...),
+				},
+			},
+			"ip_version": schema.StringAttribute{
+				Description:         "IP version of the network, either IPv4 or IPv6. Defaults to IPv4. Cannot be updated.",
+				MarkdownDescription: "IP version of the network, either `IPv4` or `IPv6`. Defaults to `IPv4`. Cannot be updated.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(string(clientgen.IPVERSIONTYPEENUM_IPV4)),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(helper.SliceTransform(clientgen.AllowedIpVersionTypeEnumEnumValues, func(in clientgen.IpVersionTypeEnum) string {
+						return string(in)
+					})...),
+				},
+			},
+			"purposes": schema.SetAttribute{
+				Description:         "Purposes of the network, eg. ISCSI, NVMe_TCP, File_Mobility or External_Replication. Only applicable to storage networks.",
+				MarkdownDescription: "Purposes of the network, eg. `ISCSI`, `NVMe_TCP`, `File_Mobility` or `External_Replication`. Only applicable to storage networks.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(helper.SliceTransform(clientgen.AllowedNetworkPurposeEnumEnumValues, func(in clientgen.NetworkPurposeEnum) string {
+						return string(in)
+					})...)),
+				},
+			},
+			"vlan_id": schema.Int64Attribute{
+				Description:         "VLAN identifier of the network.",
+				MarkdownDescription: "VLAN identifier of the network.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(0, 4094),
+				},
+			},
+			"gateway": schema.StringAttribute{
+				Description:         "Network gateway in IPv4 or IPv6 format, corresponding to the network's IP version.",
+				MarkdownDescription: "Network gateway in IPv4 or IPv6 format, corresponding to the network's IP version.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"prefix_length": schema.Int64Attribute{
+				Description:         "Network prefix length, used for both IPv4 and IPv6.",
+				MarkdownDescription: "Network prefix length, used for both IPv4 and IPv6.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 127),
+				},
+			},
+			"mtu": schema.Int64Attribute{
+				Description:         "Maximum Transmission Unit (MTU) packet size set on network interfaces, in bytes.",
+				MarkdownDescription: "Maximum Transmission Unit (MTU) packet size set on network interfaces, in bytes.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1280, 9000),
+				},
+			},
+			"storage_discovery_address": schema.StringAttribute{
+				Description:         "Storage discovery IP address of a storage network, in the format of the network's IP version.",
+				MarkdownDescription: "Storage discovery IP address of a storage network, in the format of the network's IP version.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"addresses": schema.SetAttribute{
+				Description:         "IP addresses in the IP pool of the network. Each address of a range must be listed individually.",
+				MarkdownDescription: "IP addresses in the IP pool of the network. Each address of a range must be listed individually.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+		},
+	}
+}
+
+// Configure - defines configuration for network resource
+func (r *resourceNetwork) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client.GenClient
+	r.allclient = client
+}
+
+// Create - method to create network resource
+func (r *resourceNetwork) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.Network
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	networkCreate := clientgen.NetworkCreate{
+		Type:                    clientgen.NetworkTypeEnum(plan.Type.ValueString()),
+		Name:                    plan.Name.ValueString(),
+		IpVersion:               clientgen.IpVersionTypeEnum(plan.IPVersion.ValueString()),
+		Purposes:                helper.SliceTransform(helper.SetStrings(plan.Purposes), func(in string) clientgen.NetworkPurposeEnum { return clientgen.NetworkPurposeEnum(in) }),
+		Gateway:                 helper.ValueToPointer[string](plan.Gateway),
+		PrefixLength:            int32(plan.PrefixLength.ValueInt64()),
+		Mtu:                     int32(plan.MTU.ValueInt64()),
+		StorageDiscoveryAddress: helper.ValueToPointer[string](plan.StorageDiscoveryAddress),
+		AddAddresses:            helper.SetStrings(plan.Addresses),
+	}
+	if helper.IsKnownValue(plan.VlanID) {
+		networkCreate.VlanId = helper.GetPointer(int32(plan.VlanID.ValueInt64()))
+	}
+
+	started := time.Now()
+	createResp, _, err := r.client.NetworkApi.PostAllNetworks(ctx).Body(networkCreate).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating network",
+			"Could not create network, unexpected error: "+err.Error()+r.allclient.FailedJobDetails(ctx, started, "network", "", networkCreate.Name),
+		)
+		return
+	}
+
+	network, err := r.ReadAPI(ctx, helper.TfString(createResp.Id).ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting network after creation",
+			"Could not get network, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state, dgs := r.updateState(ctx, network)
+	resp.Diagnostics.Append(dgs...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Create")
+}
+
+// Read - reads network resource information
+func (r *resourceNetwork) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.Network
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	network, err := r.ReadAPI(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading network",
+			"Could not read network with error "+id+": "+err.Error(),
+		)
+		return
+	}
+
+	state, dgs := r.updateState(ctx, network)
+	resp.Diagnostics.Append(dgs...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Read")
+}
+
+// Update - method to update network resource
+func (r *resourceNetwork) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	log.Printf("Started Update")
+
+	var plan models.Network
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.Network
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	networkModify := r.planToNetworkModify(plan, state)
+	id := state.ID.ValueString()
+	started := time.Now()
+	err := r.allclient.WithMaintenanceWindow(ctx, func() error {
+		_, err := r.client.NetworkApi.PatchNetworkById(ctx, id).Body(networkModify).Execute()
+		return err
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating network",
+			"Could not update network "+id+": "+err.Error()+r.allclient.FailedJobDetails(ctx, started, "network", id, ""),
+		)
+		return
+	}
+
+	network, err := r.ReadAPI(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting network after update",
+			"Could not get network, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state, dgs := r.updateState(ctx, network)
+	resp.Diagnostics.Append(dgs...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Successfully done with Update")
+}
+
+// Delete - method to delete network resource
+func (r *resourceNetwork) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	log.Printf("Started with Delete")
+
+	var state models.Network
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	started := time.Now()
+	err := r.allclient.WithMaintenanceWindow(ctx, func() error {
+		_, err := r.client.NetworkApi.DeleteNetworkById(ctx, id).Execute()
+		return err
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting network",
+			"Could not delete network "+id+": "+err.Error()+r.allclient.FailedJobDetails(ctx, started, "network", id, ""),
+		)
+		return
+	}
+
+	log.Printf("Done with Delete")
+}
+
+// ImportState - imports state for existing network
+func (r *resourceNetwork) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// ReadAPI - fetches the network by id
+func (r *resourceNetwork) ReadAPI(ctx context.Context, id string) (*clientgen.NetworkInstance, error) {
+	queries := make(url.Values)
+	queries.Set("select", networkSelect)
+	network, _, err := r.client.NetworkApi.GetNetworkById(ctx, id).Queries(queries).Execute()
+	return network, err
+}
+
+// planToNetworkModify - builds the modify request from the difference between plan and state
+func (r *resourceNetwork) planToNetworkModify(plan, state models.Network) clientgen.NetworkModify {
+	var networkModify clientgen.NetworkModify
+	if !plan.Name.Equal(state.Name) {
+		networkModify.Name = plan.Name.ValueStringPointer()
+	}
+	if helper.IsKnownValue(plan.VlanID) && !plan.VlanID.Equal(state.VlanID) {
+		networkModify.VlanId = helper.GetPointer(int32(plan.VlanID.ValueInt64()))
+	}
+	if helper.IsKnownValue(plan.Gateway) && !plan.Gateway.Equal(state.Gateway) {
+		networkModify.Gateway = plan.Gateway.ValueStringPointer()
+	}
+	if !plan.PrefixLength.Equal(state.PrefixLength) {
+		networkModify.PrefixLength = helper.GetPointer(int32(plan.PrefixLength.ValueInt64()))
+	}
+	if !plan.MTU.Equal(state.MTU) {
+		networkModify.Mtu = helper.GetPointer(int32(plan.MTU.ValueInt64()))
+	}
+	if helper.IsKnownValue(plan.StorageDiscoveryAddress) && !plan.StorageDiscoveryAddress.Equal(state.StorageDiscoveryAddress) {
+		networkModify.StorageDiscoveryAddress = plan.StorageDiscoveryAddress.ValueStringPointer()
+	}
+	if helper.IsKnownValue(plan.Addresses) {
+		networkModify.AddAddresses, networkModify.RemoveAddresses = helper.SetDifference(plan.Addresses, state.Addresses)
+	}
+	if helper.IsKnownValue(plan.Purposes) {
+		add, remove := helper.SetDifference(plan.Purposes, state.Purposes)
+		toPurposes := func(in string) clientgen.NetworkPurposeEnum { return clientgen.NetworkPurposeEnum(in) }
+		networkModify.AddPurposes = helper.SliceTransform(add, toPurposes)
+		networkModify.RemovePurposes = helper.SliceTransform(remove, toPurposes)
+	}
+	return networkModify
+}
+
+// updateState - converts the network response to the resource state
+func (r *resourceNetwork) updateState(ctx context.Context, network *clientgen.NetworkInstance) (models.Network, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	storageDiscoveryAddress := types.StringNull()
+	var addresses []string
+	for _, address := range network.IpPoolAddresses {
+		switch {
+		case slices.Contains(address.Purposes, clientgen.IPPURPOSETYPEENUM_STORAGE_GLOBAL):
+			storageDiscoveryAddress = helper.TfString(address.Address)
+		case slices.Contains(address.Purposes, clientgen.IPPURPOSETYPEENUM_STORAGE_CLUSTER_FLOATING),
+			slices.Contains(address.Purposes, clientgen.IPPURPOSETYPEENUM_MGMT_CLUSTER_FLOATING):
+			// cluster floating addresses are not part of the IP pool managed by this resource
+		default:
+			addresses = append(addresses, helper.TfString(address.Address).ValueString())
+		}
+	}
+	addressSet, dgs := types.SetValueFrom(ctx, types.StringType, addresses)
+	diags.Append(dgs...)
+	purposes, dgs := types.SetValueFrom(ctx, types.StringType, helper.SliceTransform(network.Purposes, func(in clientgen.NetworkPurposeEnum) string {
+		return string(in)
+	}))
+	diags.Append(dgs...)
+	return models.Network{
+		ID:                      helper.TfString(network.Id),
+		Name:                    helper.TfString(network.Name),
+		Type:                    helper.TfString(network.Type),
+		IPVersion:               helper.TfString(network.IpVersion),
+		Purposes:                purposes,
+		VlanID:                  helper.TfInt64(network.VlanId),
+		Gateway:                 helper.TfString(network.Gateway),
+		PrefixLength:            helper.TfInt64(network.PrefixLength),
+		MTU:                     helper.TfInt64(network.Mtu),
+		StorageDiscoveryAddress: storageDiscoveryAddress,
+		Addresses:               addressSet,
+	}, diags
+}
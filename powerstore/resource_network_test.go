@@ -0,0 +1,137 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Test to Create, Update and Import Network Resource
+func TestAccNetwork(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfigForTesting + NetworkParamsInvalidType,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+			{
+				Config:      ProviderConfigForTesting + NetworkParamsInvalidMTU,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value"),
+			},
+			{
+				Config:      ProviderConfigForTesting + NetworkParamsInvalidGateway,
+				ExpectError: regexp.MustCompile("Error creating network"),
+			},
+			{
+				Config: ProviderConfigForTesting + NetworkParamsCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerstore_network.test", "name", "tfacc_storage_network"),
+					resource.TestCheckResourceAttr("powerstore_network.test", "type", "Storage"),
+					resource.TestCheckResourceAttr("powerstore_network.test", "ip_version", "IPv4"),
+					resource.TestCheckResourceAttr("powerstore_network.test", "purposes.#", "1"),
+					resource.TestCheckResourceAttr("powerstore_network.test", "addresses.#", "2"),
+				),
+			},
+			// Import Testing
+			{
+				Config:            ProviderConfigForTesting + NetworkParamsCreate,
+				ResourceName:      "powerstore_network.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Import Negative Testing
+			{
+				Config:        ProviderConfigForTesting + NetworkParamsCreate,
+				ResourceName:  "powerstore_network.test",
+				ImportState:   true,
+				ExpectError:   regexp.MustCompile("Error reading network"),
+				ImportStateId: "invalid-id",
+			},
+			{
+				Config: ProviderConfigForTesting + NetworkParamsUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerstore_network.test", "name", "tfacc_storage_network_updated"),
+					resource.TestCheckResourceAttr("powerstore_network.test", "mtu", "9000"),
+					resource.TestCheckResourceAttr("powerstore_network.test", "purposes.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+var NetworkParamsInvalidType = `
+resource "powerstore_network" "test" {
+	name = "tfacc_storage_network"
+	type = "invalid"
+	prefix_length = 24
+	mtu = 1500
+}
+`
+
+var NetworkParamsInvalidMTU = `
+resource "powerstore_network" "test" {
+	name = "tfacc_storage_network"
+	type = "Storage"
+	prefix_length = 24
+	mtu = 100
+}
+`
+
+var NetworkParamsInvalidGateway = `
+resource "powerstore_network" "test" {
+	name = "tfacc_storage_network"
+	type = "Storage"
+	gateway = "invalid"
+	prefix_length = 24
+	mtu = 1500
+}
+`
+
+var NetworkParamsCreate = `
+resource "powerstore_network" "test" {
+	name = "tfacc_storage_network"
+	type = "Storage"
+	purposes = ["ISCSI"]
+	vlan_id = 42
+	prefix_length = 24
+	mtu = 1500
+	addresses = [` + networkAddresses + `]
+}
+`
+
+var NetworkParamsUpdate = `
+resource "powerstore_network" "test" {
+	name = "tfacc_storage_network_updated"
+	type = "Storage"
+	purposes = ["ISCSI", "NVMe_TCP"]
+	vlan_id = 42
+	prefix_length = 24
+	mtu = 9000
+	addresses = [` + networkAddresses + `]
+}
+`
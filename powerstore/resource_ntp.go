@@ -0,0 +1,309 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-powerstore/client"
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+)
+
+// newNtpResource returns ntp new resource instance
+func newNtpResource() resource.Resource {
+	return &resourceNtp{}
+}
+
+type resourceNtp struct {
+	client *clientgen.APIClient
+}
+
+// Metadata defines resource interface Metadata method
+func (r *resourceNtp) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ntp"
+}
+
+// Schema defines resource interface Schema method
+func (r *resourceNtp) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage the NTP servers of PowerStore Array. The cluster has exactly one NTP setting, so creating this resource adopts the existing setting and destroying it restores the baseline servers. We can also import the existing NTP setting from PowerStore array.",
+		Description:         "This resource is used to manage the NTP servers of PowerStore Array. The cluster has exactly one NTP setting, so creating this resource adopts the existing setting and destroying it restores the baseline servers. We can also import the existing NTP setting from PowerStore array.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Unique identifier of the NTP setting.",
+				MarkdownDescription: "Unique identifier of the NTP setting.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"addresses": schema.ListAttribute{
+				Description:         "NTP server addresses, in order of preference. This list may contain IPv4 addresses, IPv6 addresses and host names.",
+				MarkdownDescription: "NTP server addresses, in order of preference. This list may contain IPv4 addresses, IPv6 addresses and host names.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 3),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"baseline_addresses": schema.ListAttribute{
+				Description:         "NTP server addresses restored when the resource is destroyed. Defaults to the servers configured on the array when the resource is created. If the array had no servers configured, the servers are left unchanged on destroy.",
+				MarkdownDescription: "NTP server addresses restored when the resource is destroyed. Defaults to the servers configured on the array when the resource is created. If the array had no servers configured, the servers are left unchanged on destroy.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 3),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+		},
+	}
+}
+
+// Configure - defines configuration for ntp resource
+func (r *resourceNtp) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client.GenClient
+}
+
+// Create - adopts the NTP setting of the cluster and applies the planned servers
+func (r *resourceNtp) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.Ntp
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, _, err := r.client.NtpApi.GetAllNtps(ctx).Execute()
+	if err == nil && len(settings) == 0 {
+		err = fmt.Errorf("no NTP setting found")
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating ntp",
+			"Could not find ntp setting, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	current := settings[0]
+	id := helper.TfString(current.Id).ValueString()
+
+	// the servers configured before terraform took over are restored on destroy
+	if plan.BaselineAddresses.IsUnknown() {
+		plan.BaselineAddresses, diags = types.ListValueFrom(ctx, types.StringType, current.Addresses)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	_, err = r.client.NtpApi.PatchNtpById(ctx, id).Body(clientgen.NtpModify{
+		Addresses: helper.ListStrings(plan.Addresses),
+	}).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating ntp",
+			"Could not update ntp setting "+id+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	ntp, _, err := r.client.NtpApi.GetNtpById(ctx, id).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting ntp after creation",
+			"Could not get ntp setting, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state, dgs := r.updateState(ctx, ntp, plan.BaselineAddresses)
+	resp.Diagnostics.Append(dgs...)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Create")
+}
+
+// Read - reads ntp resource information
+func (r *resourceNtp) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.Ntp
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	ntp, _, err := r.client.NtpApi.GetNtpById(ctx, id).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ntp",
+			"Could not read ntp setting with error "+id+": "+err.Error(),
+		)
+		return
+	}
+
+	state, diags = r.updateState(ctx, ntp, state.BaselineAddresses)
+	resp.Diagnostics.Append(diags...)
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Read")
+}
+
+// Update - updates ntp resource
+func (r *resourceNtp) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	log.Printf("Started Update")
+
+	var plan models.Ntp
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.Ntp
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	if !plan.Addresses.Equal(state.Addresses) {
+		_, err := r.client.NtpApi.PatchNtpById(ctx, id).Body(clientgen.NtpModify{
+			Addresses: helper.ListStrings(plan.Addresses),
+		}).Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating ntp",
+				"Could not update ntp setting "+id+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	ntp, _, err := r.client.NtpApi.GetNtpById(ctx, id).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting ntp after update",
+			"Could not get ntp setting, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state, diags = r.updateState(ctx, ntp, plan.BaselineAddresses)
+	resp.Diagnostics.Append(diags...)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Successfully done with Update")
+}
+
+// Delete - restores the baseline NTP servers
+func (r *resourceNtp) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	log.Printf("Started with Delete")
+
+	var state models.Ntp
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	// the API requires at least one server, so an empty baseline leaves the servers unchanged
+	if baseline := helper.ListStrings(state.BaselineAddresses); len(baseline) > 0 {
+		_, err := r.client.NtpApi.PatchNtpById(ctx, id).Body(clientgen.NtpModify{
+			Addresses: baseline,
+		}).Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error deleting ntp",
+				"Could not restore ntp setting "+id+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+	log.Printf("Done with Delete")
+}
+
+// ImportState - imports state for existing ntp setting
+func (r *resourceNtp) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+
+	// the servers configured at import become the baseline
+	ntp, _, err := r.client.NtpApi.GetNtpById(ctx, req.ID).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing ntp",
+			"Could not read ntp setting with error "+req.ID+": "+err.Error(),
+		)
+		return
+	}
+	baseline, diags := types.ListValueFrom(ctx, types.StringType, ntp.Addresses)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("baseline_addresses"), baseline)...)
+}
+
+// updateState - converts the ntp response to the resource state
+func (r *resourceNtp) updateState(ctx context.Context, ntp *clientgen.NtpInstance, baseline types.List) (models.Ntp, diag.Diagnostics) {
+	addresses, diags := types.ListValueFrom(ctx, types.StringType, ntp.Addresses)
+	return models.Ntp{
+		ID:                helper.TfString(ntp.Id),
+		Addresses:         addresses,
+		BaselineAddresses: baseline,
+	}, diags
+}
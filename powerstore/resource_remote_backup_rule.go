@@ -0,0 +1,413 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-powerstore/client"
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+)
+
+// remoteBackupRuleSelect lists the snapshot rule fields read by the remote backup rule resource
+const remoteBackupRuleSelect = "id,name,remote_system_id,interval,time_of_day,timezone,days_of_week,desired_retention,managed_by,managed_by_id"
+
+// newRemoteBackupRuleResource returns remote backup rule new resource instance
+func newRemoteBackupRuleResource() resource.Resource {
+	return &resourceRemoteBackupRule{}
+}
+
+type resourceRemoteBackupRule struct {
+	client *clientgen.APIClient
+}
+
+// Metadata defines resource interface Metadata method
+func (r *resourceRemoteBackupRule) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_remote_backup_rule"
+}
+
+// Schema defines resource interface Schema method
+func (r *resourceRemoteBackupRule) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage the remote backup rules of PowerStore Array. A remote backup rule is a snapshot rule that backs up the volumes and volume groups of its protection policies to a PowerProtect DD remote system. We can Create, Update and Delete the remote backup rule using this resource. We can also import an existing remote backup rule from PowerStore array.",
+		Description:         "This resource is used to manage the remote backup rules of PowerStore Array. A remote backup rule is a snapshot rule that backs up the volumes and volume groups of its protection policies to a PowerProtect DD remote system. We can Create, Update and Delete the remote backup rule using this resource. We can also import an existing remote backup rule from PowerStore array.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Unique identifier of the remote backup rule.",
+				MarkdownDescription: "Unique identifier of the remote backup rule.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description:         "Name of the remote backup rule.",
+				MarkdownDescription: "Name of the remote backup rule.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"remote_system_id": schema.StringAttribute{
+				Description:         "Unique identifier of the PowerProtect DD remote system the snapshots are backed up to. It can only be changed while the protection policies of the rule are not assigned to any volume or volume group.",
+				MarkdownDescription: "Unique identifier of the PowerProtect DD remote system the snapshots are backed up to. It can only be changed while the protection policies of the rule are not assigned to any volume or volume group.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"interval": schema.StringAttribute{
+				Description:         "Interval between the backups. Exactly one of interval and time_of_day has to be set.",
+				MarkdownDescription: "Interval between the backups. Exactly one of `interval` and `time_of_day` has to be set.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(helper.SliceTransform(clientgen.AllowedSnapRuleIntervalEnumEnumValues, func(in clientgen.SnapRuleIntervalEnum) string {
+						return string(in)
+					})...),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("time_of_day")),
+				},
+			},
+			"time_of_day": schema.StringAttribute{
+				Description:         "Time of the day of a daily backup, with format hh:mm using a 24 hour clock.",
+				MarkdownDescription: "Time of the day of a daily backup, with format hh:mm using a 24 hour clock.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`), "format is hh:mm"),
+				},
+			},
+			"timezone": schema.StringAttribute{
+				Description:         "Time zone applied to time_of_day.",
+				MarkdownDescription: "Time zone applied to `time_of_day`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(helper.SliceTransform(clientgen.AllowedTimeZoneEnumEnumValues, func(in clientgen.TimeZoneEnum) string {
+						return string(in)
+					})...),
+					stringvalidator.AlsoRequires(path.MatchRoot("time_of_day")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"days_of_week": schema.SetAttribute{
+				Description:         "Days of the week the backups are taken.",
+				MarkdownDescription: "Days of the week the backups are taken.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(helper.SliceTransform(clientgen.AllowedDaysOfWeekEnumEnumValues, func(in clientgen.DaysOfWeekEnum) string {
+						return string(in)
+					})...)),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"desired_retention": schema.Int64Attribute{
+				Description:         "Retention period of the backups in hours, at most 70 years.",
+				MarkdownDescription: "Retention period of the backups in hours, at most 70 years.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 613200),
+				},
+			},
+			"managed_by": schema.StringAttribute{
+				Description:         "Entity that owns and manages the remote backup rule.",
+				MarkdownDescription: "Entity that owns and manages the remote backup rule.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"managed_by_id": schema.StringAttribute{
+				Description:         "Unique identifier of the managing entity.",
+				MarkdownDescription: "Unique identifier of the managing entity.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"delete_snaps": schema.BoolAttribute{
+				Description:         "Whether the remote snapshots created by the rule are deleted along with it.",
+				MarkdownDescription: "Whether the remote snapshots created by the rule are deleted along with it.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// Configure - defines configuration for remote backup rule resource
+func (r *resourceRemoteBackupRule) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client.GenClient
+}
+
+// Create - method to create remote backup rule resource
+func (r *resourceRemoteBackupRule) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.RemoteBackupRule
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createResp, _, err := r.client.SnapshotRuleApi.PostAllSnapshotRules(ctx).Body(clientgen.SnapshotRuleCreate{
+		Name:             plan.Name.ValueString(),
+		RemoteSystemId:   helper.ValueToPointer[string](plan.RemoteSystemID),
+		Interval:         (*clientgen.SnapRuleIntervalEnum)(helper.ValueToPointer[string](plan.Interval)),
+		TimeOfDay:        helper.ValueToPointer[string](plan.TimeOfDay),
+		Timezone:         (*clientgen.TimeZoneEnum)(helper.ValueToPointer[string](plan.Timezone)),
+		DaysOfWeek:       remoteBackupDays(plan.DaysOfWeek),
+		DesiredRetention: int32(plan.DesiredRetention.ValueInt64()),
+	}).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating remote backup rule",
+			"Could not create remote backup rule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	rule, err := r.ReadAPI(ctx, helper.TfString(createResp.Id).ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting remote backup rule after creation",
+			"Could not get remote backup rule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state, dgs := r.updateState(ctx, rule, plan.DeleteSnaps)
+	resp.Diagnostics.Append(dgs...)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Create")
+}
+
+// Read - reads remote backup rule resource information
+func (r *resourceRemoteBackupRule) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.RemoteBackupRule
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	rule, err := r.ReadAPI(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading remote backup rule",
+			"Could not read remote backup rule with error "+id+": "+err.Error(),
+		)
+		return
+	}
+
+	state, diags = r.updateState(ctx, rule, state.DeleteSnaps)
+	resp.Diagnostics.Append(diags...)
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Read")
+}
+
+// Update - updates remote backup rule resource
+func (r *resourceRemoteBackupRule) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	log.Printf("Started Update")
+
+	var plan models.RemoteBackupRule
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.RemoteBackupRule
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ruleModify := clientgen.SnapshotRuleModify{}
+	if !plan.Name.Equal(state.Name) {
+		ruleModify.Name = helper.ValueToPointer[string](plan.Name)
+	}
+	if !plan.RemoteSystemID.Equal(state.RemoteSystemID) {
+		ruleModify.RemoteSystemId = helper.ValueToPointer[string](plan.RemoteSystemID)
+	}
+	// the schedule is either an interval or a time of day, so switching sends the new one
+	if !plan.Interval.Equal(state.Interval) {
+		ruleModify.Interval = (*clientgen.SnapRuleIntervalEnum)(helper.ValueToPointer[string](plan.Interval))
+	}
+	if !plan.TimeOfDay.Equal(state.TimeOfDay) {
+		ruleModify.TimeOfDay = helper.ValueToPointer[string](plan.TimeOfDay)
+	}
+	if !plan.Timezone.Equal(state.Timezone) {
+		ruleModify.Timezone = (*clientgen.TimeZoneEnum)(helper.ValueToPointer[string](plan.Timezone))
+	}
+	if !plan.DaysOfWeek.Equal(state.DaysOfWeek) {
+		ruleModify.DaysOfWeek = remoteBackupDays(plan.DaysOfWeek)
+	}
+	if !plan.DesiredRetention.Equal(state.DesiredRetention) {
+		ruleModify.DesiredRetention = helper.KnownInt32(plan.DesiredRetention)
+	}
+
+	id := state.ID.ValueString()
+	if !reflect.ValueOf(ruleModify).IsZero() {
+		_, err := r.client.SnapshotRuleApi.PatchSnapshotRuleById(ctx, id).Body(ruleModify).Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating remote backup rule",
+				"Could not update remote backup rule "+id+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	rule, err := r.ReadAPI(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting remote backup rule after update",
+			"Could not get remote backup rule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state, diags = r.updateState(ctx, rule, plan.DeleteSnaps)
+	resp.Diagnostics.Append(diags...)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Successfully done with Update")
+}
+
+// Delete - method to delete remote backup rule resource
+func (r *resourceRemoteBackupRule) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	log.Printf("Started with Delete")
+
+	var state models.RemoteBackupRule
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	_, err := r.client.SnapshotRuleApi.DeleteSnapshotRuleById(ctx, id).Body(clientgen.SnapshotRuleDelete{
+		DeleteSnaps: helper.ValueToPointer[bool](state.DeleteSnaps),
+	}).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting remote backup rule",
+			"Could not delete remote backup rule "+id+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	log.Printf("Done with Delete")
+}
+
+// ImportState - imports state for existing remote backup rule
+func (r *resourceRemoteBackupRule) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// ReadAPI - fetches the remote backup rule by id
+func (r *resourceRemoteBackupRule) ReadAPI(ctx context.Context, id string) (*clientgen.SnapshotRuleInstance, error) {
+	queries := make(url.Values)
+	queries.Set("select", remoteBackupRuleSelect)
+	rule, _, err := r.client.SnapshotRuleApi.GetSnapshotRuleById(ctx, id).Queries(queries).Execute()
+	if err != nil {
+		return nil, err
+	}
+	if rule.RemoteSystemId == nil || *rule.RemoteSystemId == "" {
+		return nil, fmt.Errorf("snapshot rule %s takes local snapshots, use powerstore_snapshotrule to manage it", id)
+	}
+	return rule, nil
+}
+
+// updateState - converts the snapshot rule response to the remote backup rule state
+func (r *resourceRemoteBackupRule) updateState(ctx context.Context, rule *clientgen.SnapshotRuleInstance, deleteSnaps types.Bool) (models.RemoteBackupRule, diag.Diagnostics) {
+	days, diags := types.SetValueFrom(ctx, types.StringType, rule.DaysOfWeek)
+	timeOfDay := helper.TfString(rule.TimeOfDay)
+	// the time of day may be reported with seconds
+	if parts := strings.Split(timeOfDay.ValueString(), ":"); len(parts) == 3 {
+		timeOfDay = types.StringValue(strings.Join(parts[:2], ":"))
+	}
+	return models.RemoteBackupRule{
+		ID:               helper.TfString(rule.Id),
+		Name:             helper.TfString(rule.Name),
+		RemoteSystemID:   helper.TfString(rule.RemoteSystemId),
+		Interval:         helper.TfString(rule.Interval),
+		TimeOfDay:        timeOfDay,
+		Timezone:         helper.TfString(rule.Timezone),
+		DaysOfWeek:       days,
+		DesiredRetention: helper.TfInt64(rule.DesiredRetention),
+		ManagedBy:        helper.TfString(rule.ManagedBy),
+		ManagedByID:      helper.TfString(rule.ManagedById),
+		DeleteSnaps:      deleteSnaps,
+	}, diags
+}
+
+// remoteBackupDays converts the planned days of week, nil when they are not known
+func remoteBackupDays(in types.Set) []clientgen.DaysOfWeekEnum {
+	if !helper.IsKnownValue(in) {
+		return nil
+	}
+	return helper.SliceTransform(helper.SetStrings(in), func(day string) clientgen.DaysOfWeekEnum {
+		return clientgen.DaysOfWeekEnum(day)
+	})
+}
@@ -0,0 +1,114 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Test to Create, Update, Import and Delete Remote Backup Rule Resource
+func TestAccRemoteBackupRule(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfigForTesting + RemoteBackupRuleParamsIntervalAndTimeOfDay,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			{
+				Config: ProviderConfigForTesting + RemoteBackupRuleParamsCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerstore_remote_backup_rule.test", "name", "tfacc_remote_backup_rule"),
+					resource.TestCheckResourceAttr("powerstore_remote_backup_rule.test", "remote_system_id", ppddRemoteSystemID),
+					resource.TestCheckResourceAttr("powerstore_remote_backup_rule.test", "interval", "One_Day"),
+					resource.TestCheckResourceAttr("powerstore_remote_backup_rule.test", "desired_retention", "720"),
+					resource.TestCheckResourceAttr("powerstore_protectionpolicy.test", "snapshot_rule_ids.#", "1"),
+				),
+			},
+			// Import Testing
+			{
+				Config:                  ProviderConfigForTesting + RemoteBackupRuleParamsCreate,
+				ResourceName:            "powerstore_remote_backup_rule.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"delete_snaps"},
+			},
+			{
+				Config: ProviderConfigForTesting + RemoteBackupRuleParamsUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("powerstore_remote_backup_rule.test", "interval"),
+					resource.TestCheckResourceAttr("powerstore_remote_backup_rule.test", "time_of_day", "22:30"),
+					resource.TestCheckResourceAttr("powerstore_remote_backup_rule.test", "timezone", "UTC"),
+					resource.TestCheckResourceAttr("powerstore_remote_backup_rule.test", "days_of_week.#", "2"),
+					resource.TestCheckResourceAttr("powerstore_remote_backup_rule.test", "desired_retention", "8760"),
+				),
+			},
+		},
+	})
+}
+
+var RemoteBackupRuleParamsIntervalAndTimeOfDay = `
+resource "powerstore_remote_backup_rule" "test" {
+	name = "tfacc_remote_backup_rule"
+	remote_system_id = "` + ppddRemoteSystemID + `"
+	interval = "One_Day"
+	time_of_day = "22:30"
+	desired_retention = 720
+}
+`
+
+var RemoteBackupRuleParamsCreate = `
+resource "powerstore_remote_backup_rule" "test" {
+	name = "tfacc_remote_backup_rule"
+	remote_system_id = "` + ppddRemoteSystemID + `"
+	interval = "One_Day"
+	desired_retention = 720
+	delete_snaps = true
+}
+
+resource "powerstore_protectionpolicy" "test" {
+	name = "tfacc_remote_backup_policy"
+	snapshot_rule_ids = [powerstore_remote_backup_rule.test.id]
+}
+`
+
+var RemoteBackupRuleParamsUpdate = `
+resource "powerstore_remote_backup_rule" "test" {
+	name = "tfacc_remote_backup_rule"
+	remote_system_id = "` + ppddRemoteSystemID + `"
+	time_of_day = "22:30"
+	timezone = "UTC"
+	days_of_week = ["Saturday", "Sunday"]
+	desired_retention = 8760
+	delete_snaps = true
+}
+
+resource "powerstore_protectionpolicy" "test" {
+	name = "tfacc_remote_backup_policy"
+	snapshot_rule_ids = [powerstore_remote_backup_rule.test.id]
+}
+`
@@ -0,0 +1,367 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-powerstore/client"
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+)
+
+// remoteSyslogServerSelect lists the remote syslog server fields read by the remote syslog server resource
+const remoteSyslogServerSelect = "id,remote_server_address,port,protocol_type,encryption,audit_types,is_enabled,is_alert_events_included,status"
+
+// newRemoteSyslogServerResource returns remote syslog server new resource instance
+func newRemoteSyslogServerResource() resource.Resource {
+	return &resourceRemoteSyslogServer{}
+}
+
+type resourceRemoteSyslogServer struct {
+	client *clientgen.APIClient
+}
+
+// Metadata defines resource interface Metadata method
+func (r *resourceRemoteSyslogServer) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_remote_syslog_server"
+}
+
+// Schema defines resource interface Schema method
+func (r *resourceRemoteSyslogServer) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage the remote syslog servers receiving the audit events, and optionally the alert events, of PowerStore Array. We can Create, Update and Delete the remote syslog server using this resource. We can also import an existing remote syslog server from PowerStore array.",
+		Description:         "This resource is used to manage the remote syslog servers receiving the audit events, and optionally the alert events, of PowerStore Array. We can Create, Update and Delete the remote syslog server using this resource. We can also import an existing remote syslog server from PowerStore array.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Unique identifier of the remote syslog server.",
+				MarkdownDescription: "Unique identifier of the remote syslog server.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"remote_server_address": schema.StringAttribute{
+				Description:         "IPv4 address, IPv6 address or DNS name of the syslog server.",
+				MarkdownDescription: "IPv4 address, IPv6 address or DNS name of the syslog server.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"port": schema.Int64Attribute{
+				Description:         "Port used for the connection to the syslog server. Defaults to 514.",
+				MarkdownDescription: "Port used for the connection to the syslog server. Defaults to `514`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(514),
+				Validators: []validator.Int64{
+					int64validator.Between(0, 65535),
+				},
+			},
+			"protocol_type": schema.StringAttribute{
+				Description:         "Protocol used for the connection to the syslog server, either TCP or UDP. Defaults to UDP.",
+				MarkdownDescription: "Protocol used for the connection to the syslog server, either `TCP` or `UDP`. Defaults to `UDP`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(string(clientgen.PROTOCOLTYPEENUM_UDP)),
+				Validators: []validator.String{
+					stringvalidator.OneOf(helper.SliceTransform(clientgen.AllowedProtocolTypeEnumEnumValues, func(in clientgen.ProtocolTypeEnum) string {
+						return string(in)
+					})...),
+				},
+			},
+			"encryption": schema.StringAttribute{
+				Description:         "Encryption of the connection to the syslog server, either TLS or None. TLS requires the TCP protocol.",
+				MarkdownDescription: "Encryption of the connection to the syslog server, either `TLS` or `None`. `TLS` requires the `TCP` protocol.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(helper.SliceTransform(clientgen.AllowedEncryptionTypeEnumEnumValues, func(in clientgen.EncryptionTypeEnum) string {
+						return string(in)
+					})...),
+				},
+			},
+			"audit_types": schema.SetAttribute{
+				Description:         "Types of audit events sent to the syslog server. Valid values are Authentication, Authorization, Config, System, Logout, AIDE and Service. Defaults to all types.",
+				MarkdownDescription: "Types of audit events sent to the syslog server. Valid values are `Authentication`, `Authorization`, `Config`, `System`, `Logout`, `AIDE` and `Service`. Defaults to all types.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(helper.SliceTransform(clientgen.AllowedAuditEventTypeEnumEnumValues, func(in clientgen.AuditEventTypeEnum) string {
+						return string(in)
+					})...)),
+				},
+			},
+			"is_enabled": schema.BoolAttribute{
+				Description:         "Whether events are sent to the syslog server. Defaults to true.",
+				MarkdownDescription: "Whether events are sent to the syslog server. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"is_alert_events_included": schema.BoolAttribute{
+				Description:         "Whether the events associated with alerts are sent to the syslog server along with the audit events.",
+				MarkdownDescription: "Whether the events associated with alerts are sent to the syslog server along with the audit events.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description:         "Connection status of the syslog server, either Connected or Not_Connected.",
+				MarkdownDescription: "Connection status of the syslog server, either `Connected` or `Not_Connected`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure - defines configuration for remote syslog server resource
+func (r *resourceRemoteSyslogServer) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client.GenClient
+}
+
+// Create - method to create remote syslog server resource
+func (r *resourceRemoteSyslogServer) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.RemoteSyslogServer
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createResp, _, err := r.client.RemoteSyslogServerApi.PostAllRemoteSyslogServers(ctx).Body(clientgen.RemoteSyslogServerCreate{
+		RemoteServerAddress:   plan.RemoteServerAddress.ValueString(),
+		Port:                  int32(plan.Port.ValueInt64()),
+		ProtocolType:          clientgen.ProtocolTypeEnum(plan.ProtocolType.ValueString()),
+		Encryption:            (*clientgen.EncryptionTypeEnum)(helper.ValueToPointer[string](plan.Encryption)),
+		AuditTypes:            auditEventTypes(plan.AuditTypes),
+		IsEnabled:             helper.ValueToPointer[bool](plan.IsEnabled),
+		IsAlertEventsIncluded: helper.ValueToPointer[bool](plan.IsAlertEventsIncluded),
+	}).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating remote syslog server",
+			"Could not create remote syslog server, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	server, err := r.ReadAPI(ctx, helper.TfString(createResp.Id).ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting remote syslog server after creation",
+			"Could not get remote syslog server, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state, dgs := r.updateState(ctx, server)
+	resp.Diagnostics.Append(dgs...)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Create")
+}
+
+// Read - reads remote syslog server resource information
+func (r *resourceRemoteSyslogServer) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.RemoteSyslogServer
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	server, err := r.ReadAPI(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading remote syslog server",
+			"Could not read remote syslog server with error "+id+": "+err.Error(),
+		)
+		return
+	}
+
+	state, diags = r.updateState(ctx, server)
+	resp.Diagnostics.Append(diags...)
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Read")
+}
+
+// Update - updates remote syslog server resource
+func (r *resourceRemoteSyslogServer) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	log.Printf("Started Update")
+
+	var plan models.RemoteSyslogServer
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.RemoteSyslogServer
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	_, err := r.client.RemoteSyslogServerApi.PatchRemoteSyslogServerById(ctx, id).Body(clientgen.RemoteSyslogServerModify{
+		RemoteServerAddress:   helper.ValueToPointer[string](plan.RemoteServerAddress),
+		Port:                  helper.KnownInt32(plan.Port),
+		ProtocolType:          (*clientgen.ProtocolTypeEnum)(helper.ValueToPointer[string](plan.ProtocolType)),
+		Encryption:            (*clientgen.EncryptionTypeEnum)(helper.ValueToPointer[string](plan.Encryption)),
+		AuditTypes:            auditEventTypes(plan.AuditTypes),
+		IsEnabled:             helper.ValueToPointer[bool](plan.IsEnabled),
+		IsAlertEventsIncluded: helper.ValueToPointer[bool](plan.IsAlertEventsIncluded),
+	}).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating remote syslog server",
+			"Could not update remote syslog server "+id+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	server, err := r.ReadAPI(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting remote syslog server after update",
+			"Could not get remote syslog server, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state, diags = r.updateState(ctx, server)
+	resp.Diagnostics.Append(diags...)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Successfully done with Update")
+}
+
+// Delete - method to delete remote syslog server resource
+func (r *resourceRemoteSyslogServer) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	log.Printf("Started with Delete")
+
+	var state models.RemoteSyslogServer
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	_, err := r.client.RemoteSyslogServerApi.DeleteRemoteSyslogServerById(ctx, id).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting remote syslog server",
+			"Could not delete remote syslog server "+id+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	log.Printf("Done with Delete")
+}
+
+// ImportState - imports state for existing remote syslog server
+func (r *resourceRemoteSyslogServer) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// ReadAPI - fetches the remote syslog server by id
+func (r *resourceRemoteSyslogServer) ReadAPI(ctx context.Context, id string) (*clientgen.RemoteSyslogServerInstance, error) {
+	queries := make(url.Values)
+	queries.Set("select", remoteSyslogServerSelect)
+	server, _, err := r.client.RemoteSyslogServerApi.GetRemoteSyslogServerById(ctx, id).Queries(queries).Execute()
+	return server, err
+}
+
+// updateState - converts the remote syslog server response to the resource state
+func (r *resourceRemoteSyslogServer) updateState(ctx context.Context, server *clientgen.RemoteSyslogServerInstance) (models.RemoteSyslogServer, diag.Diagnostics) {
+	auditTypes, diags := types.SetValueFrom(ctx, types.StringType, helper.SliceTransform(server.AuditTypes, func(in clientgen.AuditEventTypeEnum) string {
+		return string(in)
+	}))
+	return models.RemoteSyslogServer{
+		ID:                    helper.TfString(server.Id),
+		RemoteServerAddress:   helper.TfString(server.RemoteServerAddress),
+		Port:                  helper.TfInt64(server.Port),
+		ProtocolType:          helper.TfString(server.ProtocolType),
+		Encryption:            helper.TfString(server.Encryption),
+		AuditTypes:            auditTypes,
+		IsEnabled:             helper.TfBool(server.IsEnabled),
+		IsAlertEventsIncluded: helper.TfBool(server.IsAlertEventsIncluded),
+		Status:                helper.TfString(server.Status),
+	}, diags
+}
+
+// auditEventTypes converts the planned audit types, unknown audit types are left to the array
+func auditEventTypes(in types.Set) []clientgen.AuditEventTypeEnum {
+	return helper.SliceTransform(helper.SetStrings(in), func(in string) clientgen.AuditEventTypeEnum {
+		return clientgen.AuditEventTypeEnum(in)
+	})
+}
@@ -0,0 +1,93 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Test to Create, Update, Import and Delete Remote Syslog Server Resource
+func TestAccRemoteSyslogServer(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfigForTesting + RemoteSyslogServerParamsInvalidAuditType,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+			{
+				Config: ProviderConfigForTesting + RemoteSyslogServerParamsCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerstore_remote_syslog_server.test", "remote_server_address", syslogServerAddress),
+					resource.TestCheckResourceAttr("powerstore_remote_syslog_server.test", "port", "514"),
+					resource.TestCheckResourceAttr("powerstore_remote_syslog_server.test", "protocol_type", "UDP"),
+					resource.TestCheckResourceAttr("powerstore_remote_syslog_server.test", "audit_types.#", "2"),
+				),
+			},
+			// Import Testing
+			{
+				Config:            ProviderConfigForTesting + RemoteSyslogServerParamsCreate,
+				ResourceName:      "powerstore_remote_syslog_server.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: ProviderConfigForTesting + RemoteSyslogServerParamsUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerstore_remote_syslog_server.test", "protocol_type", "TCP"),
+					resource.TestCheckResourceAttr("powerstore_remote_syslog_server.test", "encryption", "TLS"),
+					resource.TestCheckResourceAttr("powerstore_remote_syslog_server.test", "is_alert_events_included", "true"),
+				),
+			},
+		},
+	})
+}
+
+var RemoteSyslogServerParamsInvalidAuditType = `
+resource "powerstore_remote_syslog_server" "test" {
+	remote_server_address = "` + syslogServerAddress + `"
+	audit_types = ["invalid"]
+}
+`
+
+var RemoteSyslogServerParamsCreate = `
+resource "powerstore_remote_syslog_server" "test" {
+	remote_server_address = "` + syslogServerAddress + `"
+	audit_types = ["Authentication", "Config"]
+}
+`
+
+var RemoteSyslogServerParamsUpdate = `
+resource "powerstore_remote_syslog_server" "test" {
+	remote_server_address = "` + syslogServerAddress + `"
+	port = 6514
+	protocol_type = "TCP"
+	encryption = "TLS"
+	audit_types = ["Authentication", "Config"]
+	is_alert_events_included = true
+}
+`
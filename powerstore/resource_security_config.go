@@ -0,0 +1,436 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-powerstore/client"
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+)
+
+// newSecurityConfigResource returns security config new resource instance
+func newSecurityConfigResource() resource.Resource {
+	return &resourceSecurityConfig{}
+}
+
+type resourceSecurityConfig struct {
+	client *clientgen.APIClient
+}
+
+// Metadata defines resource interface Metadata method
+func (r *resourceSecurityConfig) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_security_config"
+}
+
+// Schema defines resource interface Schema method
+func (r *resourceSecurityConfig) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage the security settings and the login banner of PowerStore Array. The cluster has exactly one security configuration, so creating this resource adopts the existing settings and destroying it leaves the settings unchanged on the array. Settings which are not configured keep the values found on the array. We can also import the existing security configuration from PowerStore array.",
+		Description:         "This resource is used to manage the security settings and the login banner of PowerStore Array. The cluster has exactly one security configuration, so creating this resource adopts the existing settings and destroying it leaves the settings unchanged on the array. Settings which are not configured keep the values found on the array. We can also import the existing security configuration from PowerStore array.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Unique identifier of the security configuration.",
+				MarkdownDescription: "Unique identifier of the security configuration.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"idle_timeout": schema.Int64Attribute{
+				Description:         "Idle time in seconds after which management sessions expire and require re-authentication. The timeout is 3600 seconds outside of STIG mode and can only be changed in STIG mode, to 300, 600 or 1200 seconds.",
+				MarkdownDescription: "Idle time in seconds after which management sessions expire and require re-authentication. The timeout is `3600` seconds outside of STIG mode and can only be changed in STIG mode, to `300`, `600` or `1200` seconds.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.OneOf(300, 600, 1200, 3600),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					securityConfigStigModifier{},
+				},
+			},
+			"protocol_mode": schema.StringAttribute{
+				Description:         "TLS protocol mode of the cluster.",
+				MarkdownDescription: "TLS protocol mode of the cluster.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(helper.SliceTransform(clientgen.AllowedSecurityProtocolModeEnumEnumValues, func(in clientgen.SecurityProtocolModeEnum) string {
+						return string(in)
+					})...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_stig_enabled": schema.BoolAttribute{
+				Description:         "Whether the Security Technical Implementation Guide (STIG) mode is enabled on the cluster. Enabling STIG mode reboots the appliances and cannot be disabled afterwards.",
+				MarkdownDescription: "Whether the Security Technical Implementation Guide (STIG) mode is enabled on the cluster. Enabling STIG mode reboots the appliances and cannot be disabled afterwards.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_fips_enabled": schema.BoolAttribute{
+				Description:         "Whether the cluster only uses FIPS 140-2 validated encryption schemes.",
+				MarkdownDescription: "Whether the cluster only uses FIPS 140-2 validated encryption schemes.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					securityConfigStigModifier{},
+				},
+			},
+			"is_http_redirect_enabled": schema.BoolAttribute{
+				Description:         "Whether HTTP requests are redirected to HTTPS. Otherwise only HTTPS is allowed.",
+				MarkdownDescription: "Whether HTTP requests are redirected to HTTPS. Otherwise only HTTPS is allowed.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"vasa_retain_certificate": schema.BoolAttribute{
+				Description:         "Whether the VASA server certificate is kept when vCenter provides a new one.",
+				MarkdownDescription: "Whether the VASA server certificate is kept when vCenter provides a new one.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cert_user_name_policy": schema.StringAttribute{
+				Description:         "Attribute of client certificates matched with the user account for certificate based login.",
+				MarkdownDescription: "Attribute of client certificates matched with the user account for certificate based login.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(helper.SliceTransform(clientgen.AllowedCertUserNamePolicyEnumEnumValues, func(in clientgen.CertUserNamePolicyEnum) string {
+						return string(in)
+					})...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"login_banner_text": schema.StringAttribute{
+				Description:         "Text shown on the login page.",
+				MarkdownDescription: "Text shown on the login page.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_login_banner_enabled": schema.BoolAttribute{
+				Description:         "Whether the login banner is shown on the login page.",
+				MarkdownDescription: "Whether the login banner is shown on the login page.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// securityConfigStigModifier keeps the state value of settings whose defaults depend on the STIG mode while the STIG mode does not change
+type securityConfigStigModifier struct{}
+
+// Description returns a plain text description of the modifier's behavior
+func (m securityConfigStigModifier) Description(ctx context.Context) string {
+	return "Keeps the value from the state unless is_stig_enabled changes."
+}
+
+// MarkdownDescription returns a markdown formatted description of the modifier's behavior
+func (m securityConfigStigModifier) MarkdownDescription(ctx context.Context) string {
+	return "Keeps the value from the state unless `is_stig_enabled` changes."
+}
+
+// PlanModifyInt64 keeps the state value if the STIG mode is unchanged
+func (m securityConfigStigModifier) PlanModifyInt64(ctx context.Context, req planmodifier.Int64Request, resp *planmodifier.Int64Response) {
+	if req.PlanValue.IsUnknown() && m.stigUnchanged(ctx, req.Plan, req.State, &resp.Diagnostics) {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+// PlanModifyBool keeps the state value if the STIG mode is unchanged
+func (m securityConfigStigModifier) PlanModifyBool(ctx context.Context, req planmodifier.BoolRequest, resp *planmodifier.BoolResponse) {
+	if req.PlanValue.IsUnknown() && m.stigUnchanged(ctx, req.Plan, req.State, &resp.Diagnostics) {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+// stigUnchanged - returns whether the resource exists and its STIG mode is not planned to change
+func (m securityConfigStigModifier) stigUnchanged(ctx context.Context, plan tfsdk.Plan, state tfsdk.State, diags *diag.Diagnostics) bool {
+	if state.Raw.IsNull() || plan.Raw.IsNull() {
+		return false
+	}
+	var planned, current types.Bool
+	diags.Append(plan.GetAttribute(ctx, path.Root("is_stig_enabled"), &planned)...)
+	diags.Append(state.GetAttribute(ctx, path.Root("is_stig_enabled"), &current)...)
+	return !diags.HasError() && (planned.IsUnknown() || planned.Equal(current))
+}
+
+// Configure - defines configuration for security config resource
+func (r *resourceSecurityConfig) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client.GenClient
+}
+
+// Create - adopts the security configuration of the cluster and applies the planned settings
+func (r *resourceSecurityConfig) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.SecurityConfig
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configs, _, err := r.client.SecurityConfigApi.GetAllSecurityConfigs(ctx).Execute()
+	if err == nil && len(configs) == 0 {
+		err = fmt.Errorf("no security configuration found")
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating security config",
+			"Could not find security config, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	id := helper.TfString(configs[0].Id).ValueString()
+
+	// settings which are not planned are unknown, so only the configured ones are sent
+	err = r.modify(ctx, id, plan, models.SecurityConfig{})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating security config",
+			"Could not update security config "+id+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state, dgs := r.read(ctx, id)
+	resp.Diagnostics.Append(dgs...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Create")
+}
+
+// Read - reads security config resource information
+func (r *resourceSecurityConfig) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.SecurityConfig
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags = r.read(ctx, state.ID.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Read")
+}
+
+// Update - updates security config resource
+func (r *resourceSecurityConfig) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	log.Printf("Started Update")
+
+	var plan models.SecurityConfig
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.SecurityConfig
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	err := r.modify(ctx, id, plan, state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating security config",
+			"Could not update security config "+id+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state, diags = r.read(ctx, id)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Successfully done with Update")
+}
+
+// Delete - removes the security config from the state
+func (r *resourceSecurityConfig) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	log.Printf("Started with Delete")
+
+	// the security settings are left as they are, reverting them would weaken the cluster
+	resp.State.RemoveResource(ctx)
+	log.Printf("Done with Delete")
+}
+
+// ImportState - imports state for existing security config
+func (r *resourceSecurityConfig) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// modify - sends the settings which differ between the plan and the state
+func (r *resourceSecurityConfig) modify(ctx context.Context, id string, plan, state models.SecurityConfig) error {
+	configModify := clientgen.SecurityConfigModify{}
+	if helper.IsKnownValue(plan.IdleTimeout) && !plan.IdleTimeout.Equal(state.IdleTimeout) {
+		configModify.IdleTimeout = helper.KnownInt32(plan.IdleTimeout)
+	}
+	if helper.IsKnownValue(plan.ProtocolMode) && !plan.ProtocolMode.Equal(state.ProtocolMode) {
+		configModify.ProtocolMode = helper.GetPointer(clientgen.SecurityProtocolModeEnum(plan.ProtocolMode.ValueString()))
+	}
+	if helper.IsKnownValue(plan.IsStigEnabled) && !plan.IsStigEnabled.Equal(state.IsStigEnabled) {
+		configModify.IsStigEnabled = helper.ValueToPointer[bool](plan.IsStigEnabled)
+	}
+	if helper.IsKnownValue(plan.IsHTTPRedirectEnabled) && !plan.IsHTTPRedirectEnabled.Equal(state.IsHTTPRedirectEnabled) {
+		configModify.IsHttpRedirectEnabled = helper.ValueToPointer[bool](plan.IsHTTPRedirectEnabled)
+	}
+	if helper.IsKnownValue(plan.VasaRetainCertificate) && !plan.VasaRetainCertificate.Equal(state.VasaRetainCertificate) {
+		configModify.VasaRetainCertificate = helper.ValueToPointer[bool](plan.VasaRetainCertificate)
+	}
+	if helper.IsKnownValue(plan.CertUserNamePolicy) && !plan.CertUserNamePolicy.Equal(state.CertUserNamePolicy) {
+		configModify.CertUserNamePolicy = helper.GetPointer(clientgen.CertUserNamePolicyEnum(plan.CertUserNamePolicy.ValueString()))
+	}
+	if configModify != (clientgen.SecurityConfigModify{}) {
+		_, err := r.client.SecurityConfigApi.PatchSecurityConfigById(ctx, id).Body(configModify).Execute()
+		if err != nil {
+			return err
+		}
+	}
+
+	bannerModify := clientgen.LoginBannerModify{}
+	if helper.IsKnownValue(plan.LoginBannerText) && !plan.LoginBannerText.Equal(state.LoginBannerText) {
+		bannerModify.BannerText = helper.ValueToPointer[string](plan.LoginBannerText)
+	}
+	if helper.IsKnownValue(plan.IsLoginBannerEnabled) && !plan.IsLoginBannerEnabled.Equal(state.IsLoginBannerEnabled) {
+		bannerModify.IsEnabled = helper.ValueToPointer[bool](plan.IsLoginBannerEnabled)
+	}
+	if bannerModify != (clientgen.LoginBannerModify{}) {
+		banner, err := r.loginBanner(ctx)
+		if err != nil {
+			return err
+		}
+		_, err = r.client.LoginBannerApi.PatchLoginBannerById(ctx, helper.TfString(banner.Id).ValueString()).Body(bannerModify).Execute()
+		if err != nil {
+			return fmt.Errorf("could not update login banner: %s", err.Error())
+		}
+	}
+	return nil
+}
+
+// loginBanner - returns the login banner of the cluster
+func (r *resourceSecurityConfig) loginBanner(ctx context.Context) (clientgen.LoginBannerInstance, error) {
+	queries := make(url.Values)
+	queries.Set("select", "id,banner_text,is_enabled")
+	banners, _, err := r.client.LoginBannerApi.GetAllLoginBanners(ctx).Queries(queries).Execute()
+	if err == nil && len(banners) == 0 {
+		err = fmt.Errorf("no login banner found")
+	}
+	if err != nil {
+		return clientgen.LoginBannerInstance{}, fmt.Errorf("could not read login banner: %s", err.Error())
+	}
+	return banners[0], nil
+}
+
+// read - reads the security config and the login banner into the resource state
+func (r *resourceSecurityConfig) read(ctx context.Context, id string) (models.SecurityConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	config, _, err := r.client.SecurityConfigApi.GetSecurityConfigById(ctx, id).Execute()
+	if err != nil {
+		diags.AddError(
+			"Error reading security config",
+			"Could not read security config with error "+id+": "+err.Error(),
+		)
+		return models.SecurityConfig{}, diags
+	}
+	banner, err := r.loginBanner(ctx)
+	if err != nil {
+		diags.AddError(
+			"Error reading security config",
+			"Could not read security config with error "+id+": "+err.Error(),
+		)
+		return models.SecurityConfig{}, diags
+	}
+
+	return models.SecurityConfig{
+		ID:                    helper.TfString(config.Id),
+		IdleTimeout:           helper.TfInt64(config.IdleTimeout),
+		ProtocolMode:          helper.TfString(config.ProtocolMode),
+		IsStigEnabled:         helper.TfBool(config.IsStigEnabled),
+		IsFipsEnabled:         helper.TfBool(config.IsFipsEnabled),
+		IsHTTPRedirectEnabled: helper.TfBool(config.IsHttpRedirectEnabled),
+		VasaRetainCertificate: helper.TfBool(config.VasaRetainCertificate),
+		CertUserNamePolicy:    helper.TfString(config.CertUserNamePolicy),
+		LoginBannerText:       helper.TfString(banner.BannerText),
+		IsLoginBannerEnabled:  helper.TfBool(banner.IsEnabled),
+	}, diags
+}
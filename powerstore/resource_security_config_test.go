@@ -0,0 +1,88 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Test to Create, Update, Import and Delete Security Config Resource
+func TestAccSecurityConfig(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfigForTesting + SecurityConfigParamsInvalidTimeout,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value"),
+			},
+			{
+				Config: ProviderConfigForTesting + SecurityConfigParamsCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("powerstore_security_config.test", "id"),
+					resource.TestCheckResourceAttrSet("powerstore_security_config.test", "idle_timeout"),
+					resource.TestCheckResourceAttr("powerstore_security_config.test", "login_banner_text", "Authorized use only"),
+					resource.TestCheckResourceAttr("powerstore_security_config.test", "is_login_banner_enabled", "true"),
+				),
+			},
+			// Import Testing
+			{
+				Config:            ProviderConfigForTesting + SecurityConfigParamsCreate,
+				ResourceName:      "powerstore_security_config.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: ProviderConfigForTesting + SecurityConfigParamsUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerstore_security_config.test", "is_http_redirect_enabled", "false"),
+					resource.TestCheckResourceAttr("powerstore_security_config.test", "is_login_banner_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+var SecurityConfigParamsInvalidTimeout = `
+resource "powerstore_security_config" "test" {
+	idle_timeout = 10
+}
+`
+
+var SecurityConfigParamsCreate = `
+resource "powerstore_security_config" "test" {
+	login_banner_text = "Authorized use only"
+	is_login_banner_enabled = true
+}
+`
+
+var SecurityConfigParamsUpdate = `
+resource "powerstore_security_config" "test" {
+	is_http_redirect_enabled = false
+	login_banner_text = "Authorized use only"
+	is_login_banner_enabled = false
+}
+`
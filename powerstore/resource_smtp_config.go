@@ -0,0 +1,357 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-powerstore/client"
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+)
+
+// newSMTPConfigResource returns smtp config new resource instance
+func newSMTPConfigResource() resource.Resource {
+	return &resourceSMTPConfig{}
+}
+
+type resourceSMTPConfig struct {
+	client *clientgen.APIClient
+}
+
+// Metadata defines resource interface Metadata method
+func (r *resourceSMTPConfig) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_smtp_config"
+}
+
+// Schema defines resource interface Schema method
+func (r *resourceSMTPConfig) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage the SMTP server used by PowerStore Array to send alert emails. The cluster has exactly one SMTP configuration, so creating this resource adopts the existing configuration and destroying it restores the baseline configuration. We can also import the existing SMTP configuration from PowerStore array.",
+		Description:         "This resource is used to manage the SMTP server used by PowerStore Array to send alert emails. The cluster has exactly one SMTP configuration, so creating this resource adopts the existing configuration and destroying it restores the baseline configuration. We can also import the existing SMTP configuration from PowerStore array.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Unique identifier of the SMTP configuration.",
+				MarkdownDescription: "Unique identifier of the SMTP configuration.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"address": schema.StringAttribute{
+				Description:         "IP address of the SMTP server.",
+				MarkdownDescription: "IP address of the SMTP server.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"port": schema.Int64Attribute{
+				Description:         "Port used for sending SMTP messages. Defaults to 25.",
+				MarkdownDescription: "Port used for sending SMTP messages. Defaults to `25`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(25),
+				Validators: []validator.Int64{
+					int64validator.Between(0, 65535),
+				},
+			},
+			"source_email": schema.StringAttribute{
+				Description:         "Source email address used for sending SMTP messages.",
+				MarkdownDescription: "Source email address used for sending SMTP messages.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"baseline_address": schema.StringAttribute{
+				Description:         "IP address of the SMTP server restored when the resource is destroyed. Defaults to the server configured on the array when the resource is created. If the array had no server configured, the configuration is left unchanged on destroy.",
+				MarkdownDescription: "IP address of the SMTP server restored when the resource is destroyed. Defaults to the server configured on the array when the resource is created. If the array had no server configured, the configuration is left unchanged on destroy.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"baseline_port": schema.Int64Attribute{
+				Description:         "Port restored when the resource is destroyed. Defaults to the port configured on the array when the resource is created.",
+				MarkdownDescription: "Port restored when the resource is destroyed. Defaults to the port configured on the array when the resource is created.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(0, 65535),
+				},
+			},
+			"baseline_source_email": schema.StringAttribute{
+				Description:         "Source email address restored when the resource is destroyed. Defaults to the source email address configured on the array when the resource is created.",
+				MarkdownDescription: "Source email address restored when the resource is destroyed. Defaults to the source email address configured on the array when the resource is created.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// Configure - defines configuration for smtp config resource
+func (r *resourceSMTPConfig) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client.GenClient
+}
+
+// Create - adopts the SMTP configuration of the cluster and applies the planned server
+func (r *resourceSMTPConfig) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.SMTPConfig
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configs, _, err := r.client.SmtpConfigApi.GetAllSmtpConfigs(ctx).Execute()
+	if err == nil && len(configs) == 0 {
+		err = fmt.Errorf("no SMTP configuration found")
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating smtp config",
+			"Could not find smtp config, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	current := configs[0]
+	id := helper.TfString(current.Id).ValueString()
+
+	// the configuration found before terraform took over is restored on destroy
+	r.adoptBaseline(&plan, current)
+
+	err = r.modify(ctx, id, plan.Address, plan.Port, plan.SourceEmail)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating smtp config",
+			"Could not update smtp config "+id+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	config, _, err := r.client.SmtpConfigApi.GetSmtpConfigById(ctx, id).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting smtp config after creation",
+			"Could not get smtp config, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state := r.updateState(config, plan)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Create")
+}
+
+// Read - reads smtp config resource information
+func (r *resourceSMTPConfig) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.SMTPConfig
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	config, _, err := r.client.SmtpConfigApi.GetSmtpConfigById(ctx, id).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading smtp config",
+			"Could not read smtp config with error "+id+": "+err.Error(),
+		)
+		return
+	}
+
+	state = r.updateState(config, state)
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Read")
+}
+
+// Update - updates smtp config resource
+func (r *resourceSMTPConfig) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	log.Printf("Started Update")
+
+	var plan models.SMTPConfig
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.SMTPConfig
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	err := r.modify(ctx, id, plan.Address, plan.Port, plan.SourceEmail)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating smtp config",
+			"Could not update smtp config "+id+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	config, _, err := r.client.SmtpConfigApi.GetSmtpConfigById(ctx, id).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting smtp config after update",
+			"Could not get smtp config, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state = r.updateState(config, plan)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Successfully done with Update")
+}
+
+// Delete - restores the baseline SMTP configuration
+func (r *resourceSMTPConfig) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	log.Printf("Started with Delete")
+
+	var state models.SMTPConfig
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	// the API cannot clear the server address, so an empty baseline leaves the configuration unchanged
+	if state.BaselineAddress.ValueString() != "" {
+		err := r.modify(ctx, id, state.BaselineAddress, state.BaselinePort, state.BaselineSourceEmail)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error deleting smtp config",
+				"Could not restore smtp config "+id+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+	log.Printf("Done with Delete")
+}
+
+// ImportState - imports state for existing smtp config
+func (r *resourceSMTPConfig) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+
+	// the configuration found at import becomes the baseline
+	config, _, err := r.client.SmtpConfigApi.GetSmtpConfigById(ctx, req.ID).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing smtp config",
+			"Could not read smtp config with error "+req.ID+": "+err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("baseline_address"), helper.TfString(config.Address))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("baseline_port"), helper.TfInt64(config.Port))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("baseline_source_email"), helper.TfString(config.SourceEmail))...)
+}
+
+// adoptBaseline - fills the baseline attributes which are not planned with the given configuration
+func (r *resourceSMTPConfig) adoptBaseline(plan *models.SMTPConfig, current clientgen.SmtpConfigInstance) {
+	if plan.BaselineAddress.IsUnknown() {
+		plan.BaselineAddress = helper.TfString(current.Address)
+	}
+	if plan.BaselinePort.IsUnknown() {
+		plan.BaselinePort = helper.TfInt64(current.Port)
+	}
+	if plan.BaselineSourceEmail.IsUnknown() {
+		plan.BaselineSourceEmail = helper.TfString(current.SourceEmail)
+	}
+}
+
+// modify - sets the address, port and source email of the SMTP configuration
+func (r *resourceSMTPConfig) modify(ctx context.Context, id string, address types.String, port types.Int64, sourceEmail types.String) error {
+	_, err := r.client.SmtpConfigApi.PatchSmtpConfigById(ctx, id).Body(clientgen.SmtpConfigModify{
+		Address:     helper.ValueToPointer[string](address),
+		Port:        helper.KnownInt32(port),
+		SourceEmail: helper.ValueToPointer[string](sourceEmail),
+	}).Execute()
+	return err
+}
+
+// updateState - converts the smtp config response to the resource state
+func (r *resourceSMTPConfig) updateState(config *clientgen.SmtpConfigInstance, plan models.SMTPConfig) models.SMTPConfig {
+	return models.SMTPConfig{
+		ID:                  helper.TfString(config.Id),
+		Address:             helper.TfString(config.Address),
+		Port:                helper.TfInt64(config.Port),
+		SourceEmail:         helper.TfString(config.SourceEmail),
+		BaselineAddress:     plan.BaselineAddress,
+		BaselinePort:        plan.BaselinePort,
+		BaselineSourceEmail: plan.BaselineSourceEmail,
+	}
+}
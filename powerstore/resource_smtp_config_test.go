@@ -0,0 +1,90 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Test to Create, Update, Import and Delete SMTP Config Resource
+func TestAccSMTPConfig(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfigForTesting + SMTPConfigParamsInvalidPort,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value"),
+			},
+			{
+				Config: ProviderConfigForTesting + SMTPConfigParamsCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerstore_smtp_config.test", "address", smtpAddress),
+					resource.TestCheckResourceAttr("powerstore_smtp_config.test", "port", "25"),
+					resource.TestCheckResourceAttr("powerstore_smtp_config.test", "source_email", "powerstore@tfacc.example.com"),
+				),
+			},
+			// Import Testing
+			{
+				Config:                  ProviderConfigForTesting + SMTPConfigParamsCreate,
+				ResourceName:            "powerstore_smtp_config.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"baseline_address", "baseline_port", "baseline_source_email"},
+			},
+			{
+				Config: ProviderConfigForTesting + SMTPConfigParamsUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerstore_smtp_config.test", "port", "587"),
+					resource.TestCheckResourceAttr("powerstore_smtp_config.test", "source_email", "alerts@tfacc.example.com"),
+				),
+			},
+		},
+	})
+}
+
+var SMTPConfigParamsInvalidPort = `
+resource "powerstore_smtp_config" "test" {
+	address = "` + smtpAddress + `"
+	port = 70000
+	source_email = "powerstore@tfacc.example.com"
+}
+`
+
+var SMTPConfigParamsCreate = `
+resource "powerstore_smtp_config" "test" {
+	address = "` + smtpAddress + `"
+	source_email = "powerstore@tfacc.example.com"
+}
+`
+
+var SMTPConfigParamsUpdate = `
+resource "powerstore_smtp_config" "test" {
+	address = "` + smtpAddress + `"
+	port = 587
+	source_email = "alerts@tfacc.example.com"
+}
+`
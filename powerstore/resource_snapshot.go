@@ -24,6 +24,7 @@ import (
 	"regexp"
 	"terraform-provider-powerstore/client"
 	"terraform-provider-powerstore/models"
+	"time"
 
 	"github.com/dell/gopowerstore"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -215,11 +216,12 @@ func (r *resourceVolumeSnapshot) Create(ctx context.Context, req resource.Create
 		CreatorType:         gopowerstore.StorageCreatorTypeEnum(creatorType),
 	}
 
+	started := time.Now()
 	snapCreateResponse, err := r.client.PStoreClient.CreateSnapshot(context.Background(), snapCreate, volID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating volume snapshot",
-			"Could not create volume snapshot, unexpected error: "+err.Error(),
+			"Could not create volume snapshot, unexpected error: "+err.Error()+r.client.FailedJobDetails(ctx, started, "volume", volID, ""),
 		)
 		return
 	}
@@ -0,0 +1,405 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-powerstore/client"
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+)
+
+// snmpServerSelect lists the SNMP server fields read by the SNMP server resource
+const snmpServerSelect = "id,ip_address,port,version,alert_severity,user_name,auth_protocol,privacy_protocol"
+
+// newSnmpServerResource returns snmp server new resource instance
+func newSnmpServerResource() resource.Resource {
+	return &resourceSnmpServer{}
+}
+
+type resourceSnmpServer struct {
+	client *clientgen.APIClient
+}
+
+// Metadata defines resource interface Metadata method
+func (r *resourceSnmpServer) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_snmp_server"
+}
+
+// Schema defines resource interface Schema method
+func (r *resourceSnmpServer) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to manage the SNMP servers receiving alert traps from PowerStore Array, using SNMPv2c or SNMPv3. We can Create, Update and Delete the SNMP server using this resource. We can also import an existing SNMP server from PowerStore array.",
+		Description:         "This resource is used to manage the SNMP servers receiving alert traps from PowerStore Array, using SNMPv2c or SNMPv3. We can Create, Update and Delete the SNMP server using this resource. We can also import an existing SNMP server from PowerStore array.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Unique identifier of the SNMP server.",
+				MarkdownDescription: "Unique identifier of the SNMP server.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ip_address": schema.StringAttribute{
+				Description:         "IPv4 address, IPv6 address or FQDN of the SNMP server.",
+				MarkdownDescription: "IPv4 address, IPv6 address or FQDN of the SNMP server.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"port": schema.Int64Attribute{
+				Description:         "Port number of the SNMP server, either 162 or between 1024 and 49151. Defaults to 162.",
+				MarkdownDescription: "Port number of the SNMP server, either `162` or between `1024` and `49151`. Defaults to `162`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(162),
+				Validators: []validator.Int64{
+					int64validator.Any(int64validator.OneOf(162), int64validator.Between(1024, 49151)),
+				},
+			},
+			"version": schema.StringAttribute{
+				Description:         "SNMP protocol version, either V2c or V3. Cannot be updated.",
+				MarkdownDescription: "SNMP protocol version, either `V2c` or `V3`. Cannot be updated.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(helper.SliceTransform(clientgen.AllowedSNMPVersionEnumEnumValues, func(in clientgen.SNMPVersionEnum) string {
+						return string(in)
+					})...),
+				},
+			},
+			"alert_severity": schema.StringAttribute{
+				Description:         "Minimum severity of the alerts sent to the SNMP server, one of Info, Minor, Major or Critical.",
+				MarkdownDescription: "Minimum severity of the alerts sent to the SNMP server, one of `Info`, `Minor`, `Major` or `Critical`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(helper.SliceTransform(clientgen.AllowedSNMPSeverityEnumEnumValues, func(in clientgen.SNMPSeverityEnum) string {
+						return string(in)
+					})...),
+				},
+			},
+			"trap_community": schema.StringAttribute{
+				Description:         "Trap community string, relevant only for SNMPv2c. This attribute is write-only and is never stored in the state, change credentials_version to set a new trap community.",
+				MarkdownDescription: "Trap community string, relevant only for SNMPv2c. This attribute is write-only and is never stored in the state, change `credentials_version` to set a new trap community.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(256),
+				},
+			},
+			"user_name": schema.StringAttribute{
+				Description:         "User name, relevant only for SNMPv3.",
+				MarkdownDescription: "User name, relevant only for SNMPv3.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 32),
+				},
+			},
+			"auth_protocol": schema.StringAttribute{
+				Description:         "Authentication protocol, relevant only for SNMPv3. Valid values are None, MD5 and SHA256.",
+				MarkdownDescription: "Authentication protocol, relevant only for SNMPv3. Valid values are `None`, `MD5` and `SHA256`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(helper.SliceTransform(clientgen.AllowedSNMPAuthProtocolEnumEnumValues, func(in clientgen.SNMPAuthProtocolEnum) string {
+						return string(in)
+					})...),
+				},
+			},
+			"privacy_protocol": schema.StringAttribute{
+				Description:         "Privacy protocol, relevant only for SNMPv3. Valid values are None, AES256 and TDES.",
+				MarkdownDescription: "Privacy protocol, relevant only for SNMPv3. Valid values are `None`, `AES256` and `TDES`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(helper.SliceTransform(clientgen.AllowedSNMPPrivacyProtocolEnumEnumValues, func(in clientgen.SNMPPrivacyProtocolEnum) string {
+						return string(in)
+					})...),
+				},
+			},
+			"authpass": schema.StringAttribute{
+				Description:         "Passphrase used for both the authentication and privacy protocols, relevant only for SNMPv3. This attribute is write-only and is never stored in the state, change credentials_version to set a new passphrase.",
+				MarkdownDescription: "Passphrase used for both the authentication and privacy protocols, relevant only for SNMPv3. This attribute is write-only and is never stored in the state, change `credentials_version` to set a new passphrase.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(8, 40),
+				},
+			},
+			"credentials_version": schema.Int64Attribute{
+				Description:         "Version of the trap community and passphrase. Since they are not stored in the state, changes to them are only applied when this value changes.",
+				MarkdownDescription: "Version of the trap community and passphrase. Since they are not stored in the state, changes to them are only applied when this value changes.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// Configure - defines configuration for snmp server resource
+func (r *resourceSnmpServer) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client.GenClient
+}
+
+// Create - method to create snmp server resource
+func (r *resourceSnmpServer) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.SnmpServer
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// write-only attributes are only available in the configuration
+	var trapCommunity, authpass types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("trap_community"), &trapCommunity)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("authpass"), &authpass)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createResp, _, err := r.client.SnmpServerApi.PostAllSnmpServers(ctx).Body(clientgen.SnmpServerCreate{
+		IpAddress:       plan.IPAddress.ValueString(),
+		Port:            int32(plan.Port.ValueInt64()),
+		Version:         clientgen.SNMPVersionEnum(plan.Version.ValueString()),
+		AlertSeverity:   clientgen.SNMPSeverityEnum(plan.AlertSeverity.ValueString()),
+		TrapCommunity:   helper.ValueToPointer[string](trapCommunity),
+		UserName:        helper.ValueToPointer[string](plan.UserName),
+		AuthProtocol:    (*clientgen.SNMPAuthProtocolEnum)(helper.ValueToPointer[string](plan.AuthProtocol)),
+		PrivacyProtocol: (*clientgen.SNMPPrivacyProtocolEnum)(helper.ValueToPointer[string](plan.PrivacyProtocol)),
+		Authpass:        helper.ValueToPointer[string](authpass),
+	}).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating snmp server",
+			"Could not create snmp server, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	server, err := r.ReadAPI(ctx, helper.TfString(createResp.Id).ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting snmp server after creation",
+			"Could not get snmp server, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state := r.updateState(server, plan.CredentialsVersion)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Create")
+}
+
+// Read - reads snmp server resource information
+func (r *resourceSnmpServer) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.SnmpServer
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	server, err := r.ReadAPI(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading snmp server",
+			"Could not read snmp server with error "+id+": "+err.Error(),
+		)
+		return
+	}
+
+	state = r.updateState(server, state.CredentialsVersion)
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Read")
+}
+
+// Update - updates snmp server resource
+func (r *resourceSnmpServer) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	log.Printf("Started Update")
+
+	var plan models.SnmpServer
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.SnmpServer
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverModify := clientgen.SnmpServerModify{}
+	if !plan.IPAddress.Equal(state.IPAddress) {
+		serverModify.IpAddress = helper.ValueToPointer[string](plan.IPAddress)
+	}
+	if !plan.Port.Equal(state.Port) {
+		serverModify.Port = helper.KnownInt32(plan.Port)
+	}
+	if !plan.AlertSeverity.Equal(state.AlertSeverity) {
+		serverModify.AlertSeverity = (*clientgen.SNMPSeverityEnum)(helper.ValueToPointer[string](plan.AlertSeverity))
+	}
+	if !plan.UserName.Equal(state.UserName) {
+		serverModify.UserName = helper.ValueToPointer[string](plan.UserName)
+	}
+	if !plan.AuthProtocol.Equal(state.AuthProtocol) {
+		serverModify.AuthProtocol = (*clientgen.SNMPAuthProtocolEnum)(helper.ValueToPointer[string](plan.AuthProtocol))
+	}
+	if !plan.PrivacyProtocol.Equal(state.PrivacyProtocol) {
+		serverModify.PrivacyProtocol = (*clientgen.SNMPPrivacyProtocolEnum)(helper.ValueToPointer[string](plan.PrivacyProtocol))
+	}
+	if !plan.CredentialsVersion.Equal(state.CredentialsVersion) {
+		var trapCommunity, authpass types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("trap_community"), &trapCommunity)...)
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("authpass"), &authpass)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		serverModify.TrapCommunity = helper.ValueToPointer[string](trapCommunity)
+		serverModify.Authpass = helper.ValueToPointer[string](authpass)
+	}
+
+	id := state.ID.ValueString()
+	if serverModify != (clientgen.SnmpServerModify{}) {
+		_, err := r.client.SnmpServerApi.PatchSnmpServerById(ctx, id).Body(serverModify).Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating snmp server",
+				"Could not update snmp server "+id+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	server, err := r.ReadAPI(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting snmp server after update",
+			"Could not get snmp server, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state = r.updateState(server, plan.CredentialsVersion)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Successfully done with Update")
+}
+
+// Delete - method to delete snmp server resource
+func (r *resourceSnmpServer) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	log.Printf("Started with Delete")
+
+	var state models.SnmpServer
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	_, err := r.client.SnmpServerApi.DeleteSnmpServerById(ctx, id).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting snmp server",
+			"Could not delete snmp server "+id+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	log.Printf("Done with Delete")
+}
+
+// ImportState - imports state for existing snmp server
+func (r *resourceSnmpServer) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// ReadAPI - fetches the SNMP server by id
+func (r *resourceSnmpServer) ReadAPI(ctx context.Context, id string) (*clientgen.SnmpServerInstance, error) {
+	queries := make(url.Values)
+	queries.Set("select", snmpServerSelect)
+	server, _, err := r.client.SnmpServerApi.GetSnmpServerById(ctx, id).Queries(queries).Execute()
+	return server, err
+}
+
+// updateState - converts the SNMP server response to the resource state
+func (r *resourceSnmpServer) updateState(server *clientgen.SnmpServerInstance, credentialsVersion types.Int64) models.SnmpServer {
+	return models.SnmpServer{
+		ID:                 helper.TfString(server.Id),
+		IPAddress:          helper.TfString(server.IpAddress),
+		Port:               helper.TfInt64(server.Port),
+		Version:            helper.TfString(server.Version),
+		AlertSeverity:      helper.TfString(server.AlertSeverity),
+		TrapCommunity:      types.StringNull(),
+		UserName:           helper.TfString(server.UserName),
+		AuthProtocol:       helper.TfString(server.AuthProtocol),
+		PrivacyProtocol:    helper.TfString(server.PrivacyProtocol),
+		Authpass:           types.StringNull(),
+		CredentialsVersion: credentialsVersion,
+	}
+}
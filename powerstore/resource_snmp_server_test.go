@@ -0,0 +1,104 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Test to Create, Update, Import and Delete SNMP Server Resource
+func TestAccSnmpServer(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfigForTesting + SnmpServerParamsInvalidPort,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value"),
+			},
+			{
+				Config: ProviderConfigForTesting + SnmpServerParamsCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerstore_snmp_server.test", "ip_address", snmpServerAddress),
+					resource.TestCheckResourceAttr("powerstore_snmp_server.test", "port", "162"),
+					resource.TestCheckResourceAttr("powerstore_snmp_server.test", "version", "V3"),
+					resource.TestCheckResourceAttr("powerstore_snmp_server.test", "auth_protocol", "SHA256"),
+					resource.TestCheckNoResourceAttr("powerstore_snmp_server.test", "authpass"),
+				),
+			},
+			// Import Testing
+			{
+				Config:                  ProviderConfigForTesting + SnmpServerParamsCreate,
+				ResourceName:            "powerstore_snmp_server.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"credentials_version"},
+			},
+			{
+				Config: ProviderConfigForTesting + SnmpServerParamsUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerstore_snmp_server.test", "alert_severity", "Major"),
+					resource.TestCheckResourceAttr("powerstore_snmp_server.test", "credentials_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+var SnmpServerParamsInvalidPort = `
+resource "powerstore_snmp_server" "test" {
+	ip_address = "` + snmpServerAddress + `"
+	port = 163
+	version = "V2c"
+	alert_severity = "Info"
+}
+`
+
+var SnmpServerParamsCreate = `
+resource "powerstore_snmp_server" "test" {
+	ip_address = "` + snmpServerAddress + `"
+	version = "V3"
+	alert_severity = "Critical"
+	user_name = "tfacc"
+	auth_protocol = "SHA256"
+	privacy_protocol = "AES256"
+	authpass = "Password123!"
+	credentials_version = 1
+}
+`
+
+var SnmpServerParamsUpdate = `
+resource "powerstore_snmp_server" "test" {
+	ip_address = "` + snmpServerAddress + `"
+	version = "V3"
+	alert_severity = "Major"
+	user_name = "tfacc"
+	auth_protocol = "SHA256"
+	privacy_protocol = "AES256"
+	authpass = "Password456!"
+	credentials_version = 2
+}
+`
@@ -0,0 +1,484 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-powerstore/client"
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+)
+
+// softwarePackageSelect lists the software package fields read by the software upgrade resource
+const softwarePackageSelect = "id,name,release_version,build_version,software_package_state,is_reboot_required"
+
+// softwareUpgradePollInterval is the interval between two checks of the software package state while installing
+var softwareUpgradePollInterval = 30 * time.Second
+
+// newSoftwareUpgradeResource returns software upgrade new resource instance
+func newSoftwareUpgradeResource() resource.Resource {
+	return &resourceSoftwareUpgrade{}
+}
+
+type resourceSoftwareUpgrade struct {
+	client    *clientgen.APIClient
+	allclient *client.Client
+}
+
+// Metadata defines resource interface Metadata method
+func (r *resourceSoftwareUpgrade) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_software_upgrade"
+}
+
+// Schema defines resource interface Schema method
+func (r *resourceSoftwareUpgrade) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to upgrade the software of PowerStore Array. Creating this resource uploads a software package from a local file, optionally runs the pre-upgrade health check, starts the upgrade of all appliances and waits until the package is installed. Installed software cannot be removed, so destroying this resource only removes it from the Terraform state.",
+		Description:         "This resource is used to upgrade the software of PowerStore Array. Creating this resource uploads a software package from a local file, optionally runs the pre-upgrade health check, starts the upgrade of all appliances and waits until the package is installed. Installed software cannot be removed, so destroying this resource only removes it from the Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Unique identifier of the uploaded software package.",
+				MarkdownDescription: "Unique identifier of the uploaded software package.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"file_path": schema.StringAttribute{
+				Description:         "Path of the software package file on the machine running Terraform.",
+				MarkdownDescription: "Path of the software package file on the machine running Terraform.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"file_sha256": schema.StringAttribute{
+				Description:         "SHA-256 checksum of the software package file. It is computed from the file at every plan, so replacing the file at the same path uploads and installs the new software package. The last checksum is kept when the file has been removed.",
+				MarkdownDescription: "SHA-256 checksum of the software package file. It is computed from the file at every plan, so replacing the file at the same path uploads and installs the new software package. The last checksum is kept when the file has been removed.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					softwarePackageChecksumModifier{},
+				},
+			},
+			"run_health_check": schema.BoolAttribute{
+				Description:         "Whether to run the pre-upgrade health check before starting the upgrade. The upgrade is not started if the health check reports errors, warnings are shown without blocking it. Defaults to true.",
+				MarkdownDescription: "Whether to run the pre-upgrade health check before starting the upgrade. The upgrade is not started if the health check reports errors, warnings are shown without blocking it. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"timeout": schema.Int64Attribute{
+				Description:         "Number of seconds to wait for the pre-upgrade health check and the upgrade to complete. Defaults to 14400.",
+				MarkdownDescription: "Number of seconds to wait for the pre-upgrade health check and the upgrade to complete. Defaults to `14400`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(14400),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description:         "Name of the software package.",
+				MarkdownDescription: "Name of the software package.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"release_version": schema.StringAttribute{
+				Description:         "Release version of the software package.",
+				MarkdownDescription: "Release version of the software package.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"build_version": schema.StringAttribute{
+				Description:         "Build version of the software package.",
+				MarkdownDescription: "Build version of the software package.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"software_package_state": schema.StringAttribute{
+				Description:         "State of the software package.",
+				MarkdownDescription: "State of the software package.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_reboot_required": schema.BoolAttribute{
+				Description:         "Whether a reboot is required during the upgrade process.",
+				MarkdownDescription: "Whether a reboot is required during the upgrade process.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// softwarePackageChecksumModifier plans the checksum of the software package file and replaces the resource when it changes
+type softwarePackageChecksumModifier struct{}
+
+// Description returns a plain text description of the modifier's behavior
+func (m softwarePackageChecksumModifier) Description(ctx context.Context) string {
+	return "Computes the checksum of the software package file and requires replacement if it changes."
+}
+
+// MarkdownDescription returns a markdown formatted description of the modifier's behavior
+func (m softwarePackageChecksumModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+// PlanModifyString sets the planned checksum from the file, keeping the state value if the file cannot be read
+func (m softwarePackageChecksumModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	var filePath types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("file_path"), &filePath)...)
+	if resp.Diagnostics.HasError() || !helper.IsKnownValue(filePath) {
+		return
+	}
+	checksum, err := client.SoftwarePackageChecksum(filePath.ValueString())
+	if err != nil {
+		// the package file is usually removed once it is installed
+		if !req.State.Raw.IsNull() {
+			resp.PlanValue = req.StateValue
+		}
+		return
+	}
+	resp.PlanValue = types.StringValue(checksum)
+	if !req.State.Raw.IsNull() && !req.StateValue.IsNull() && !req.StateValue.Equal(resp.PlanValue) {
+		resp.RequiresReplace = true
+	}
+}
+
+// Configure - defines configuration for software upgrade resource
+func (r *resourceSoftwareUpgrade) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client.GenClient
+	r.allclient = client
+}
+
+// Create - uploads the software package, runs the health check and installs the package
+func (r *resourceSoftwareUpgrade) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.SoftwareUpgrade
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, checksum, err := r.allclient.UploadSoftwarePackage(ctx, plan.FilePath.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating software upgrade",
+			"Could not upload software package, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if helper.IsKnownValue(plan.FileSha256) && plan.FileSha256.ValueString() != checksum {
+		resp.Diagnostics.AddError(
+			"Error creating software upgrade",
+			"Software package file "+plan.FilePath.ValueString()+" changed since the plan was made, run the plan again",
+		)
+		resp.Diagnostics.Append(r.deleteUploadedPackage(ctx, id)...)
+		return
+	}
+	plan.FileSha256 = types.StringValue(checksum)
+
+	deadline := time.Now().Add(time.Duration(plan.Timeout.ValueInt64()) * time.Second)
+	if plan.RunHealthCheck.ValueBool() {
+		resp.Diagnostics.Append(r.runHealthCheck(ctx, id, deadline)...)
+		if resp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(r.deleteUploadedPackage(ctx, id)...)
+			return
+		}
+	}
+
+	err = r.allclient.WithMaintenanceWindow(ctx, func() error {
+		jobID, err := r.allclient.StartSoftwarePackageJob(ctx, id, "install")
+		if err != nil {
+			return err
+		}
+		return r.waitForInstall(ctx, id, jobID, deadline)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating software upgrade",
+			"Could not install software package "+id+", unexpected error: "+err.Error(),
+		)
+		resp.Diagnostics.Append(r.deleteUploadedPackage(ctx, id)...)
+		return
+	}
+
+	pkg, err := r.ReadAPI(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting software upgrade after creation",
+			"Could not get software package, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state := r.updateState(pkg, plan)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Create")
+}
+
+// Read - reads software upgrade resource information
+func (r *resourceSoftwareUpgrade) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.SoftwareUpgrade
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	pkg, err := r.ReadAPI(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading software upgrade",
+			"Could not read software package with error "+id+": "+err.Error(),
+		)
+		return
+	}
+
+	state = r.updateState(pkg, state)
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Read")
+}
+
+// Update - only the health check and timeout settings can be updated, which have no effect once the package is installed
+func (r *resourceSoftwareUpgrade) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	log.Printf("Started Update")
+
+	var plan models.SoftwareUpgrade
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Successfully done with Update")
+}
+
+// Delete - removes the software upgrade from the state, installed software cannot be removed
+func (r *resourceSoftwareUpgrade) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	log.Printf("Started with Delete")
+	resp.State.RemoveResource(ctx)
+	log.Printf("Done with Delete")
+}
+
+// deleteUploadedPackage - deletes a package whose upgrade failed, so that it is not left on the array without being tracked
+// The array refuses to delete a package that is being installed, in which case the package is reported to be cleaned up manually
+func (r *resourceSoftwareUpgrade) deleteUploadedPackage(ctx context.Context, id string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if _, _, err := r.client.SoftwarePackageApi.DeleteSoftwarePackageById(ctx, id).Execute(); err != nil {
+		diags.AddWarning(
+			"Could not delete uploaded software package",
+			"Software package "+id+" was uploaded but could not be deleted after the failed upgrade, it has to be deleted manually: "+err.Error(),
+		)
+	}
+	return diags
+}
+
+// ReadAPI - fetches the software package by id
+func (r *resourceSoftwareUpgrade) ReadAPI(ctx context.Context, id string) (*clientgen.SoftwarePackageInstance, error) {
+	queries := make(url.Values)
+	queries.Set("select", softwarePackageSelect)
+	pkg, _, err := r.client.SoftwarePackageApi.GetSoftwarePackageById(ctx, id).Queries(queries).Execute()
+	return pkg, err
+}
+
+// runHealthCheck - runs the pre-upgrade health check of the software package and waits for its result
+// Health check errors fail the upgrade, warnings are reported without blocking it
+func (r *resourceSoftwareUpgrade) runHealthCheck(ctx context.Context, id string, deadline time.Time) diag.Diagnostics {
+	var diags diag.Diagnostics
+	jobID, err := r.allclient.StartSoftwarePackageJob(ctx, id, "puhc")
+	if err == nil {
+		var job *clientgen.JobInstance
+		job, err = r.waitForJob(ctx, jobID, deadline)
+		if err == nil {
+			errors, warnings := healthCheckMessages(*job)
+			for _, warning := range warnings {
+				diags.AddWarning("Pre-upgrade health check warning", "Software package "+id+": "+warning)
+			}
+			if len(errors) > 0 || *job.State != clientgen.JOBSTATEENUM_COMPLETED {
+				err = fmt.Errorf("health check job %s finished in state %s: %s%s", jobID, *job.State, strings.Join(errors, " "), client.JobFailureDetails(*job))
+			}
+		}
+	}
+	if err != nil {
+		diags.AddError(
+			"Error creating software upgrade",
+			"Pre-upgrade health check of software package "+id+" failed, unexpected error: "+err.Error(),
+		)
+	}
+	return diags
+}
+
+// healthCheckMessages - splits the messages reported by a health check job and its steps into errors and warnings
+func healthCheckMessages(job clientgen.JobInstance) (errors, warnings []string) {
+	for _, step := range append([]clientgen.JobInstance{job}, job.Leafs...) {
+		if step.ResponseBody == nil {
+			continue
+		}
+		for _, message := range step.ResponseBody.Messages {
+			if message.MessageL10n == nil || message.Severity == nil {
+				continue
+			}
+			switch *message.Severity {
+			case clientgen.MESSAGESEVERITYENUM_ERROR:
+				errors = append(errors, *message.MessageL10n)
+			case clientgen.MESSAGESEVERITYENUM_WARNING:
+				warnings = append(warnings, *message.MessageL10n)
+			}
+		}
+	}
+	return errors, warnings
+}
+
+// waitForJob - polls a job until it reaches a final state or the deadline expired
+// Errors while reading the job are tolerated, since the management endpoint may move between nodes during the upgrade
+func (r *resourceSoftwareUpgrade) waitForJob(ctx context.Context, jobID string, deadline time.Time) (*clientgen.JobInstance, error) {
+	for {
+		job, err := r.allclient.GetJob(ctx, jobID)
+		if err != nil {
+			log.Printf("Could not read job %s: %s", jobID, err.Error())
+		} else if job.State != nil && isFinalJobState(*job.State) {
+			return job, nil
+		}
+		if err := sleepUntilNextPoll(ctx, deadline); err != nil {
+			return nil, fmt.Errorf("job %s: %w", jobID, err)
+		}
+	}
+}
+
+// waitForInstall - polls the install job and the software package until the package is installed, the install failed or the deadline expired
+// Errors while reading are tolerated, since the management endpoint may move between nodes during the upgrade
+func (r *resourceSoftwareUpgrade) waitForInstall(ctx context.Context, id, jobID string, deadline time.Time) error {
+	for {
+		job, err := r.allclient.GetJob(ctx, jobID)
+		if err != nil {
+			log.Printf("Could not read install job %s of software package %s: %s", jobID, id, err.Error())
+		} else if job.State != nil && isFinalJobState(*job.State) && *job.State != clientgen.JOBSTATEENUM_COMPLETED {
+			return fmt.Errorf("install job %s of software package %s finished in state %s%s", jobID, id, *job.State, client.JobFailureDetails(*job))
+		}
+
+		pkg, err := r.ReadAPI(ctx, id)
+		if err != nil {
+			log.Printf("Could not read software package %s while waiting for the upgrade: %s", id, err.Error())
+		} else if pkg.SoftwarePackageState != nil {
+			switch *pkg.SoftwarePackageState {
+			case clientgen.SOFTWAREPACKAGESTATEENUM_INSTALLED:
+				return nil
+			case clientgen.SOFTWAREPACKAGESTATEENUM_INSTALL_FAILED:
+				return fmt.Errorf("software package %s failed to install", id)
+			}
+		}
+		if err := sleepUntilNextPoll(ctx, deadline); err != nil {
+			return fmt.Errorf("software package %s: %w", id, err)
+		}
+	}
+}
+
+// isFinalJobState - reports whether a job in this state will not change anymore
+func isFinalJobState(state clientgen.JobStateEnum) bool {
+	switch state {
+	case clientgen.JOBSTATEENUM_COMPLETED, clientgen.JOBSTATEENUM_SKIPPED, clientgen.JOBSTATEENUM_FAILED, clientgen.JOBSTATEENUM_UNRECOVERABLE_FAILED:
+		return true
+	}
+	return false
+}
+
+// sleepUntilNextPoll - waits for the poll interval, failing if the deadline expired or the context is cancelled
+func sleepUntilNextPoll(ctx context.Context, deadline time.Time) error {
+	if time.Now().After(deadline) {
+		return fmt.Errorf("timed out waiting for the upgrade")
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(softwareUpgradePollInterval):
+		return nil
+	}
+}
+
+// updateState - converts the software package response to the resource state
+func (r *resourceSoftwareUpgrade) updateState(pkg *clientgen.SoftwarePackageInstance, plan models.SoftwareUpgrade) models.SoftwareUpgrade {
+	return models.SoftwareUpgrade{
+		ID:                   helper.TfString(pkg.Id),
+		FilePath:             plan.FilePath,
+		FileSha256:           plan.FileSha256,
+		RunHealthCheck:       plan.RunHealthCheck,
+		Timeout:              plan.Timeout,
+		Name:                 helper.TfString(pkg.Name),
+		ReleaseVersion:       helper.TfString(pkg.ReleaseVersion),
+		BuildVersion:         helper.TfString(pkg.BuildVersion),
+		SoftwarePackageState: helper.TfString(pkg.SoftwarePackageState),
+		IsRebootRequired:     helper.TfBool(pkg.IsRebootRequired),
+	}
+}
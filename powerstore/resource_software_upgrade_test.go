@@ -0,0 +1,94 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Test to upgrade the software with a software package
+// Against a real array SOFTWARE_PACKAGE_FILE must point to a valid package, against the mock REST server any file is accepted
+func TestAccSoftwareUpgrade(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+
+	if _, err := os.Stat(softwarePackageFile); os.IsNotExist(err) {
+		if err := os.WriteFile(softwarePackageFile, []byte("tfacc software package"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfigForTesting + SoftwareUpgradeParamsInvalidFile,
+				ExpectError: regexp.MustCompile("Could not upload software package"),
+			},
+			{
+				Config:      ProviderConfigForTesting + SoftwareUpgradeParamsInvalidTimeout,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value"),
+			},
+			{
+				Config: ProviderConfigForTesting + SoftwareUpgradeParamsCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerstore_software_upgrade.test", "software_package_state", "Installed"),
+					resource.TestCheckResourceAttrSet("powerstore_software_upgrade.test", "id"),
+					resource.TestCheckResourceAttrSet("powerstore_software_upgrade.test", "release_version"),
+					resource.TestCheckResourceAttrSet("powerstore_software_upgrade.test", "file_sha256"),
+				),
+			},
+			{
+				Config: ProviderConfigForTesting + SoftwareUpgradeParamsUpdate,
+				Check:  resource.TestCheckResourceAttr("powerstore_software_upgrade.test", "timeout", "7200"),
+			},
+		},
+	})
+}
+
+var SoftwareUpgradeParamsInvalidFile = `
+resource "powerstore_software_upgrade" "test" {
+	file_path = "/invalid/path/to/package.tgz.bin"
+}
+`
+
+var SoftwareUpgradeParamsInvalidTimeout = `
+resource "powerstore_software_upgrade" "test" {
+	file_path = "` + softwarePackageFile + `"
+	timeout = 0
+}
+`
+
+var SoftwareUpgradeParamsCreate = `
+resource "powerstore_software_upgrade" "test" {
+	file_path = "` + softwarePackageFile + `"
+}
+`
+
+var SoftwareUpgradeParamsUpdate = `
+resource "powerstore_software_upgrade" "test" {
+	file_path = "` + softwarePackageFile + `"
+	timeout = 7200
+}
+`
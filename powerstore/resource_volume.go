@@ -24,6 +24,7 @@ import (
 	"strings"
 	client "terraform-provider-powerstore/client"
 	"terraform-provider-powerstore/models"
+	"time"
 
 	"github.com/dell/gopowerstore"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -408,11 +409,12 @@ func (r volumeResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	// Create New Volume
 	// The function returns only ID of the newly created Volume
+	started := time.Now()
 	volCreateResponse, err := r.client.PStoreClient.CreateVolume(context.Background(), volumeCreate)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating volume",
-			"Could not create volume, unexpected error: "+err.Error(),
+			"Could not create volume, unexpected error: "+err.Error()+r.client.FailedJobDetails(ctx, started, "volume", "", name),
 		)
 		return
 	}
@@ -517,6 +519,7 @@ func (r volumeResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	// Update volume parameters. In case of validation failure, return
+	started := time.Now()
 	updatedParams, updateFailedParameters, errMessages := updateVol(ctx, *r.client, plan, state)
 	if len(updateFailedParameters) > 0 && updateFailedParameters[0] == "Validation Failed" {
 		resp.Diagnostics.AddError(
@@ -529,7 +532,7 @@ func (r volumeResource) Update(ctx context.Context, req resource.UpdateRequest,
 	volID := state.ID.ValueString()
 
 	if len(errMessages) > 0 || len(updateFailedParameters) > 0 {
-		errMessage := strings.Join(errMessages, ",\n")
+		errMessage := strings.Join(errMessages, ",\n") + r.client.FailedJobDetails(ctx, started, "volume", volID, "")
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Failed to update all parameters of Volume, updated parameters are %v and parameters failed to update are %v", updatedParams, updateFailedParameters),
 			errMessage)
@@ -637,11 +640,12 @@ func (r volumeResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 
 	// Delete volume by calling API
+	started := time.Now()
 	_, err = r.client.PStoreClient.DeleteVolume(context.Background(), nil, volID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting volume",
-			"Could not delete volID "+volID+": "+err.Error(),
+			"Could not delete volID "+volID+": "+err.Error()+r.client.FailedJobDetails(ctx, started, "volume", volID, ""),
 		)
 		return
 	}
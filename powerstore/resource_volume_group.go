@@ -23,6 +23,7 @@ import (
 	"log"
 	"net/url"
 	"strings"
+	"time"
 
 	client "terraform-provider-powerstore/client"
 	"terraform-provider-powerstore/clientgen"
@@ -197,11 +198,12 @@ func (r *resourceVolumeGroup) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	//Create New Volume Group
+	started := time.Now()
 	volGroupCreateResponse, _, err := r.client.VolumeGroupApi.PostAllVolumeGroups(ctx).Body(volumeGroupCreate).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating volume group",
-			"Could not create volume group, unexpected error: "+err.Error(),
+			"Could not create volume group, unexpected error: "+err.Error()+r.allclient.FailedJobDetails(ctx, started, "volume_group", "", volumeGroupCreate.Name),
 		)
 		return
 	}
@@ -287,11 +289,12 @@ func (r *resourceVolumeGroup) Delete(ctx context.Context, req resource.DeleteReq
 	}
 
 	//Delete Volume Group by calling API
+	started := time.Now()
 	_, err = r.client.VolumeGroupApi.DeleteVolumeGroupById(ctx, volumeGroupID).Body(clientgen.VolumeGroupDelete{}).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting volume group",
-			"Could not delete volumeGroupID "+volumeGroupID+": "+err.Error(),
+			"Could not delete volumeGroupID "+volumeGroupID+": "+err.Error()+r.allclient.FailedJobDetails(ctx, started, "volume_group", volumeGroupID, ""),
 		)
 		return
 	}
@@ -440,11 +443,12 @@ func (r *resourceVolumeGroup) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	//Update Volume Group by calling API
+	started := time.Now()
 	_, err := r.client.VolumeGroupApi.PatchVolumeGroupById(ctx, volumeGroupID).Body(volumeGroupUpdate).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating volume group",
-			"Could not update volumeGroupID "+volumeGroupID+": "+err.Error(),
+			"Could not update volumeGroupID "+volumeGroupID+": "+err.Error()+r.allclient.FailedJobDetails(ctx, started, "volume_group", volumeGroupID, ""),
 		)
 	}
 
@@ -457,7 +461,7 @@ func (r *resourceVolumeGroup) Update(ctx context.Context, req resource.UpdateReq
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error updating volume group",
-				"Could not update volumeGroupID "+volumeGroupID+": "+err.Error(),
+				"Could not update volumeGroupID "+volumeGroupID+": "+err.Error()+r.allclient.FailedJobDetails(ctx, started, "volume_group", volumeGroupID, ""),
 			)
 		}
 	}
@@ -471,7 +475,7 @@ func (r *resourceVolumeGroup) Update(ctx context.Context, req resource.UpdateReq
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error updating volume group",
-				"Could not update volumeGroupID "+volumeGroupID+": "+err.Error(),
+				"Could not update volumeGroupID "+volumeGroupID+": "+err.Error()+r.allclient.FailedJobDetails(ctx, started, "volume_group", volumeGroupID, ""),
 			)
 		}
 	}
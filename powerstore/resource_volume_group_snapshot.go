@@ -24,6 +24,7 @@ import (
 	"regexp"
 	"terraform-provider-powerstore/client"
 	"terraform-provider-powerstore/models"
+	"time"
 
 	"github.com/dell/gopowerstore"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -169,11 +170,12 @@ func (r *resourceVGSnapshot) Create(ctx context.Context, req resource.CreateRequ
 		ExpirationTimestamp: expirationTimestamp,
 	}
 
+	started := time.Now()
 	snapCreateResponse, err := r.client.PStoreClient.CreateVolumeGroupSnapshot(context.Background(), volGroupID, vgSnapCreate)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating volume group snapshot",
-			"Could not create volume group snapshot, unexpected error: "+err.Error(),
+			"Could not create volume group snapshot, unexpected error: "+err.Error()+r.client.FailedJobDetails(ctx, started, "volume_group", volGroupID, ""),
 		)
 		return
 	}
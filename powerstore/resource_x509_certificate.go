@@ -0,0 +1,409 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-powerstore/client"
+	"terraform-provider-powerstore/clientgen"
+	"terraform-provider-powerstore/models"
+	"terraform-provider-powerstore/powerstore/helper"
+)
+
+// x509CertificateSelect lists the certificate fields read by the x509 certificate resource
+const x509CertificateSelect = "id,type,service,scope,is_current,is_valid,members"
+
+// newX509CertificateResource returns x509 certificate new resource instance
+func newX509CertificateResource() resource.Resource {
+	return &resourceX509Certificate{}
+}
+
+type resourceX509Certificate struct {
+	client *clientgen.APIClient
+}
+
+// Metadata defines resource interface Metadata method
+func (r *resourceX509Certificate) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_x509_certificate"
+}
+
+// Schema defines resource interface Schema method
+func (r *resourceX509Certificate) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is used to import X.509 certificates used by the services of PowerStore Array, like the management HTTPS endpoint, replication, VASA and LDAP. The fingerprint and expiry of the end-entity certificate are exposed as attributes. The PowerStore REST API does not support deleting certificates, so destroying this resource only removes it from the Terraform state. We can also import an existing certificate from PowerStore array.",
+		Description:         "This resource is used to import X.509 certificates used by the services of PowerStore Array, like the management HTTPS endpoint, replication, VASA and LDAP. The fingerprint and expiry of the end-entity certificate are exposed as attributes. The PowerStore REST API does not support deleting certificates, so destroying this resource only removes it from the Terraform state. We can also import an existing certificate from PowerStore array.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Unique identifier of the certificate.",
+				MarkdownDescription: "Unique identifier of the certificate.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description:         "Usage of the certificate. Valid values are Server, Client, CA_Client_Validation and CA_Server_Validation. Cannot be updated.",
+				MarkdownDescription: "Usage of the certificate. Valid values are `Server`, `Client`, `CA_Client_Validation` and `CA_Server_Validation`. Cannot be updated.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(helper.SliceTransform(clientgen.AllowedX509CertificateUsageTypeEnumEnumValues, func(in clientgen.X509CertificateUsageTypeEnum) string {
+						return string(in)
+					})...),
+				},
+			},
+			"service": schema.StringAttribute{
+				Description:         "Service the certificate is used for, like Management_HTTP, Replication_HTTP, VASA_HTTP or LDAP_HTTP. Cannot be updated.",
+				MarkdownDescription: "Service the certificate is used for, like `Management_HTTP`, `Replication_HTTP`, `VASA_HTTP` or `LDAP_HTTP`. Cannot be updated.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(helper.SliceTransform(clientgen.AllowedX509CertificateServiceEnumEnumValues, func(in clientgen.X509CertificateServiceEnum) string {
+						return string(in)
+					})...),
+				},
+			},
+			"scope": schema.StringAttribute{
+				Description:         "Subset of the certificates of the service the certificate belongs to. For example External for Management_HTTP server certificates, the serial number of the remote cluster for Replication_HTTP CA certificates or the domain name for LDAP_HTTP CA certificates. If not set, the scope assigned by the array is used. Cannot be updated.",
+				MarkdownDescription: "Subset of the certificates of the service the certificate belongs to. For example `External` for `Management_HTTP` server certificates, the serial number of the remote cluster for `Replication_HTTP` CA certificates or the domain name for `LDAP_HTTP` CA certificates. If not set, the scope assigned by the array is used. Cannot be updated.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"certificate": schema.StringAttribute{
+				Description:         "PEM encoded certificate chain, from the end-entity certificate to the root certificate. Server and client certificates whose private key is held by the array can be updated in place, otherwise changing the certificate imports a new one.",
+				MarkdownDescription: "PEM encoded certificate chain, from the end-entity certificate to the root certificate. Server and client certificates whose private key is held by the array can be updated in place, otherwise changing the certificate imports a new one.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(x509CertificateNotModifiable,
+						"CA certificates and certificates imported with a private key cannot be modified.",
+						"CA certificates and certificates imported with a private key cannot be modified.",
+					),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"private_key": schema.StringAttribute{
+				Description:         "PEM encoded private key of the certificate, following encrypted PKCS8. This attribute is write-only and is never stored in the state.",
+				MarkdownDescription: "PEM encoded private key of the certificate, following encrypted PKCS8. This attribute is write-only and is never stored in the state.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("passphrase")),
+				},
+			},
+			"passphrase": schema.StringAttribute{
+				Description:         "Passphrase used to encrypt the private key. This attribute is write-only and is never stored in the state.",
+				MarkdownDescription: "Passphrase used to encrypt the private key. This attribute is write-only and is never stored in the state.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("private_key")),
+				},
+			},
+			"is_current": schema.BoolAttribute{
+				Description:         "Whether the certificate is currently used by the service, or will be used in the future. Must be true for Management_HTTP server certificates.",
+				MarkdownDescription: "Whether the certificate is currently used by the service, or will be used in the future. Must be `true` for `Management_HTTP` server certificates.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_valid": schema.BoolAttribute{
+				Description:         "Whether the certificate is valid. It is false when the certificate expired or a server certificate misses its private key.",
+				MarkdownDescription: "Whether the certificate is valid. It is false when the certificate expired or a server certificate misses its private key.",
+				Computed:            true,
+			},
+			"subject": schema.StringAttribute{
+				Description:         "Subject of the end-entity certificate.",
+				MarkdownDescription: "Subject of the end-entity certificate.",
+				Computed:            true,
+			},
+			"issuer": schema.StringAttribute{
+				Description:         "Distinguished name of the issuer of the end-entity certificate.",
+				MarkdownDescription: "Distinguished name of the issuer of the end-entity certificate.",
+				Computed:            true,
+			},
+			"serial_number": schema.StringAttribute{
+				Description:         "Serial number of the end-entity certificate.",
+				MarkdownDescription: "Serial number of the end-entity certificate.",
+				Computed:            true,
+			},
+			"fingerprint": schema.StringAttribute{
+				Description:         "Fingerprint (thumbprint) of the end-entity certificate.",
+				MarkdownDescription: "Fingerprint (thumbprint) of the end-entity certificate.",
+				Computed:            true,
+			},
+			"fingerprint_algorithm": schema.StringAttribute{
+				Description:         "Hash algorithm of the fingerprint.",
+				MarkdownDescription: "Hash algorithm of the fingerprint.",
+				Computed:            true,
+			},
+			"valid_from": schema.StringAttribute{
+				Description:         "Time when the end-entity certificate becomes valid.",
+				MarkdownDescription: "Time when the end-entity certificate becomes valid.",
+				Computed:            true,
+			},
+			"valid_to": schema.StringAttribute{
+				Description:         "Expiry time of the end-entity certificate.",
+				MarkdownDescription: "Expiry time of the end-entity certificate.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// x509CertificateNotModifiable requires the certificate to be replaced when it cannot be modified in place,
+// since the API only modifies server and client certificates whose private key was generated by the array
+func x509CertificateNotModifiable(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	if req.State.Raw.IsNull() {
+		return
+	}
+	var certificateType, privateKey types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("type"), &certificateType)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("private_key"), &privateKey)...)
+	resp.RequiresReplace = strings.HasPrefix(certificateType.ValueString(), "CA_") || !privateKey.IsNull()
+}
+
+// Configure - defines configuration for x509 certificate resource
+func (r *resourceX509Certificate) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client.GenClient
+}
+
+// Create - imports the certificate
+func (r *resourceX509Certificate) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.X509Certificate
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// write-only attributes are only available in the configuration
+	var privateKey, passphrase types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("private_key"), &privateKey)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("passphrase"), &passphrase)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createResp, _, err := r.client.X509CertificateApi.PostAllX509Certificates(ctx).Body(clientgen.X509CertificateCreate{
+		Type:        clientgen.X509CertificateUsageTypeEnum(plan.Type.ValueString()),
+		Service:     clientgen.X509CertificateServiceEnum(plan.Service.ValueString()),
+		Scope:       helper.ValueToPointer[string](plan.Scope),
+		Certificate: plan.Certificate.ValueString(),
+		PrivateKey:  helper.ValueToPointer[string](privateKey),
+		Passphrase:  helper.ValueToPointer[string](passphrase),
+		IsCurrent:   helper.ValueToPointer[bool](plan.IsCurrent),
+	}).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating x509 certificate",
+			"Could not import x509 certificate, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	certificate, err := r.ReadAPI(ctx, helper.TfString(createResp.Id).ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting x509 certificate after creation",
+			"Could not get x509 certificate, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state := r.updateState(certificate, plan)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Create")
+}
+
+// Read - reads x509 certificate resource information
+func (r *resourceX509Certificate) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.X509Certificate
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	certificate, err := r.ReadAPI(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading x509 certificate",
+			"Could not read x509 certificate with error "+id+": "+err.Error(),
+		)
+		return
+	}
+
+	state = r.updateState(certificate, state)
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Done with Read")
+}
+
+// Update - updates the certificate chain or current flag of server and client certificates
+func (r *resourceX509Certificate) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	log.Printf("Started Update")
+
+	var plan models.X509Certificate
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.X509Certificate
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	certificateModify := clientgen.X509CertificateModify{
+		IsCurrent: plan.IsCurrent.ValueBool(),
+	}
+	if !plan.Certificate.Equal(state.Certificate) {
+		certificateModify.Certificate = helper.ValueToPointer[string](plan.Certificate)
+	}
+
+	id := state.ID.ValueString()
+	_, err := r.client.X509CertificateApi.PatchX509CertificateById(ctx, id).Body(certificateModify).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating x509 certificate",
+			"Could not update x509 certificate "+id+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	certificate, err := r.ReadAPI(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting x509 certificate after update",
+			"Could not get x509 certificate, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state = r.updateState(certificate, plan)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	log.Printf("Successfully done with Update")
+}
+
+// Delete - removes the x509 certificate from the state, the API does not support deleting certificates
+func (r *resourceX509Certificate) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	log.Printf("Started with Delete")
+	resp.State.RemoveResource(ctx)
+	log.Printf("Done with Delete")
+}
+
+// ImportState - imports state for existing x509 certificate
+func (r *resourceX509Certificate) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// ReadAPI - fetches the x509 certificate by id
+func (r *resourceX509Certificate) ReadAPI(ctx context.Context, id string) (*clientgen.X509CertificateInstance, error) {
+	queries := make(url.Values)
+	queries.Set("select", x509CertificateSelect)
+	certificate, _, err := r.client.X509CertificateApi.GetX509CertificateById(ctx, id).Queries(queries).Execute()
+	return certificate, err
+}
+
+// updateState - converts the x509 certificate response to the resource state
+// The array only reports the members of the chain, so the configured PEM certificate is kept
+func (r *resourceX509Certificate) updateState(certificate *clientgen.X509CertificateInstance, plan models.X509Certificate) models.X509Certificate {
+	var endEntity clientgen.MemberCertificateInstance
+	for _, member := range certificate.Members {
+		// the end-entity certificate always has the minimum depth of 1
+		if member.Depth != nil && *member.Depth == 1 {
+			endEntity = member
+			break
+		}
+	}
+	return models.X509Certificate{
+		ID:                   helper.TfString(certificate.Id),
+		Type:                 helper.TfString(certificate.Type),
+		Service:              helper.TfString(certificate.Service),
+		Scope:                helper.TfString(certificate.Scope),
+		Certificate:          plan.Certificate,
+		PrivateKey:           types.StringNull(),
+		Passphrase:           types.StringNull(),
+		IsCurrent:            helper.TfBool(certificate.IsCurrent),
+		IsValid:              helper.TfBool(certificate.IsValid),
+		Subject:              helper.TfString(endEntity.Subject),
+		Issuer:               helper.TfString(endEntity.Issuer),
+		SerialNumber:         helper.TfString(endEntity.SerialNumber),
+		Fingerprint:          helper.TfString(endEntity.Thumbprint),
+		FingerprintAlgorithm: helper.TfString(endEntity.ThumbprintAlgorithm),
+		ValidFrom:            helper.TfStringFromPTime(endEntity.ValidFrom),
+		ValidTo:              helper.TfStringFromPTime(endEntity.ValidTo),
+	}
+}
@@ -0,0 +1,92 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerstore
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Test to Create, Update, Import and Delete x509 Certificate Resource
+func TestAccX509Certificate(t *testing.T) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Dont run with units tests because it will try to create the context")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfigForTesting + X509CertificateParamsInvalidType,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+			{
+				Config:      ProviderConfigForTesting + X509CertificateParamsKeyWithoutPassphrase,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			{
+				Config: ProviderConfigForTesting + X509CertificateParamsCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerstore_x509_certificate.test", "type", "CA_Client_Validation"),
+					resource.TestCheckResourceAttr("powerstore_x509_certificate.test", "service", "Management_HTTP"),
+					resource.TestCheckResourceAttrSet("powerstore_x509_certificate.test", "fingerprint"),
+					resource.TestCheckResourceAttrSet("powerstore_x509_certificate.test", "valid_to"),
+				),
+			},
+			// Import Testing
+			{
+				Config:                  ProviderConfigForTesting + X509CertificateParamsCreate,
+				ResourceName:            "powerstore_x509_certificate.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"certificate"},
+			},
+		},
+	})
+}
+
+var X509CertificateParamsInvalidType = `
+resource "powerstore_x509_certificate" "test" {
+	type = "invalid"
+	service = "Management_HTTP"
+	certificate = "invalid"
+}
+`
+
+var X509CertificateParamsKeyWithoutPassphrase = `
+resource "powerstore_x509_certificate" "test" {
+	type = "Server"
+	service = "Management_HTTP"
+	certificate = "invalid"
+	private_key = "invalid"
+}
+`
+
+var X509CertificateParamsCreate = `
+resource "powerstore_x509_certificate" "test" {
+	type = "CA_Client_Validation"
+	service = "Management_HTTP"
+	certificate = <<-EOT
+` + x509CACertificate + `
+EOT
+}
+`